@@ -0,0 +1,111 @@
+package apikeys
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/auth"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+)
+
+const keyId = "6f1ae9d4-0c1b-4a8e-9a57-3c1f7d0e2b11"
+
+func TestCreateApiKey(t *testing.T) {
+	repo := &mockApiKeyRepository{}
+	handler := CallsHandler{Repository: repo}
+	rw := httptest.NewRecorder()
+	handler.CreateApiKey(rw, httptest.NewRequest(http.MethodPost, "/admin/keys",
+		strings.NewReader(`{"name":"ci","scopes":["read","write:debt"]}`)))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, rw.Code)
+	}
+	var created CreatedApiKey
+	if err := json.Unmarshal(rw.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Id != keyId || !strings.HasPrefix(created.Key, "sa_") {
+		t.Errorf("unexpected response %+v", created)
+	}
+	if repo.Created == nil || repo.Created.Hash != auth.HashKey(created.Key) {
+		t.Error("expected the hash of the returned key to be stored")
+	}
+	if strings.Contains(rw.Body.String(), repo.Created.Hash) {
+		t.Error("hash must not be returned")
+	}
+}
+
+func TestCreateApiKey_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"InvalidJson", "not json"},
+		{"NoName", `{"scopes":["read"]}`},
+		{"UnknownScope", `{"name":"ci","scopes":["root"]}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := CallsHandler{Repository: &mockApiKeyRepository{}}
+			rw := httptest.NewRecorder()
+			handler.CreateApiKey(rw, httptest.NewRequest(http.MethodPost, "/admin/keys", strings.NewReader(tc.body)))
+			if rw.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
+			}
+		})
+	}
+}
+
+func TestGetApiKeys(t *testing.T) {
+	handler := CallsHandler{Repository: &mockApiKeyRepository{Keys: []repositories.ApiKey{
+		{Id: keyId, Name: "ci", Scopes: []string{"read"}, Hash: "secret-hash"},
+	}}}
+	rw := httptest.NewRecorder()
+	handler.GetApiKeys(rw, httptest.NewRequest(http.MethodGet, "/admin/keys", nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rw.Code)
+	}
+	if strings.Contains(rw.Body.String(), "secret-hash") {
+		t.Error("hash must not be returned")
+	}
+	var keys []repositories.ApiKey
+	if err := json.Unmarshal(rw.Body.Bytes(), &keys); err != nil || len(keys) != 1 {
+		t.Errorf("unexpected body %s", rw.Body.String())
+	}
+}
+
+func TestGetApiKeys_RepositoryError(t *testing.T) {
+	handler := CallsHandler{Repository: &mockApiKeyRepository{Err: errors.New("boom")}}
+	rw := httptest.NewRecorder()
+	handler.GetApiKeys(rw, httptest.NewRequest(http.MethodGet, "/admin/keys", nil))
+	if rw.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rw.Code)
+	}
+}
+
+func TestDeleteApiKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		status int
+	}{
+		{"Deleted", keyId, http.StatusNoContent},
+		{"NotFound", "0d6f0c55-8d0f-4a9b-a3a4-7e6e5b0f5a10", http.StatusNotFound},
+		{"InvalidId", "nope", http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := CallsHandler{Repository: &mockApiKeyRepository{Keys: []repositories.ApiKey{{Id: keyId}}}}
+			req := httptest.NewRequest(http.MethodDelete, "/admin/keys/"+tc.id, nil)
+			req.SetPathValue("id", tc.id)
+			rw := httptest.NewRecorder()
+			handler.DeleteApiKey(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, rw.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package apikeys
+
+import "service-atlas/repositories"
+
+type CallsHandler struct {
+	Repository repositories.ApiKeyRepository
+}
+
+func New(repository repositories.ApiKeyRepository) *CallsHandler {
+	return &CallsHandler{
+		Repository: repository,
+	}
+}
@@ -0,0 +1,52 @@
+package apikeys
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"time"
+)
+
+// CreatedApiKey is the response to creating a key. It is the only time the key itself is returned.
+type CreatedApiKey struct {
+	repositories.ApiKey
+	Key string `json:"key"`
+}
+
+func (c CallsHandler) CreateApiKey(rw http.ResponseWriter, r *http.Request) {
+	apiKey := &repositories.ApiKey{}
+	err := json.NewDecoder(r.Body).Decode(apiKey)
+	if err != nil {
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
+		return
+	}
+	if err = apiKey.Validate(); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	key, hash, err := auth.GenerateKey()
+	if err != nil {
+		http.Error(rw, "Error generating api key", http.StatusInternalServerError)
+		return
+	}
+	apiKey.Hash = hash
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	apiKey.Id, err = c.Repository.CreateApiKey(ctxWithTimeout, *apiKey)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	apiKey.Created = time.Now().UTC()
+	internal.LoggerFromContext(r.Context()).Info("Created api key",
+		slog.String("id", apiKey.Id), slog.Any("scopes", apiKey.Scopes))
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(rw).Encode(CreatedApiKey{ApiKey: *apiKey, Key: key})
+}
@@ -0,0 +1,24 @@
+package apikeys
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"time"
+)
+
+func (c CallsHandler) DeleteApiKey(rw http.ResponseWriter, r *http.Request) {
+	id, ok := internal.GetGuidFromRequestPath("id", r)
+	if !ok {
+		http.Error(rw, "Invalid api key ID", http.StatusBadRequest)
+		return
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	if err := c.Repository.DeleteApiKey(ctxWithTimeout, id); err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
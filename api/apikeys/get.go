@@ -0,0 +1,25 @@
+package apikeys
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"time"
+)
+
+func (c CallsHandler) GetApiKeys(rw http.ResponseWriter, r *http.Request) {
+	page, err := pagination.FromRequest(r, pagination.Unlimited)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	keys, err := c.Repository.GetApiKeys(ctxWithTimeout, page)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	pagination.Write(rw, r, keys)
+}
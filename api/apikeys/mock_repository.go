@@ -0,0 +1,54 @@
+package apikeys
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+)
+
+type mockApiKeyRepository struct {
+	Err     error
+	Keys    []repositories.ApiKey
+	Created *repositories.ApiKey
+}
+
+func (repo *mockApiKeyRepository) CreateApiKey(_ context.Context, key repositories.ApiKey) (string, error) {
+	if repo.Err != nil {
+		return "", repo.Err
+	}
+	repo.Created = &key
+	return "6f1ae9d4-0c1b-4a8e-9a57-3c1f7d0e2b11", nil
+}
+
+func (repo *mockApiKeyRepository) GetApiKeys(_ context.Context, page pagination.Page) (pagination.Result[repositories.ApiKey], error) {
+	if repo.Err != nil {
+		return pagination.Result[repositories.ApiKey]{}, repo.Err
+	}
+	return pagination.Slice(repo.Keys, page), nil
+}
+
+func (repo *mockApiKeyRepository) GetApiKeyByHash(_ context.Context, hash string) (*repositories.ApiKey, error) {
+	if repo.Err != nil {
+		return nil, repo.Err
+	}
+	for _, k := range repo.Keys {
+		if k.Hash == hash {
+			return &k, nil
+		}
+	}
+	return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+}
+
+func (repo *mockApiKeyRepository) DeleteApiKey(_ context.Context, id string) error {
+	if repo.Err != nil {
+		return repo.Err
+	}
+	for _, k := range repo.Keys {
+		if k.Id == id {
+			return nil
+		}
+	}
+	return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+}
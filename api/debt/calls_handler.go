@@ -1,18 +1,13 @@
 package debt
 
-import (
-	"service-atlas/neo4jrepositories/debtrepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type CallsHandler struct {
 	Repository repositories.DebtRepository
 }
 
-func New(driver neo4j.DriverWithContext) *CallsHandler {
+func New(repository repositories.DebtRepository) *CallsHandler {
 	return &CallsHandler{
-		Repository: debtrepository.New(driver),
+		Repository: repository,
 	}
 }
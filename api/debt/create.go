@@ -20,11 +20,9 @@ func (c CallsHandler) CreateDebt(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 	debt := &repositories.Debt{}
-	const maxBodySize = 1 << 20 // 1 MB
-	r.Body = http.MaxBytesReader(rw, r.Body, maxBodySize)
 	err := json.NewDecoder(r.Body).Decode(debt)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	debt.ServiceId = id
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 	"testing"
 )
@@ -239,10 +240,11 @@ func TestGetDebtByServiceId_OnlyResolved(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		got, err := mock.GetDebtByServiceId(ctx, tt.serviceId, 0, 10, tt.onlyResolved)
+		page, err := mock.GetDebtByServiceId(ctx, tt.serviceId, pagination.First(10), tt.onlyResolved)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
+		got := page.Items
 		if len(got) != tt.wantCount {
 			t.Errorf("GetDebtByServiceId(%q, onlyResolved=%v) got %d debts, want %d",
 				tt.serviceId, tt.onlyResolved, len(got), tt.wantCount)
@@ -2,6 +2,9 @@ package debt
 
 import (
 	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 )
 
@@ -28,9 +31,21 @@ func (repo mockDebtRepository) UpdateStatus(_ context.Context, _, _ string) erro
 	return nil
 }
 
-func (repo mockDebtRepository) GetDebtByServiceId(_ context.Context, id string, _, _ int, onlyResolved bool) ([]repositories.Debt, error) {
+func (repo mockDebtRepository) GetDebtById(_ context.Context, id string) (repositories.Debt, error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return repositories.Debt{}, repo.Err
+	}
+	for _, d := range repo.Debts {
+		if d.Id == id {
+			return d, nil
+		}
+	}
+	return repositories.Debt{}, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+}
+
+func (repo mockDebtRepository) GetDebtByServiceId(_ context.Context, id string, page pagination.Page, onlyResolved bool) (pagination.Result[repositories.Debt], error) {
+	if repo.Err != nil {
+		return pagination.Result[repositories.Debt]{}, repo.Err
 	}
 	var debts []repositories.Debt
 	for _, d := range repo.Debts {
@@ -44,6 +59,6 @@ func (repo mockDebtRepository) GetDebtByServiceId(_ context.Context, id string,
 			}
 		}
 	}
-	return debts, nil
+	return pagination.Slice(debts, page), nil
 
 }
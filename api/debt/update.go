@@ -18,7 +18,7 @@ func (c CallsHandler) UpdateDebtStatus(rw http.ResponseWriter, r *http.Request)
 	body := map[string]string{}
 	err := json.NewDecoder(r.Body).Decode(&body)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusInternalServerError))
 		return
 	}
 	if _, ok := body["status"]; !ok || !internal.DebtStatus.IsMember(body["status"]) {
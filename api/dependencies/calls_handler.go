@@ -1,18 +1,13 @@
 package dependencies
 
-import (
-	"service-atlas/neo4jrepositories/dependencyrepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type ServiceCallsHandler struct {
 	Repository repositories.DependencyRepository
 }
 
-func New(driver neo4j.DriverWithContext) *ServiceCallsHandler {
+func New(repository repositories.DependencyRepository) *ServiceCallsHandler {
 	return &ServiceCallsHandler{
-		Repository: dependencyrepository.New(driver),
+		Repository: repository,
 	}
 }
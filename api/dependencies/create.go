@@ -17,7 +17,7 @@ func (s *ServiceCallsHandler) CreateDependency(rw http.ResponseWriter, req *http
 	dep := &repositories.Dependency{}
 	err := json.NewDecoder(req.Body).Decode(dep)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	if err := dep.Validate(); err != nil {
@@ -1,12 +1,10 @@
 package dependencies
 
 import (
-	"encoding/json"
-	"log/slog"
 	"net/http"
 	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
-	"service-atlas/repositories"
+	"service-atlas/internal/pagination"
 )
 
 func (s *ServiceCallsHandler) GetDependencies(rw http.ResponseWriter, req *http.Request) {
@@ -16,19 +14,17 @@ func (s *ServiceCallsHandler) GetDependencies(rw http.ResponseWriter, req *http.
 		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
 	}
-	dep, err := s.Repository.GetDependencies(req.Context(), id)
+	page, err := pagination.FromRequest(req, pagination.Unlimited)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(dep)
+	dep, err := s.Repository.GetDependencies(req.Context(), id, page)
 	if err != nil {
-		logger := internal.LoggerFromContext(req.Context())
-		logger.Debug("Error encoding dependencies json",
-			slog.String("error", err.Error()),
-		)
+		customerrors.HandleError(rw, err)
+		return
 	}
+	pagination.Write(rw, req, dep)
 }
 
 func (s *ServiceCallsHandler) GetDependents(rw http.ResponseWriter, req *http.Request) {
@@ -37,29 +33,15 @@ func (s *ServiceCallsHandler) GetDependents(rw http.ResponseWriter, req *http.Re
 		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
 		return
 	}
-	deps, err := s.Repository.GetDependents(req.Context(), id)
+	page, err := pagination.FromRequest(req, pagination.Unlimited)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	ver := req.URL.Query().Get("version")
-	returnObj := make([]*repositories.Dependency, 0)
-	if ver != "" {
-		for _, dep := range deps {
-			if ver == dep.Version {
-				returnObj = append(returnObj, dep)
-			}
-		}
-	} else {
-		returnObj = append(returnObj, deps...)
-	}
-
-	err = json.NewEncoder(rw).Encode(returnObj)
+	deps, err := s.Repository.GetDependents(req.Context(), id, req.URL.Query().Get("version"), page)
 	if err != nil {
-		logger := internal.LoggerFromContext(req.Context())
-		logger.Debug("Error encoding dependencies json",
-			slog.String("error", err.Error()),
-		)
+		customerrors.HandleError(rw, err)
+		return
 	}
+	pagination.Write(rw, req, deps)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 )
 
@@ -24,9 +25,9 @@ func (repo mockDependencyRepository) AddDependency(_ context.Context, _ string,
 	return nil
 }
 
-func (repo mockDependencyRepository) GetDependencies(_ context.Context, _ string) ([]*repositories.Dependency, error) {
+func (repo mockDependencyRepository) GetDependencies(_ context.Context, _ string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[*repositories.Dependency]{}, repo.Err
 	}
 
 	// Convert the mock data to the expected return type
@@ -49,12 +50,12 @@ func (repo mockDependencyRepository) GetDependencies(_ context.Context, _ string
 		dependencies = append(dependencies, dep)
 	}
 
-	return dependencies, nil
+	return pagination.Slice(dependencies, page), nil
 }
 
-func (repo mockDependencyRepository) GetDependents(_ context.Context, _ string) ([]*repositories.Dependency, error) {
+func (repo mockDependencyRepository) GetDependents(_ context.Context, _ string, version string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[*repositories.Dependency]{}, repo.Err
 	}
 
 	// Convert the mock data to the expected return type
@@ -74,10 +75,12 @@ func (repo mockDependencyRepository) GetDependents(_ context.Context, _ string)
 			dep.Version = version
 		}
 
-		dependencies = append(dependencies, dep)
+		if version == "" || dep.Version == version {
+			dependencies = append(dependencies, dep)
+		}
 	}
 
-	return dependencies, nil
+	return pagination.Slice(dependencies, page), nil
 }
 
 func (repo mockDependencyRepository) DeleteDependency(_ context.Context, id string, dependsOnID string) error {
@@ -0,0 +1,44 @@
+package graph
+
+import (
+	"service-atlas/repositories"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// maxDepth bounds how deeply a query may nest, since every level can fan out across the graph.
+	maxDepth = 12
+	// maxParallelism is the number of fields resolved concurrently; it must be high enough for
+	// sibling fields to land in the same loader batch.
+	maxParallelism = 100
+)
+
+// CallsHandler serves GraphQL queries over the catalog.
+type CallsHandler struct {
+	ServiceRepository repositories.ServiceRepository
+	TeamRepository    repositories.TeamRepository
+	ReleaseRepository repositories.ReleaseRepository
+	ReportRepository  repositories.ReportRepository
+	GraphRepository   repositories.GraphRepository
+
+	schema *graphql.Schema
+}
+
+func New(repos repositories.Repositories) *CallsHandler {
+	return newCallsHandler(&CallsHandler{
+		ServiceRepository: repos.Services,
+		TeamRepository:    repos.Teams,
+		ReleaseRepository: repos.Releases,
+		ReportRepository:  repos.Reports,
+		GraphRepository:   repos.Graph,
+	})
+}
+
+func newCallsHandler(h *CallsHandler) *CallsHandler {
+	h.schema = graphql.MustParseSchema(schema, &queryResolver{h: h},
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	return h
+}
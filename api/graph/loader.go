@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBatchWait is how long a loader collects keys before fetching them in one batch.
+	defaultBatchWait = time.Millisecond
+	// defaultMaxBatch is the most keys fetched in one batch.
+	defaultMaxBatch = 100
+)
+
+// batchFunc fetches the values for many keys at once. Keys missing from the returned map resolve to the zero value.
+type batchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// loader coalesces concurrent Load calls made while resolving a single request into batched fetches,
+// and caches every result for the rest of the request.
+type loader[K comparable, V any] struct {
+	ctx      context.Context
+	fetch    batchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	cache map[K]*loaderResult[V]
+	batch *loaderBatch[K, V]
+}
+
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+	once    sync.Once
+}
+
+func newLoader[K comparable, V any](ctx context.Context, fetch batchFunc[K, V]) *loader[K, V] {
+	return &loader[K, V]{
+		ctx:      ctx,
+		fetch:    fetch,
+		wait:     defaultBatchWait,
+		maxBatch: defaultMaxBatch,
+		cache:    make(map[K]*loaderResult[V]),
+	}
+}
+
+// Load returns the value for key, waiting for the batch it joins to be fetched.
+func (l *loader[K, V]) Load(key K) (V, error) {
+	l.mu.Lock()
+	result, ok := l.cache[key]
+	if !ok {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.cache[key] = result
+		if l.batch == nil {
+			b := &loaderBatch[K, V]{}
+			l.batch = b
+			time.AfterFunc(l.wait, func() { l.dispatch(b) })
+		}
+		b := l.batch
+		b.keys = append(b.keys, key)
+		b.results = append(b.results, result)
+		if len(b.keys) >= l.maxBatch {
+			l.batch = nil
+			go l.dispatch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-l.ctx.Done():
+		var zero V
+		return zero, l.ctx.Err()
+	}
+}
+
+// dispatch fetches a batch once, whichever of the timer or a full batch triggers it first.
+func (l *loader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	b.once.Do(func() {
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+
+		values, err := l.fetch(l.ctx, b.keys)
+		for i, key := range b.keys {
+			if err != nil {
+				b.results[i].err = err
+			} else {
+				b.results[i].value = values[key]
+			}
+			close(b.results[i].done)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLoader_BatchesConcurrentLoads(t *testing.T) {
+	var calls atomic.Int32
+	var batchSize atomic.Int32
+	l := newLoader(context.Background(), func(_ context.Context, keys []int) (map[int]int, error) {
+		calls.Add(1)
+		batchSize.Store(int32(len(keys)))
+		values := make(map[int]int, len(keys))
+		for _, k := range keys {
+			values[k] = k * 10
+		}
+		return values, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 5; i++ {
+		wg.Add(1)
+		go func(key int) {
+			defer wg.Done()
+			value, err := l.Load(key)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if value != key*10 {
+				t.Errorf("Load(%d) = %d, want %d", key, value, key*10)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 batch fetch, got %d", calls.Load())
+	}
+	if batchSize.Load() != 5 {
+		t.Errorf("expected a batch of 5 keys, got %d", batchSize.Load())
+	}
+}
+
+func TestLoader_CachesResults(t *testing.T) {
+	var calls atomic.Int32
+	l := newLoader(context.Background(), func(_ context.Context, keys []string) (map[string]string, error) {
+		calls.Add(1)
+		return map[string]string{"a": "A"}, nil
+	})
+	for i := 0; i < 3; i++ {
+		if value, _ := l.Load("a"); value != "A" {
+			t.Fatalf("unexpected value %q", value)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected cached result after first fetch, got %d fetches", calls.Load())
+	}
+}
+
+func TestLoader_MissingKeyIsZeroValue(t *testing.T) {
+	l := newLoader(context.Background(), func(_ context.Context, keys []string) (map[string][]int, error) {
+		return map[string][]int{}, nil
+	})
+	value, err := l.Load("missing")
+	if err != nil || value != nil {
+		t.Errorf("expected nil value and no error, got %v, %v", value, err)
+	}
+}
+
+func TestLoader_PropagatesError(t *testing.T) {
+	l := newLoader(context.Background(), func(_ context.Context, keys []string) (map[string]int, error) {
+		return nil, errors.New("boom")
+	})
+	if _, err := l.Load("a"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestLoader_SplitsAtMaxBatch(t *testing.T) {
+	var calls atomic.Int32
+	l := newLoader(context.Background(), func(_ context.Context, keys []int) (map[int]int, error) {
+		calls.Add(1)
+		if len(keys) > 2 {
+			t.Errorf("batch of %d exceeds max batch", len(keys))
+		}
+		return map[int]int{}, nil
+	})
+	l.maxBatch = 2
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(key int) {
+			defer wg.Done()
+			_, _ = l.Load(key)
+		}(i)
+	}
+	wg.Wait()
+	if calls.Load() < 2 {
+		t.Errorf("expected at least 2 batches, got %d", calls.Load())
+	}
+}
+
+func TestLoader_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	block := make(chan struct{})
+	l := newLoader(ctx, func(_ context.Context, keys []int) (map[int]int, error) {
+		<-block
+		return map[int]int{}, nil
+	})
+	cancel()
+	if _, err := l.Load(1); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	close(block)
+}
@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"service-atlas/repositories"
+)
+
+type loadersKey struct{}
+
+// releaseKey groups release lookups by limit, since every service in one batch must share the same limit.
+type releaseKey struct {
+	serviceId string
+	limit     int
+}
+
+// loaders holds the batch loaders for a single GraphQL request.
+type loaders struct {
+	services     *loader[string, repositories.Service]
+	dependencies *loader[string, []*repositories.Dependency]
+	dependents   *loader[string, []*repositories.Dependency]
+	owners       *loader[string, []repositories.Team]
+	releases     *loader[releaseKey, []*repositories.Release]
+	debt         *loader[string, []repositories.Debt]
+	teamServices *loader[string, []repositories.Service]
+}
+
+func newLoaders(ctx context.Context, repo repositories.GraphRepository) *loaders {
+	return &loaders{
+		services:     newLoader(ctx, repo.GetServicesByIds),
+		dependencies: newLoader(ctx, repo.GetDependenciesByServiceIds),
+		dependents:   newLoader(ctx, repo.GetDependentsByServiceIds),
+		owners:       newLoader(ctx, repo.GetTeamsByServiceIds),
+		releases: newLoader(ctx, func(ctx context.Context, keys []releaseKey) (map[releaseKey][]*repositories.Release, error) {
+			byLimit := make(map[int][]string)
+			for _, k := range keys {
+				byLimit[k.limit] = append(byLimit[k.limit], k.serviceId)
+			}
+			values := make(map[releaseKey][]*repositories.Release, len(keys))
+			for limit, ids := range byLimit {
+				releases, err := repo.GetReleasesByServiceIds(ctx, ids, limit)
+				if err != nil {
+					return nil, err
+				}
+				for id, r := range releases {
+					values[releaseKey{serviceId: id, limit: limit}] = r
+				}
+			}
+			return values, nil
+		}),
+		debt:         newLoader(ctx, repo.GetDebtByServiceIds),
+		teamServices: newLoader(ctx, repo.GetServicesByTeamIds),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
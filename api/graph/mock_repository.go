@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"sync"
+	"time"
+)
+
+// mockCatalog is an in-memory catalog implementing every repository the GraphQL handler reads from.
+// It counts the calls made to each method so tests can assert that nested fields are batched.
+type mockCatalog struct {
+	Services     []repositories.Service
+	Teams        []repositories.Team
+	Owners       map[string][]string // service id -> team ids
+	Dependencies map[string][]*repositories.Dependency
+	Releases     map[string][]*repositories.Release
+	Debt         map[string][]repositories.Debt
+	Err          error
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (m *mockCatalog) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	m.calls[method]++
+}
+
+func (m *mockCatalog) Calls(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
+func (m *mockCatalog) service(id string) (repositories.Service, bool) {
+	for _, svc := range m.Services {
+		if svc.Id == id {
+			return svc, true
+		}
+	}
+	return repositories.Service{}, false
+}
+
+func (m *mockCatalog) GetServiceById(_ context.Context, id string) (repositories.Service, error) {
+	m.record("GetServiceById")
+	svc, _ := m.service(id)
+	return svc, m.Err
+}
+
+func (m *mockCatalog) GetAllServices(_ context.Context, _ repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	m.record("GetAllServices")
+	return pagination.Slice(m.Services, page), m.Err
+}
+
+func (m *mockCatalog) Search(_ context.Context, _ searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	m.record("Search")
+	return pagination.Slice(m.Services, page), m.Err
+}
+
+func (m *mockCatalog) CreateService(_ context.Context, _ repositories.Service) (string, error) {
+	return "", nil
+}
+
+func (m *mockCatalog) UpdateService(_ context.Context, _ repositories.Service) error {
+	return nil
+}
+
+func (m *mockCatalog) DeleteService(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockCatalog) GetTeamsByServiceId(_ context.Context, _ string, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	return pagination.NewResult([]repositories.Team{}, page), nil
+}
+
+func (m *mockCatalog) GetServicesByUrl(_ context.Context, _ string) ([]repositories.Service, error) {
+	return nil, nil
+}
+
+func (m *mockCatalog) GetTeam(_ context.Context, teamId string) (*repositories.Team, error) {
+	m.record("GetTeam")
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	for _, t := range m.Teams {
+		if t.Id == teamId {
+			return &t, nil
+		}
+	}
+	return nil, customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Team not found"}
+}
+
+func (m *mockCatalog) GetTeams(_ context.Context, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	m.record("GetTeams")
+	return pagination.Slice(m.Teams, page), m.Err
+}
+
+func (m *mockCatalog) CreateTeam(_ context.Context, _ repositories.Team) (string, error) {
+	return "", nil
+}
+
+func (m *mockCatalog) UpdateTeam(_ context.Context, _ repositories.Team) error {
+	return nil
+}
+
+func (m *mockCatalog) DeleteTeam(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockCatalog) CreateTeamAssociation(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockCatalog) DeleteTeamAssociation(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockCatalog) CreateRelease(_ context.Context, _ repositories.Release) error {
+	return nil
+}
+
+func (m *mockCatalog) GetReleasesByServiceId(_ context.Context, _ string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
+	return pagination.Slice([]*repositories.Release{}, page), nil
+}
+
+func (m *mockCatalog) GetReleasesInDateRange(_ context.Context, startDate, endDate time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
+	m.record("GetReleasesInDateRange")
+	infos := make([]*repositories.ServiceReleaseInfo, 0)
+	for _, releases := range m.Releases {
+		for _, r := range releases {
+			if !r.ReleaseDate.Before(startDate) && !r.ReleaseDate.After(endDate) {
+				infos = append(infos, &repositories.ServiceReleaseInfo{Release: *r})
+			}
+		}
+	}
+	return pagination.Slice(infos, page), m.Err
+}
+
+func (m *mockCatalog) GetServiceRiskReport(_ context.Context, _ string) (*repositories.ServiceRiskReport, error) {
+	return nil, nil
+}
+
+func (m *mockCatalog) GetServicesByTeam(_ context.Context, _ string, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	return pagination.Slice([]repositories.Service{}, page), nil
+}
+
+func (m *mockCatalog) GetDebtCountByService(_ context.Context, page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+	m.record("GetDebtCountByService")
+	report := make([]repositories.ServiceDebtReport, 0)
+	for id, debt := range m.Debt {
+		report = append(report, repositories.ServiceDebtReport{Id: id, Count: int64(len(debt))})
+	}
+	return pagination.Slice(report, page), m.Err
+}
+
+func (m *mockCatalog) GetCatalogCounts(_ context.Context) (*repositories.CatalogCounts, error) {
+	return &repositories.CatalogCounts{}, nil
+}
+
+func (m *mockCatalog) GetIntegrityReport(_ context.Context) (*repositories.IntegrityReport, error) {
+	return &repositories.IntegrityReport{}, nil
+}
+
+func (m *mockCatalog) GetServicesByIds(_ context.Context, ids []string) (map[string]repositories.Service, error) {
+	m.record("GetServicesByIds")
+	values := make(map[string]repositories.Service)
+	for _, id := range ids {
+		if svc, ok := m.service(id); ok {
+			values[id] = svc
+		}
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDependenciesByServiceIds(_ context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	m.record("GetDependenciesByServiceIds")
+	values := make(map[string][]*repositories.Dependency)
+	for _, id := range ids {
+		values[id] = m.Dependencies[id]
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDependentsByServiceIds(_ context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	m.record("GetDependentsByServiceIds")
+	values := make(map[string][]*repositories.Dependency)
+	for _, id := range ids {
+		for serviceId, dependencies := range m.Dependencies {
+			for _, d := range dependencies {
+				if d.Id == id {
+					svc, _ := m.service(serviceId)
+					values[id] = append(values[id], &repositories.Dependency{Id: svc.Id, Name: svc.Name, ServiceType: svc.ServiceType})
+				}
+			}
+		}
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetTeamsByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Team, error) {
+	m.record("GetTeamsByServiceIds")
+	values := make(map[string][]repositories.Team)
+	for _, id := range ids {
+		for _, teamId := range m.Owners[id] {
+			for _, t := range m.Teams {
+				if t.Id == teamId {
+					values[id] = append(values[id], t)
+				}
+			}
+		}
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetReleasesByServiceIds(_ context.Context, ids []string, limit int) (map[string][]*repositories.Release, error) {
+	m.record("GetReleasesByServiceIds")
+	values := make(map[string][]*repositories.Release)
+	for _, id := range ids {
+		releases := m.Releases[id]
+		if len(releases) > limit {
+			releases = releases[:limit]
+		}
+		values[id] = releases
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDebtByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Debt, error) {
+	m.record("GetDebtByServiceIds")
+	values := make(map[string][]repositories.Debt)
+	for _, id := range ids {
+		values[id] = m.Debt[id]
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetServicesByTeamIds(_ context.Context, ids []string) (map[string][]repositories.Service, error) {
+	m.record("GetServicesByTeamIds")
+	values := make(map[string][]repositories.Service)
+	for _, id := range ids {
+		for serviceId, teamIds := range m.Owners {
+			for _, teamId := range teamIds {
+				if teamId == id {
+					svc, _ := m.service(serviceId)
+					values[id] = append(values[id], svc)
+				}
+			}
+		}
+	}
+	return values, m.Err
+}
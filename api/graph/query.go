@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"time"
+)
+
+// maxQueryBytes bounds the size of a GraphQL request body.
+const maxQueryBytes = 1 << 20
+
+type queryRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Query executes a GraphQL query sent as a JSON POST body, or as query parameters on GET.
+// As is conventional for GraphQL, resolver errors are reported in the response body with a 200 status.
+func (h *CallsHandler) Query(rw http.ResponseWriter, req *http.Request) {
+	logger := internal.LoggerFromContext(req.Context())
+	var params queryRequest
+	switch req.Method {
+	case http.MethodGet:
+		params.Query = req.URL.Query().Get("query")
+		params.OperationName = req.URL.Query().Get("operationName")
+		if variables := req.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &params.Variables); err != nil {
+				http.Error(rw, "variables must be a JSON object", http.StatusBadRequest)
+				return
+			}
+		}
+	default:
+		if err := json.NewDecoder(http.MaxBytesReader(rw, req.Body, maxQueryBytes)).Decode(&params); err != nil {
+			http.Error(rw, "Invalid request body", internal.BodyErrorStatus(err, http.StatusBadRequest))
+			return
+		}
+	}
+	if params.Query == "" {
+		http.Error(rw, "query is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+	defer cancel()
+	ctx = withLoaders(ctx, newLoaders(ctx, h.GraphRepository))
+
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	for _, err := range response.Errors {
+		logger.Debug("GraphQL query error", slog.String("error", err.Error()))
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		logger.Debug("Error encoding GraphQL response json",
+			slog.String("error", err.Error()))
+	}
+}
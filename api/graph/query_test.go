@@ -0,0 +1,262 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	cartId  = "11111111-1111-1111-1111-111111111111"
+	dbId    = "22222222-2222-2222-2222-222222222222"
+	cacheId = "33333333-3333-3333-3333-333333333333"
+	shopId  = "44444444-4444-4444-4444-444444444444"
+	dataId  = "55555555-5555-5555-5555-555555555555"
+)
+
+func newTestCatalog() *mockCatalog {
+	return &mockCatalog{
+		Services: []repositories.Service{
+			{Id: cartId, Name: "cart", ServiceType: "api", Url: "https://cart"},
+			{Id: dbId, Name: "cart-db", ServiceType: "database", Url: "https://cart-db"},
+			{Id: cacheId, Name: "cache", ServiceType: "cache", Url: "https://cache"},
+		},
+		Teams: []repositories.Team{
+			{Id: shopId, Name: "Shop"},
+			{Id: dataId, Name: "Data"},
+		},
+		Owners: map[string][]string{
+			cartId:  {shopId},
+			dbId:    {dataId},
+			cacheId: {dataId},
+		},
+		Dependencies: map[string][]*repositories.Dependency{
+			cartId: {
+				{Id: dbId, Name: "cart-db", ServiceType: "database", Version: "16"},
+				{Id: cacheId, Name: "cache", ServiceType: "cache", Version: "7"},
+			},
+		},
+		Releases: map[string][]*repositories.Release{
+			cartId: {
+				{ServiceId: cartId, Version: "1.2.0", ReleaseDate: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)},
+				{ServiceId: cartId, Version: "1.1.0", ReleaseDate: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+				{ServiceId: cartId, Version: "1.0.0", ReleaseDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		Debt: map[string][]repositories.Debt{
+			dbId: {
+				{Id: "d1", ServiceId: dbId, Title: "no backups", Type: "operations", Status: "pending"},
+				{Id: "d2", ServiceId: dbId, Title: "old version", Type: "upgrade", Status: "resolved"},
+			},
+		},
+	}
+}
+
+type queryResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func executeQuery(t *testing.T, catalog *mockCatalog, query string, variables map[string]any) queryResponse {
+	t.Helper()
+	handler := newCallsHandler(&CallsHandler{
+		ServiceRepository: catalog,
+		TeamRepository:    catalog,
+		ReleaseRepository: catalog,
+		ReportRepository:  catalog,
+		GraphRepository:   catalog,
+	})
+	body, _ := json.Marshal(queryRequest{Query: query, Variables: variables})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	rw := httptest.NewRecorder()
+	handler.Query(rw, req)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, rw.Code, rw.Body.String())
+	}
+	var response queryResponse
+	if err := json.Unmarshal(rw.Body.Bytes(), &response); err != nil {
+		t.Fatalf("invalid response json: %v", err)
+	}
+	return response
+}
+
+func TestQuery_NestedServicePage(t *testing.T) {
+	catalog := newTestCatalog()
+	response := executeQuery(t, catalog, `query ($id: ID!) {
+		service(id: $id) {
+			name
+			owners { name }
+			dependencies { name version owners { name } }
+			releases(last: 2) { version }
+		}
+	}`, map[string]any{"id": cartId})
+	if len(response.Errors) != 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+
+	var data struct {
+		Service struct {
+			Name         string
+			Owners       []struct{ Name string }
+			Dependencies []struct {
+				Name    string
+				Version string
+				Owners  []struct{ Name string }
+			}
+			Releases []struct{ Version string }
+		}
+	}
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		t.Fatal(err)
+	}
+	svc := data.Service
+	if svc.Name != "cart" || len(svc.Owners) != 1 || svc.Owners[0].Name != "Shop" {
+		t.Errorf("unexpected service %+v", svc)
+	}
+	if len(svc.Dependencies) != 2 {
+		t.Fatalf("expected 2 dependencies, got %+v", svc.Dependencies)
+	}
+	for _, d := range svc.Dependencies {
+		if len(d.Owners) != 1 || d.Owners[0].Name != "Data" {
+			t.Errorf("unexpected owners for %s: %+v", d.Name, d.Owners)
+		}
+	}
+	if len(svc.Releases) != 2 || svc.Releases[0].Version != "1.2.0" {
+		t.Errorf("unexpected releases %+v", svc.Releases)
+	}
+}
+
+func TestQuery_BatchesNestedLookups(t *testing.T) {
+	catalog := newTestCatalog()
+	response := executeQuery(t, catalog, `{
+		services {
+			owners { name services { name } }
+			dependencies { owners { name } }
+			releases(last: 1) { version }
+		}
+	}`, nil)
+	if len(response.Errors) != 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+
+	// owners are resolved at two levels (services, then dependencies), each in one batch
+	if calls := catalog.Calls("GetTeamsByServiceIds"); calls > 2 {
+		t.Errorf("expected owners to be batched per level, got %d calls", calls)
+	}
+	for _, method := range []string{"GetDependenciesByServiceIds", "GetReleasesByServiceIds", "GetServicesByTeamIds"} {
+		if calls := catalog.Calls(method); calls != 1 {
+			t.Errorf("expected 1 call to %s, got %d", method, calls)
+		}
+	}
+}
+
+func TestQuery_ServiceNotFound(t *testing.T) {
+	response := executeQuery(t, newTestCatalog(), `{ service(id: "missing") { name } team(id: "missing") { name } }`, nil)
+	if len(response.Errors) != 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+	if string(response.Data) != `{"service":null,"team":null}` {
+		t.Errorf("unexpected data %s", response.Data)
+	}
+}
+
+func TestQuery_DebtAndRisk(t *testing.T) {
+	response := executeQuery(t, newTestCatalog(), `query ($id: ID!) {
+		service(id: $id) {
+			debt(status: "pending") { title service { name } }
+			risk { dependentCount debtCount { type count } }
+		}
+	}`, map[string]any{"id": dbId})
+	if len(response.Errors) != 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+	want := `{"service":{"debt":[{"title":"no backups","service":{"name":"cart-db"}}],` +
+		`"risk":{"dependentCount":1,"debtCount":[{"type":"operations","count":1},{"type":"upgrade","count":1}]}}}`
+	if string(response.Data) != want {
+		t.Errorf("unexpected data\n got %s\nwant %s", response.Data, want)
+	}
+}
+
+func TestQuery_TeamServices(t *testing.T) {
+	response := executeQuery(t, newTestCatalog(), `{ teams { name services { name } } }`, nil)
+	if len(response.Errors) != 0 {
+		t.Fatalf("unexpected errors: %+v", response.Errors)
+	}
+	if !strings.Contains(string(response.Data), `{"name":"Shop","services":[{"name":"cart"}]}`) {
+		t.Errorf("unexpected data %s", response.Data)
+	}
+}
+
+func TestQuery_ArgumentValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"PageZero", `{ services(page: 0) { name } }`},
+		{"PageSizeTooLarge", `{ teams(pageSize: 101) { name } }`},
+		{"ReleasesLastTooLarge", `{ services { releases(last: 500) { version } } }`},
+		{"EmptySearch", `{ searchServices(query: "") { name } }`},
+		{"InvertedDateRange", `{ releases(startDate: "2025-02-01T00:00:00Z", endDate: "2025-01-01T00:00:00Z") { version } }`},
+		{"UnknownField", `{ services { owner } }`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response := executeQuery(t, newTestCatalog(), tc.query, nil)
+			if len(response.Errors) == 0 {
+				t.Errorf("expected errors, got data %s", response.Data)
+			}
+		})
+	}
+}
+
+func TestQuery_RepositoryError(t *testing.T) {
+	catalog := newTestCatalog()
+	catalog.Err = errors.New("database unavailable")
+	response := executeQuery(t, catalog, `{ services { name } }`, nil)
+	if len(response.Errors) != 1 || response.Errors[0].Message != "database unavailable" {
+		t.Errorf("unexpected errors %+v", response.Errors)
+	}
+}
+
+func TestQuery_Get(t *testing.T) {
+	catalog := newTestCatalog()
+	handler := newCallsHandler(&CallsHandler{ServiceRepository: catalog, GraphRepository: catalog})
+	target := "/graphql?" + url.Values{
+		"query":     {`query ($id: ID!) { service(id: $id) { name } }`},
+		"variables": {`{"id":"` + cartId + `"}`},
+	}.Encode()
+	rw := httptest.NewRecorder()
+	handler.Query(rw, httptest.NewRequest(http.MethodGet, target, nil))
+	if rw.Code != http.StatusOK || !strings.Contains(rw.Body.String(), `"name":"cart"`) {
+		t.Errorf("unexpected response %d: %s", rw.Code, rw.Body.String())
+	}
+}
+
+func TestQuery_BadRequests(t *testing.T) {
+	handler := newCallsHandler(&CallsHandler{})
+	tests := []struct {
+		name string
+		req  *http.Request
+	}{
+		{"InvalidJson", httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("{"))},
+		{"MissingQuery", httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader("{}"))},
+		{"InvalidVariables", httptest.NewRequest(http.MethodGet, "/graphql?query=%7Bteams%7Bname%7D%7D&variables=nope", nil)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			handler.Query(rw, tc.req)
+			if rw.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"sort"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// maxPageSize and maxReleases mirror the limits of the REST endpoints, and maxSearchResults the number of matches
+// searchServices has always returned.
+const (
+	maxPageSize      = pagination.MaxLimit
+	maxReleases      = 100
+	maxSearchResults = pagination.SearchLimit
+)
+
+type queryResolver struct {
+	h *CallsHandler
+}
+
+type pageArgs struct {
+	Page     int32
+	PageSize int32
+}
+
+func (a pageArgs) validate() error {
+	if a.Page < 1 {
+		return errors.New("page must be positive")
+	}
+	if a.PageSize < 1 || a.PageSize > maxPageSize {
+		return fmt.Errorf("pageSize must be between 1 and %d", maxPageSize)
+	}
+	return nil
+}
+
+func (a pageArgs) page() pagination.Page {
+	return pagination.FromPage(int(a.Page), int(a.PageSize))
+}
+
+func (q *queryResolver) Service(ctx context.Context, args struct{ Id graphql.ID }) (*serviceResolver, error) {
+	svc, err := q.h.ServiceRepository.GetServiceById(ctx, string(args.Id))
+	if err != nil || svc.Id == "" {
+		return nil, err
+	}
+	return newServiceResolver(svc), nil
+}
+
+func (q *queryResolver) Services(ctx context.Context, args pageArgs) ([]*serviceResolver, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	services, err := q.h.ServiceRepository.GetAllServices(ctx, repositories.ServiceFilter{}, args.page())
+	if err != nil {
+		return nil, err
+	}
+	return newServiceResolvers(services.Items), nil
+}
+
+func (q *queryResolver) SearchServices(ctx context.Context, args struct{ Query string }) ([]*serviceResolver, error) {
+	if args.Query == "" {
+		return nil, errors.New("query is required")
+	}
+	query, err := searchquery.Parse(args.Query)
+	if err != nil {
+		return nil, err
+	}
+	services, err := q.h.ServiceRepository.Search(ctx, query, pagination.First(maxSearchResults))
+	if err != nil {
+		return nil, err
+	}
+	return newServiceResolvers(services.Items), nil
+}
+
+func (q *queryResolver) Team(ctx context.Context, args struct{ Id graphql.ID }) (*teamResolver, error) {
+	team, err := q.h.TeamRepository.GetTeam(ctx, string(args.Id))
+	if err != nil {
+		var httpErr customerrors.HTTPError
+		if errors.As(err, &httpErr) && httpErr.Status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if team == nil {
+		return nil, nil
+	}
+	return &teamResolver{team: *team}, nil
+}
+
+func (q *queryResolver) Teams(ctx context.Context, args pageArgs) ([]*teamResolver, error) {
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	teams, err := q.h.TeamRepository.GetTeams(ctx, args.page())
+	if err != nil {
+		return nil, err
+	}
+	return newTeamResolvers(teams.Items), nil
+}
+
+func (q *queryResolver) Releases(ctx context.Context, args struct {
+	StartDate graphql.Time
+	EndDate   graphql.Time
+	Page      int32
+	PageSize  int32
+}) ([]*releaseResolver, error) {
+	pageArgs := pageArgs{Page: args.Page, PageSize: args.PageSize}
+	if err := pageArgs.validate(); err != nil {
+		return nil, err
+	}
+	if args.EndDate.Before(args.StartDate.Time) {
+		return nil, errors.New("endDate must not be before startDate")
+	}
+	releases, err := q.h.ReleaseRepository.GetReleasesInDateRange(ctx, args.StartDate.Time, args.EndDate.Time, pageArgs.page())
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*releaseResolver, 0, len(releases.Items))
+	for _, r := range releases.Items {
+		resolvers = append(resolvers, &releaseResolver{release: r.Release})
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) DebtReport(ctx context.Context) ([]*serviceDebtCountResolver, error) {
+	report, err := pagination.All(maxPageSize, func(page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+		return q.h.ReportRepository.GetDebtCountByService(ctx, page)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*serviceDebtCountResolver, 0, len(report))
+	for _, r := range report {
+		resolvers = append(resolvers, &serviceDebtCountResolver{report: r})
+	}
+	return resolvers, nil
+}
+
+type serviceResolver struct {
+	service repositories.Service
+}
+
+func newServiceResolver(svc repositories.Service) *serviceResolver {
+	return &serviceResolver{service: svc}
+}
+
+func newServiceResolvers(services []repositories.Service) []*serviceResolver {
+	resolvers := make([]*serviceResolver, 0, len(services))
+	for _, svc := range services {
+		resolvers = append(resolvers, newServiceResolver(svc))
+	}
+	return resolvers
+}
+
+// loadService resolves a reference to a service by id, returning nil if it no longer exists.
+func loadService(ctx context.Context, id string) (*serviceResolver, error) {
+	svc, err := loadersFromContext(ctx).services.Load(id)
+	if err != nil || svc.Id == "" {
+		return nil, err
+	}
+	return newServiceResolver(svc), nil
+}
+
+func (s *serviceResolver) Id() graphql.ID      { return graphql.ID(s.service.Id) }
+func (s *serviceResolver) Name() string        { return s.service.Name }
+func (s *serviceResolver) Type() string        { return s.service.ServiceType }
+func (s *serviceResolver) Description() string { return s.service.Description }
+func (s *serviceResolver) Url() string         { return s.service.Url }
+func (s *serviceResolver) Created() *graphql.Time {
+	return optionalTime(s.service.Created)
+}
+func (s *serviceResolver) Updated() *graphql.Time {
+	return optionalTime(s.service.Updated)
+}
+func (s *serviceResolver) Lifecycle() *string {
+	if s.service.Lifecycle == "" {
+		return nil
+	}
+	return &s.service.Lifecycle
+}
+func (s *serviceResolver) Tags() []string {
+	if s.service.Tags == nil {
+		return []string{}
+	}
+	return s.service.Tags
+}
+
+func (s *serviceResolver) Dependencies(ctx context.Context) ([]*dependencyResolver, error) {
+	return resolveDependencies(ctx, s.service.Id)
+}
+
+func (s *serviceResolver) Dependents(ctx context.Context) ([]*dependencyResolver, error) {
+	dependents, err := loadersFromContext(ctx).dependents.Load(s.service.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newDependencyResolvers(dependents), nil
+}
+
+func (s *serviceResolver) Owners(ctx context.Context) ([]*teamResolver, error) {
+	return resolveOwners(ctx, s.service.Id)
+}
+
+func (s *serviceResolver) Releases(ctx context.Context, args struct{ Last int32 }) ([]*releaseResolver, error) {
+	return resolveReleases(ctx, s.service.Id, args.Last)
+}
+
+func (s *serviceResolver) Debt(ctx context.Context, args struct{ Status *string }) ([]*debtResolver, error) {
+	debt, err := loadersFromContext(ctx).debt.Load(s.service.Id)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*debtResolver, 0, len(debt))
+	for _, d := range debt {
+		if args.Status != nil && d.Status != *args.Status {
+			continue
+		}
+		resolvers = append(resolvers, &debtResolver{debt: d})
+	}
+	return resolvers, nil
+}
+
+// Risk is derived from the batched dependents and debt of the service, matching the REST risk report.
+func (s *serviceResolver) Risk(ctx context.Context) (*riskReportResolver, error) {
+	l := loadersFromContext(ctx)
+	dependents, err := l.dependents.Load(s.service.Id)
+	if err != nil {
+		return nil, err
+	}
+	debt, err := l.debt.Load(s.service.Id)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int32)
+	for _, d := range debt {
+		counts[d.Type]++
+	}
+	report := &riskReportResolver{dependentCount: int32(len(dependents))}
+	for debtType, count := range counts {
+		report.debtCount = append(report.debtCount, &debtTypeCountResolver{debtType: debtType, count: count})
+	}
+	sort.Slice(report.debtCount, func(i, j int) bool { return report.debtCount[i].debtType < report.debtCount[j].debtType })
+	return report, nil
+}
+
+type dependencyResolver struct {
+	dependency *repositories.Dependency
+}
+
+func newDependencyResolvers(dependencies []*repositories.Dependency) []*dependencyResolver {
+	resolvers := make([]*dependencyResolver, 0, len(dependencies))
+	for _, d := range dependencies {
+		resolvers = append(resolvers, &dependencyResolver{dependency: d})
+	}
+	return resolvers
+}
+
+func resolveDependencies(ctx context.Context, serviceId string) ([]*dependencyResolver, error) {
+	dependencies, err := loadersFromContext(ctx).dependencies.Load(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	return newDependencyResolvers(dependencies), nil
+}
+
+func (d *dependencyResolver) Id() graphql.ID  { return graphql.ID(d.dependency.Id) }
+func (d *dependencyResolver) Name() string    { return d.dependency.Name }
+func (d *dependencyResolver) Type() string    { return d.dependency.ServiceType }
+func (d *dependencyResolver) Version() string { return d.dependency.Version }
+
+func (d *dependencyResolver) Service(ctx context.Context) (*serviceResolver, error) {
+	return loadService(ctx, d.dependency.Id)
+}
+
+func (d *dependencyResolver) Owners(ctx context.Context) ([]*teamResolver, error) {
+	return resolveOwners(ctx, d.dependency.Id)
+}
+
+func (d *dependencyResolver) Dependencies(ctx context.Context) ([]*dependencyResolver, error) {
+	return resolveDependencies(ctx, d.dependency.Id)
+}
+
+func (d *dependencyResolver) Releases(ctx context.Context, args struct{ Last int32 }) ([]*releaseResolver, error) {
+	return resolveReleases(ctx, d.dependency.Id, args.Last)
+}
+
+type teamResolver struct {
+	team repositories.Team
+}
+
+func newTeamResolvers(teams []repositories.Team) []*teamResolver {
+	resolvers := make([]*teamResolver, 0, len(teams))
+	for _, t := range teams {
+		resolvers = append(resolvers, &teamResolver{team: t})
+	}
+	return resolvers
+}
+
+func resolveOwners(ctx context.Context, serviceId string) ([]*teamResolver, error) {
+	teams, err := loadersFromContext(ctx).owners.Load(serviceId)
+	if err != nil {
+		return nil, err
+	}
+	return newTeamResolvers(teams), nil
+}
+
+func (t *teamResolver) Id() graphql.ID { return graphql.ID(t.team.Id) }
+func (t *teamResolver) Name() string   { return t.team.Name }
+func (t *teamResolver) Created() *graphql.Time {
+	return optionalTime(t.team.Created)
+}
+func (t *teamResolver) Updated() *graphql.Time {
+	return optionalTime(t.team.Updated)
+}
+
+func (t *teamResolver) Services(ctx context.Context) ([]*serviceResolver, error) {
+	services, err := loadersFromContext(ctx).teamServices.Load(t.team.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newServiceResolvers(services), nil
+}
+
+type releaseResolver struct {
+	release repositories.Release
+}
+
+func resolveReleases(ctx context.Context, serviceId string, last int32) ([]*releaseResolver, error) {
+	if last < 1 || last > maxReleases {
+		return nil, fmt.Errorf("last must be between 1 and %d", maxReleases)
+	}
+	releases, err := loadersFromContext(ctx).releases.Load(releaseKey{serviceId: serviceId, limit: int(last)})
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*releaseResolver, 0, len(releases))
+	for _, r := range releases {
+		resolvers = append(resolvers, &releaseResolver{release: *r})
+	}
+	return resolvers, nil
+}
+
+func (r *releaseResolver) Version() string { return r.release.Version }
+func (r *releaseResolver) Url() string     { return r.release.Url }
+func (r *releaseResolver) ReleaseDate() graphql.Time {
+	return graphql.Time{Time: r.release.ReleaseDate}
+}
+
+func (r *releaseResolver) Service(ctx context.Context) (*serviceResolver, error) {
+	return loadService(ctx, r.release.ServiceId)
+}
+
+type debtResolver struct {
+	debt repositories.Debt
+}
+
+func (d *debtResolver) Id() graphql.ID      { return graphql.ID(d.debt.Id) }
+func (d *debtResolver) Title() string       { return d.debt.Title }
+func (d *debtResolver) Description() string { return d.debt.Description }
+func (d *debtResolver) Type() string        { return d.debt.Type }
+func (d *debtResolver) Status() string      { return d.debt.Status }
+
+func (d *debtResolver) Service(ctx context.Context) (*serviceResolver, error) {
+	return loadService(ctx, d.debt.ServiceId)
+}
+
+type riskReportResolver struct {
+	dependentCount int32
+	debtCount      []*debtTypeCountResolver
+}
+
+func (r *riskReportResolver) DependentCount() int32               { return r.dependentCount }
+func (r *riskReportResolver) DebtCount() []*debtTypeCountResolver { return r.debtCount }
+
+type debtTypeCountResolver struct {
+	debtType string
+	count    int32
+}
+
+func (d *debtTypeCountResolver) Type() string { return d.debtType }
+func (d *debtTypeCountResolver) Count() int32 { return d.count }
+
+type serviceDebtCountResolver struct {
+	report repositories.ServiceDebtReport
+}
+
+func (s *serviceDebtCountResolver) Count() int32 { return int32(s.report.Count) }
+
+func (s *serviceDebtCountResolver) Service(ctx context.Context) (*serviceResolver, error) {
+	return loadService(ctx, s.report.Id)
+}
+
+func optionalTime(t time.Time) *graphql.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: t}
+}
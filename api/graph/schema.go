@@ -0,0 +1,97 @@
+package graph
+
+// schema is the GraphQL schema served at /graphql. Nested fields are resolved through per-request
+// batch loaders, so selecting e.g. the owners of every dependency costs one query per level, not one per item.
+const schema = `
+schema {
+	query: Query
+}
+
+scalar Time
+
+type Query {
+	# A single service, or null if it does not exist.
+	service(id: ID!): Service
+	services(page: Int = 1, pageSize: Int = 10): [Service!]!
+	# Searches services with the query language of GET /services/search, such as "kafka type:api -lifecycle:retired".
+	searchServices(query: String!): [Service!]!
+	# A single team, or null if it does not exist.
+	team(id: ID!): Team
+	teams(page: Int = 1, pageSize: Int = 10): [Team!]!
+	releases(startDate: Time!, endDate: Time!, page: Int = 1, pageSize: Int = 10): [Release!]!
+	# The number of debt items per service.
+	debtReport: [ServiceDebtCount!]!
+}
+
+type Service {
+	id: ID!
+	name: String!
+	type: String!
+	description: String!
+	url: String!
+	created: Time
+	updated: Time
+	lifecycle: String
+	tags: [String!]!
+	# The services this service depends on.
+	dependencies: [Dependency!]!
+	# The services that depend on this service.
+	dependents: [Dependency!]!
+	owners: [Team!]!
+	# The most recent releases, newest first.
+	releases(last: Int = 10): [Release!]!
+	debt(status: String): [Debt!]!
+	risk: RiskReport!
+}
+
+# A dependency edge to another service.
+type Dependency {
+	id: ID!
+	name: String!
+	type: String!
+	version: String!
+	service: Service
+	owners: [Team!]!
+	dependencies: [Dependency!]!
+	releases(last: Int = 10): [Release!]!
+}
+
+type Team {
+	id: ID!
+	name: String!
+	created: Time
+	updated: Time
+	services: [Service!]!
+}
+
+type Release {
+	version: String!
+	url: String!
+	releaseDate: Time!
+	service: Service
+}
+
+type Debt {
+	id: ID!
+	title: String!
+	description: String!
+	type: String!
+	status: String!
+	service: Service
+}
+
+type RiskReport {
+	dependentCount: Int!
+	debtCount: [DebtTypeCount!]!
+}
+
+type DebtTypeCount {
+	type: String!
+	count: Int!
+}
+
+type ServiceDebtCount {
+	count: Int!
+	service: Service
+}
+`
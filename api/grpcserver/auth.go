@@ -0,0 +1,150 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal"
+	"service-atlas/internal/auth"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// servicePrefix is the package of the catalog services; other services, such as reflection, need no scope.
+const servicePrefix = "/serviceatlas.v1."
+
+// methodScope returns the scope a method requires, mirroring the scopes of the equivalent REST routes.
+func methodScope(fullMethod string) (auth.Scope, bool) {
+	if !strings.HasPrefix(fullMethod, servicePrefix) {
+		return "", false
+	}
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, servicePrefix), "/")
+	for _, prefix := range []string{"Get", "List", "Search", "Export"} {
+		if strings.HasPrefix(method, prefix) {
+			return auth.ScopeRead, true
+		}
+	}
+	if service == "DebtService" {
+		return auth.ScopeWriteDebt, true
+	}
+	return auth.ScopeWriteServices, true
+}
+
+// authenticate identifies the caller from the authorization (Bearer) or x-api-key metadata, or failing that from a
+// verified TLS client certificate.
+func authenticate(ctx context.Context, authenticator *auth.Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	key := ""
+	if values := md.Get("x-api-key"); len(values) > 0 {
+		key = values[0]
+	} else if values = md.Get("authorization"); len(values) > 0 {
+		if scheme, token, ok := strings.Cut(values[0], " "); ok && strings.EqualFold(scheme, "Bearer") {
+			key = strings.TrimSpace(token)
+		}
+	}
+	if key == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+				if identity, ok := authenticator.ClientCertIdentity(&info.State); ok {
+					return auth.WithIdentity(ctx, identity), nil
+				}
+			}
+		}
+		return ctx, nil
+	}
+	identity, err := authenticator.Identify(ctx, key)
+	if err != nil {
+		internal.LoggerFromContext(ctx).Warn("Rejected credentials", slog.String("error", err.Error()))
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+		}
+		return nil, status.Error(codes.Internal, "error authenticating request")
+	}
+	return auth.WithIdentity(ctx, identity), nil
+}
+
+// authorize checks the caller in ctx was granted the scope the method requires.
+func authorize(ctx context.Context, authenticator *auth.Authenticator, fullMethod string) error {
+	scope, ok := methodScope(fullMethod)
+	if !ok {
+		return nil
+	}
+	err := authenticator.Authorize(ctx, scope)
+	if errors.Is(err, auth.ErrUnauthenticated) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return toStatus(err)
+}
+
+// authorizeRequest limits team scoped callers to changing the services and teams they are members of,
+// mirroring the ownership checks of the equivalent REST routes.
+func authorizeRequest(ctx context.Context, authenticator *auth.Authenticator, req any) error {
+	var err error
+	switch r := req.(type) {
+	case *atlasv1.UpdateServiceRequest:
+		err = authenticator.AuthorizeService(ctx, r.GetService().GetId())
+	case *atlasv1.DeleteServiceRequest:
+		err = authenticator.AuthorizeService(ctx, r.GetId())
+	case *atlasv1.UpdateDebtStatusRequest:
+		err = authenticator.AuthorizeDebt(ctx, r.GetId())
+	case *atlasv1.CreateDebtRequest, *atlasv1.CreateReleaseRequest, *atlasv1.AddDependencyRequest, *atlasv1.DeleteDependencyRequest:
+		err = authenticator.AuthorizeService(ctx, r.(interface{ GetServiceId() string }).GetServiceId())
+	case *atlasv1.UpdateTeamRequest:
+		err = authenticator.AuthorizeTeam(ctx, r.GetTeam().GetId())
+	case *atlasv1.DeleteTeamRequest:
+		err = authenticator.AuthorizeTeam(ctx, r.GetId())
+	case *atlasv1.AddTeamServiceRequest:
+		err = authenticator.AuthorizeOwnership(ctx, r.GetTeamId(), r.GetServiceId())
+	case *atlasv1.RemoveTeamServiceRequest:
+		err = authenticator.AuthorizeOwnership(ctx, r.GetTeamId(), r.GetServiceId())
+	}
+	return toStatus(err)
+}
+
+func unaryAuthenticate(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuthenticate(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func unaryAuthorize(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, authenticator, info.FullMethod); err != nil {
+			return nil, err
+		}
+		if err := authorizeRequest(ctx, authenticator, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuthorize(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), authenticator, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,121 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/config"
+	"service-atlas/repositories"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodScope(t *testing.T) {
+	tests := []struct {
+		method string
+		scope  auth.Scope
+		ok     bool
+	}{
+		{"/serviceatlas.v1.ServicesService/GetService", auth.ScopeRead, true},
+		{"/serviceatlas.v1.ExportService/ExportGraph", auth.ScopeRead, true},
+		{"/serviceatlas.v1.ServicesService/UpdateService", auth.ScopeWriteServices, true},
+		{"/serviceatlas.v1.TeamsService/AddTeamService", auth.ScopeWriteServices, true},
+		{"/serviceatlas.v1.DebtService/CreateDebt", auth.ScopeWriteDebt, true},
+		{"/serviceatlas.v1.DebtService/ListDebt", auth.ScopeRead, true},
+		{"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", "", false},
+	}
+	for _, tc := range tests {
+		scope, ok := methodScope(tc.method)
+		if scope != tc.scope || ok != tc.ok {
+			t.Errorf("methodScope(%q) = %q, %v", tc.method, scope, ok)
+		}
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	catalog := newTestCatalog()
+	catalog.ApiKeys = map[string]repositories.ApiKey{
+		auth.HashKey("sa_reader"): {Id: "k-1", Name: "reader", Scopes: []string{"read"}},
+	}
+	client := atlasv1.NewServicesServiceClient(dialWithAuth(t, catalog, config.Auth{Required: true}))
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+key)
+	}
+
+	_, err := client.GetService(context.Background(), &atlasv1.GetServiceRequest{Id: cartId})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated without credentials, got %v", err)
+	}
+	_, err = client.GetService(withKey("sa_unknown"), &atlasv1.GetServiceRequest{Id: cartId})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected Unauthenticated with an unknown key, got %v", err)
+	}
+	if _, err = client.GetService(withKey("sa_reader"), &atlasv1.GetServiceRequest{Id: cartId}); err != nil {
+		t.Errorf("expected read to be allowed, got %v", err)
+	}
+	_, err = client.CreateService(withKey("sa_reader"), &atlasv1.CreateServiceRequest{Name: "orders", Type: "api", Url: "https://orders"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got %v", err)
+	}
+	if len(catalog.CreatedServices) != 0 {
+		t.Error("expected the write to be rejected before reaching the repository")
+	}
+}
+
+func TestAuthentication_ClientCertificate(t *testing.T) {
+	authenticator := auth.FromConfig(config.Auth{Required: true, ClientCertScopes: []string{"read"}}, nil, nil, nil)
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "deployer"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+
+	ctx, err := authenticate(ctx, authenticator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity, ok := auth.IdentityFromContext(ctx); !ok || identity.Subject() != "cert:deployer" {
+		t.Errorf("expected the certificate's identity, got %+v", identity)
+	}
+	if err = authorize(ctx, authenticator, "/serviceatlas.v1.ServicesService/GetService"); err != nil {
+		t.Errorf("expected the certificate's scopes to allow reads, got %v", err)
+	}
+}
+
+func TestAuthorizeRequest_TeamOwnership(t *testing.T) {
+	authenticator := auth.NewAuthenticator(auth.Options{Keys: newTestCatalog(), Owners: newTestCatalog(), Debt: newTestCatalog()})
+	member := auth.WithIdentity(context.Background(), auth.Identity{
+		Kind: "jwt", Id: "ada", Scopes: []auth.Scope{auth.ScopeRead, auth.ScopeWriteServices, auth.ScopeWriteDebt},
+		Teams: []string{shopId}, TeamScoped: true,
+	})
+	tests := []struct {
+		name string
+		req  any
+		code codes.Code
+	}{
+		{"UpdateOwnedService", &atlasv1.UpdateServiceRequest{Service: &atlasv1.Service{Id: cartId}}, codes.OK},
+		{"UpdateOtherService", &atlasv1.UpdateServiceRequest{Service: &atlasv1.Service{Id: dbId}}, codes.PermissionDenied},
+		{"DebtOnOwnedService", &atlasv1.CreateDebtRequest{ServiceId: cartId}, codes.OK},
+		{"StatusOfOtherServiceDebt", &atlasv1.UpdateDebtStatusRequest{Id: "d1", Status: "remediated"}, codes.PermissionDenied},
+		{"StatusOfMissingDebt", &atlasv1.UpdateDebtStatusRequest{Id: "d2", Status: "remediated"}, codes.NotFound},
+		{"ReleaseOnOtherService", &atlasv1.CreateReleaseRequest{ServiceId: dbId}, codes.PermissionDenied},
+		{"DependencyFromOtherService", &atlasv1.AddDependencyRequest{ServiceId: dbId, DependsOnId: cartId}, codes.PermissionDenied},
+		{"DeleteOtherTeam", &atlasv1.DeleteTeamRequest{Id: "55555555-5555-5555-5555-555555555555"}, codes.PermissionDenied},
+		{"ClaimUnownedService", &atlasv1.AddTeamServiceRequest{TeamId: shopId, ServiceId: dbId}, codes.OK},
+		{"Reads", &atlasv1.GetServiceRequest{Id: dbId}, codes.OK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := authorizeRequest(member, authenticator, tc.req); status.Code(err) != tc.code {
+				t.Errorf("expected %v, got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package grpcserver
+
+import (
+	"fmt"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = pagination.DefaultLimit
+	maxPageSize     = pagination.MaxLimit
+	// maxSearchResults is the number of matches SearchServices has always returned.
+	maxSearchResults = pagination.SearchLimit
+)
+
+// everything is the page read for lists whose requests have no page, which are returned in full.
+var everything = pagination.First(pagination.Unlimited)
+
+// pageParams applies the REST defaults to an optional page and validates it.
+func pageParams(page *atlasv1.Page) (pagination.Page, error) {
+	p, size := 1, defaultPageSize
+	if page.GetPage() != 0 {
+		p = int(page.GetPage())
+	}
+	if page.GetPageSize() != 0 {
+		size = int(page.GetPageSize())
+	}
+	if p < 1 {
+		return pagination.Page{}, invalidArgument("page must be positive")
+	}
+	if size < 1 || size > maxPageSize {
+		return pagination.Page{}, invalidArgument(fmt.Sprintf("page_size must be between 1 and %d", maxPageSize))
+	}
+	return pagination.FromPage(p, size), nil
+}
+
+// requireId validates that id is a guid, naming the field in the error.
+func requireId(field, id string) error {
+	if _, ok := internal.IsValidGuid(id); !ok {
+		return invalidArgument(field + " is not a valid id")
+	}
+	return nil
+}
+
+// serviceFilter reads the filter and sort of a service listing, validating them as the REST query parameters are.
+func serviceFilter(req *atlasv1.ListServicesRequest) (repositories.ServiceFilter, error) {
+	f := req.GetFilter()
+	filter := repositories.ServiceFilter{
+		Type:          strings.TrimSpace(f.GetType()),
+		Team:          strings.TrimSpace(f.GetTeam()),
+		Lifecycle:     strings.ToLower(strings.TrimSpace(f.GetLifecycle())),
+		CreatedAfter:  fromTimestamp(f.GetCreatedAfter()),
+		CreatedBefore: fromTimestamp(f.GetCreatedBefore()),
+		UpdatedAfter:  fromTimestamp(f.GetUpdatedAfter()),
+		UpdatedBefore: fromTimestamp(f.GetUpdatedBefore()),
+	}
+	if f != nil && f.HasOpenDebt != nil {
+		hasOpenDebt := f.GetHasOpenDebt()
+		filter.HasOpenDebt = &hasOpenDebt
+	}
+	if filter.Lifecycle != "" && !internal.ServiceLifecycles.IsMember(filter.Lifecycle) {
+		return filter, invalidArgument("filter.lifecycle must be one of " + strings.Join(internal.ServiceLifecycles.Members(), ", "))
+	}
+	if len(f.GetTags()) > 0 {
+		var err error
+		if filter.Tags, err = repositories.NormalizeTags(f.GetTags()); err != nil {
+			return filter, invalidArgument("filter.tags: " + err.Error())
+		}
+	}
+	if sort := req.GetSort(); sort != "" {
+		filter.Descending = strings.HasPrefix(sort, "-")
+		filter.Sort = repositories.ServiceSort(strings.TrimPrefix(sort, "-"))
+		if !slices.Contains(repositories.ServiceSorts, filter.Sort) {
+			return filter, invalidArgument("sort must be one of name, created, updated, dependents or debt, prefixed with - to reverse")
+		}
+	}
+	return filter, nil
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// fromTimestamp converts an optional timestamp, returning the zero time when it is unset.
+func fromTimestamp(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func toService(s repositories.Service) *atlasv1.Service {
+	return &atlasv1.Service{
+		Id:          s.Id,
+		Name:        s.Name,
+		Type:        s.ServiceType,
+		Description: s.Description,
+		Url:         s.Url,
+		Created:     timestamp(s.Created),
+		Updated:     timestamp(s.Updated),
+		Lifecycle:   s.Lifecycle,
+		Tags:        s.Tags,
+	}
+}
+
+func toServices(services []repositories.Service) []*atlasv1.Service {
+	out := make([]*atlasv1.Service, 0, len(services))
+	for _, s := range services {
+		out = append(out, toService(s))
+	}
+	return out
+}
+
+func toTeam(t repositories.Team) *atlasv1.Team {
+	return &atlasv1.Team{
+		Id:      t.Id,
+		Name:    t.Name,
+		Created: timestamp(t.Created),
+		Updated: timestamp(t.Updated),
+	}
+}
+
+func toTeams(teams []repositories.Team) []*atlasv1.Team {
+	out := make([]*atlasv1.Team, 0, len(teams))
+	for _, t := range teams {
+		out = append(out, toTeam(t))
+	}
+	return out
+}
+
+func toDependency(d *repositories.Dependency) *atlasv1.Dependency {
+	return &atlasv1.Dependency{
+		Id:      d.Id,
+		Name:    d.Name,
+		Type:    d.ServiceType,
+		Version: d.Version,
+	}
+}
+
+func toDependencies(dependencies []*repositories.Dependency) []*atlasv1.Dependency {
+	out := make([]*atlasv1.Dependency, 0, len(dependencies))
+	for _, d := range dependencies {
+		out = append(out, toDependency(d))
+	}
+	return out
+}
+
+func toDebt(d repositories.Debt) *atlasv1.Debt {
+	return &atlasv1.Debt{
+		Id:          d.Id,
+		ServiceId:   d.ServiceId,
+		Type:        d.Type,
+		Title:       d.Title,
+		Description: d.Description,
+		Status:      d.Status,
+	}
+}
+
+func toRelease(r *repositories.Release) *atlasv1.Release {
+	return &atlasv1.Release{
+		ServiceId:   r.ServiceId,
+		Version:     r.Version,
+		Url:         r.Url,
+		ReleaseDate: timestamp(r.ReleaseDate),
+	}
+}
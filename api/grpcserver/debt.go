@@ -0,0 +1,65 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal"
+	"service-atlas/repositories"
+)
+
+type debtServer struct {
+	atlasv1.UnimplementedDebtServiceServer
+	repository repositories.DebtRepository
+}
+
+func (s *debtServer) CreateDebt(ctx context.Context, req *atlasv1.CreateDebtRequest) (*atlasv1.CreateDebtResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	debt := repositories.Debt{
+		ServiceId:   req.GetServiceId(),
+		Type:        req.GetType(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		Status:      req.GetStatus(),
+	}
+	if err := debt.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.repository.CreateDebtItem(ctx, debt); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.CreateDebtResponse{}, nil
+}
+
+func (s *debtServer) ListDebt(ctx context.Context, req *atlasv1.ListDebtRequest) (*atlasv1.ListDebtResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	page, err := pageParams(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	debt, err := s.repository.GetDebtByServiceId(ctx, req.GetServiceId(), page, req.GetOnlyResolved())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*atlasv1.Debt, 0, len(debt.Items))
+	for _, d := range debt.Items {
+		out = append(out, toDebt(d))
+	}
+	return &atlasv1.ListDebtResponse{Debt: out}, nil
+}
+
+func (s *debtServer) UpdateDebtStatus(ctx context.Context, req *atlasv1.UpdateDebtStatusRequest) (*atlasv1.UpdateDebtStatusResponse, error) {
+	if err := requireId("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if !internal.DebtStatus.IsMember(req.GetStatus()) {
+		return nil, invalidArgument("status not valid")
+	}
+	if err := s.repository.UpdateStatus(ctx, req.GetId(), req.GetStatus()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.UpdateDebtStatusResponse{}, nil
+}
@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/repositories"
+)
+
+type dependenciesServer struct {
+	atlasv1.UnimplementedDependenciesServiceServer
+	repository repositories.DependencyRepository
+}
+
+func (s *dependenciesServer) ListDependencies(ctx context.Context, req *atlasv1.ListDependenciesRequest) (*atlasv1.ListDependenciesResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	dependencies, err := s.repository.GetDependencies(ctx, req.GetServiceId(), everything)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListDependenciesResponse{Dependencies: toDependencies(dependencies.Items)}, nil
+}
+
+func (s *dependenciesServer) ListDependents(ctx context.Context, req *atlasv1.ListDependentsRequest) (*atlasv1.ListDependentsResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	dependents, err := s.repository.GetDependents(ctx, req.GetServiceId(), "", everything)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListDependentsResponse{Dependents: toDependencies(dependents.Items)}, nil
+}
+
+func (s *dependenciesServer) AddDependency(ctx context.Context, req *atlasv1.AddDependencyRequest) (*atlasv1.AddDependencyResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	dependency := repositories.Dependency{Id: req.GetDependsOnId(), Version: req.GetVersion()}
+	if err := dependency.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.repository.AddDependency(ctx, req.GetServiceId(), dependency); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.AddDependencyResponse{}, nil
+}
+
+func (s *dependenciesServer) DeleteDependency(ctx context.Context, req *atlasv1.DeleteDependencyRequest) (*atlasv1.DeleteDependencyResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	if err := requireId("depends_on_id", req.GetDependsOnId()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteDependency(ctx, req.GetServiceId(), req.GetDependsOnId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.DeleteDependencyResponse{}, nil
+}
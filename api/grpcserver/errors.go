@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"service-atlas/internal/customerrors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus converts a repository error into a gRPC status, mapping the HTTP status of
+// customerrors.HTTPError onto the equivalent gRPC code.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var httpErr customerrors.HTTPError
+	var httpErrPtr *customerrors.HTTPError
+	switch {
+	case errors.As(err, &httpErr):
+		return status.Error(httpStatusToCode(httpErr.Status), httpErr.Msg)
+	case errors.As(err, &httpErrPtr):
+		return status.Error(httpStatusToCode(httpErrPtr.Status), httpErrPtr.Msg)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
+
+func invalidArgument(msg string) error {
+	return status.Error(codes.InvalidArgument, msg)
+}
@@ -0,0 +1,108 @@
+package grpcserver
+
+import (
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+)
+
+// exportPageSize is the number of services or teams read per query while exporting.
+const exportPageSize = 100
+
+type exportServer struct {
+	atlasv1.UnimplementedExportServiceServer
+	services repositories.ServiceRepository
+	teams    repositories.TeamRepository
+	graph    repositories.GraphRepository
+}
+
+// ExportGraph streams the catalog a page at a time so the whole graph is never held in memory.
+// The edges of each page of services are read with one batched query per edge type.
+func (s *exportServer) ExportGraph(req *atlasv1.ExportGraphRequest, stream atlasv1.ExportService_ExportGraphServer) error {
+	ctx := stream.Context()
+	if req.GetReleasesPerService() < 0 || req.GetReleasesPerService() > maxPageSize {
+		return invalidArgument("releases_per_service must be between 0 and 100")
+	}
+
+	for page := pagination.First(exportPageSize); ; page = page.Next() {
+		teams, err := s.teams.GetTeams(ctx, page)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, t := range teams.Items {
+			if err = stream.Send(&atlasv1.ExportGraphResponse{Item: &atlasv1.ExportGraphResponse_Team{Team: toTeam(t)}}); err != nil {
+				return err
+			}
+		}
+		if teams.NextCursor == "" {
+			break
+		}
+	}
+
+	for page := pagination.First(exportPageSize); ; page = page.Next() {
+		services, err := s.services.GetAllServices(ctx, repositories.ServiceFilter{}, page)
+		if err != nil {
+			return toStatus(err)
+		}
+		if err = s.sendServices(req, stream, services.Items); err != nil {
+			return err
+		}
+		if services.NextCursor == "" {
+			return nil
+		}
+	}
+}
+
+func (s *exportServer) sendServices(req *atlasv1.ExportGraphRequest, stream atlasv1.ExportService_ExportGraphServer, services []repositories.Service) error {
+	ctx := stream.Context()
+	ids := make([]string, 0, len(services))
+	for _, svc := range services {
+		ids = append(ids, svc.Id)
+	}
+	dependencies, err := s.graph.GetDependenciesByServiceIds(ctx, ids)
+	if err != nil {
+		return toStatus(err)
+	}
+	owners, err := s.graph.GetTeamsByServiceIds(ctx, ids)
+	if err != nil {
+		return toStatus(err)
+	}
+	releases := map[string][]*repositories.Release{}
+	if req.GetReleasesPerService() > 0 {
+		if releases, err = s.graph.GetReleasesByServiceIds(ctx, ids, int(req.GetReleasesPerService())); err != nil {
+			return toStatus(err)
+		}
+	}
+	debt := map[string][]repositories.Debt{}
+	if req.GetIncludeDebt() {
+		if debt, err = s.graph.GetDebtByServiceIds(ctx, ids); err != nil {
+			return toStatus(err)
+		}
+	}
+
+	for _, svc := range services {
+		items := []*atlasv1.ExportGraphResponse{{Item: &atlasv1.ExportGraphResponse_Service{Service: toService(svc)}}}
+		for _, d := range dependencies[svc.Id] {
+			items = append(items, &atlasv1.ExportGraphResponse{Item: &atlasv1.ExportGraphResponse_Dependency{
+				Dependency: &atlasv1.DependencyEdge{ServiceId: svc.Id, Dependency: toDependency(d)},
+			}})
+		}
+		for _, t := range owners[svc.Id] {
+			items = append(items, &atlasv1.ExportGraphResponse{Item: &atlasv1.ExportGraphResponse_Ownership{
+				Ownership: &atlasv1.Ownership{TeamId: t.Id, ServiceId: svc.Id},
+			}})
+		}
+		for _, r := range releases[svc.Id] {
+			items = append(items, &atlasv1.ExportGraphResponse{Item: &atlasv1.ExportGraphResponse_Release{Release: toRelease(r)}})
+		}
+		for _, d := range debt[svc.Id] {
+			items = append(items, &atlasv1.ExportGraphResponse{Item: &atlasv1.ExportGraphResponse_Debt{Debt: toDebt(d)}})
+		}
+		for _, item := range items {
+			if err = stream.Send(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
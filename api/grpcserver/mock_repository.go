@@ -0,0 +1,289 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"time"
+)
+
+// mockCatalog is an in-memory catalog implementing every repository the gRPC services use.
+// Writes are recorded so tests can assert on what reached the repository layer.
+type mockCatalog struct {
+	Services     []repositories.Service
+	Teams        []repositories.Team
+	Owners       map[string][]string // service id -> team ids
+	Dependencies map[string][]*repositories.Dependency
+	Releases     map[string][]*repositories.Release
+	Debt         map[string][]repositories.Debt
+	ApiKeys      map[string]repositories.ApiKey // hash -> key
+	Err          error
+	// Panic makes GetAllServices panic, to exercise the recovery interceptor.
+	Panic bool
+
+	CreatedServices []repositories.Service
+	UpdatedServices []repositories.Service
+	ListedFilters   []repositories.ServiceFilter
+	CreatedDebt     []repositories.Debt
+	CreatedReleases []repositories.Release
+	UpdatedStatus   map[string]string
+}
+
+func (m *mockCatalog) service(id string) (repositories.Service, bool) {
+	for _, svc := range m.Services {
+		if svc.Id == id {
+			return svc, true
+		}
+	}
+	return repositories.Service{}, false
+}
+
+func (m *mockCatalog) GetAllServices(_ context.Context, filter repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	if m.Panic {
+		panic("boom")
+	}
+	m.ListedFilters = append(m.ListedFilters, filter)
+	return pagination.Slice(m.Services, page), m.Err
+}
+
+func (m *mockCatalog) CreateService(_ context.Context, service repositories.Service) (string, error) {
+	if m.Err != nil {
+		return "", m.Err
+	}
+	m.CreatedServices = append(m.CreatedServices, service)
+	return "99999999-9999-9999-9999-999999999999", nil
+}
+
+func (m *mockCatalog) UpdateService(_ context.Context, service repositories.Service) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.UpdatedServices = append(m.UpdatedServices, service)
+	return nil
+}
+
+func (m *mockCatalog) DeleteService(_ context.Context, _ string) error {
+	return m.Err
+}
+
+func (m *mockCatalog) GetServiceById(_ context.Context, id string) (repositories.Service, error) {
+	svc, _ := m.service(id)
+	return svc, m.Err
+}
+
+func (m *mockCatalog) Search(_ context.Context, _ searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	return pagination.Slice(m.Services, page), m.Err
+}
+
+func (m *mockCatalog) GetTeamsByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	teams, err := m.GetTeamsByServiceIds(ctx, []string{serviceId})
+	return pagination.Slice(teams[serviceId], page), err
+}
+
+func (m *mockCatalog) GetServicesByUrl(_ context.Context, _ string) ([]repositories.Service, error) {
+	return nil, m.Err
+}
+
+func (m *mockCatalog) AddDependency(_ context.Context, _ string, _ repositories.Dependency) error {
+	return m.Err
+}
+
+func (m *mockCatalog) GetDependencies(_ context.Context, id string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
+	return pagination.Slice(m.Dependencies[id], page), m.Err
+}
+
+func (m *mockCatalog) GetDependents(_ context.Context, _ string, _ string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
+	return pagination.NewResult([]*repositories.Dependency{}, page), m.Err
+}
+
+func (m *mockCatalog) DeleteDependency(_ context.Context, _ string, _ string) error {
+	return m.Err
+}
+
+func (m *mockCatalog) CreateTeam(_ context.Context, _ repositories.Team) (string, error) {
+	return "", m.Err
+}
+
+func (m *mockCatalog) GetTeam(_ context.Context, teamId string) (*repositories.Team, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	for _, t := range m.Teams {
+		if t.Id == teamId {
+			return &t, nil
+		}
+	}
+	return nil, customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Team not found"}
+}
+
+func (m *mockCatalog) GetTeams(_ context.Context, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	return pagination.Slice(m.Teams, page), m.Err
+}
+
+func (m *mockCatalog) UpdateTeam(_ context.Context, _ repositories.Team) error {
+	return m.Err
+}
+
+func (m *mockCatalog) DeleteTeam(_ context.Context, _ string) error {
+	return m.Err
+}
+
+func (m *mockCatalog) CreateTeamAssociation(_ context.Context, _, _ string) error {
+	return m.Err
+}
+
+func (m *mockCatalog) DeleteTeamAssociation(_ context.Context, _, _ string) error {
+	return m.Err
+}
+
+func (m *mockCatalog) CreateDebtItem(_ context.Context, debt repositories.Debt) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.CreatedDebt = append(m.CreatedDebt, debt)
+	return nil
+}
+
+func (m *mockCatalog) UpdateStatus(_ context.Context, id, status string) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	if m.UpdatedStatus == nil {
+		m.UpdatedStatus = make(map[string]string)
+	}
+	m.UpdatedStatus[id] = status
+	return nil
+}
+
+func (m *mockCatalog) GetDebtById(_ context.Context, id string) (repositories.Debt, error) {
+	for serviceId, items := range m.Debt {
+		for _, item := range items {
+			if item.Id == id {
+				item.ServiceId = serviceId
+				return item, m.Err
+			}
+		}
+	}
+	return repositories.Debt{}, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+}
+
+func (m *mockCatalog) GetDebtByServiceId(_ context.Context, id string, page pagination.Page, _ bool) (pagination.Result[repositories.Debt], error) {
+	return pagination.Slice(m.Debt[id], page), m.Err
+}
+
+func (m *mockCatalog) CreateRelease(_ context.Context, release repositories.Release) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	m.CreatedReleases = append(m.CreatedReleases, release)
+	return nil
+}
+
+func (m *mockCatalog) GetReleasesByServiceId(_ context.Context, serviceId string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
+	return pagination.Slice(m.Releases[serviceId], page), m.Err
+}
+
+func (m *mockCatalog) GetReleasesInDateRange(_ context.Context, _, _ time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
+	return pagination.Slice([]*repositories.ServiceReleaseInfo{}, page), m.Err
+}
+
+func (m *mockCatalog) GetServiceRiskReport(_ context.Context, _ string) (*repositories.ServiceRiskReport, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return &repositories.ServiceRiskReport{DebtCount: map[string]int64{"code": 2}, DependentCount: 3}, nil
+}
+
+func (m *mockCatalog) GetServicesByTeam(_ context.Context, _ string, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	return pagination.Slice(m.Services, page), m.Err
+}
+
+func (m *mockCatalog) GetDebtCountByService(_ context.Context, page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+	return pagination.Slice([]repositories.ServiceDebtReport{}, page), m.Err
+}
+
+func (m *mockCatalog) GetCatalogCounts(_ context.Context) (*repositories.CatalogCounts, error) {
+	return &repositories.CatalogCounts{Services: int64(len(m.Services))}, m.Err
+}
+
+func (m *mockCatalog) GetIntegrityReport(_ context.Context) (*repositories.IntegrityReport, error) {
+	return &repositories.IntegrityReport{}, m.Err
+}
+
+func (m *mockCatalog) GetServicesByIds(_ context.Context, ids []string) (map[string]repositories.Service, error) {
+	values := make(map[string]repositories.Service)
+	for _, id := range ids {
+		if svc, ok := m.service(id); ok {
+			values[id] = svc
+		}
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDependenciesByServiceIds(_ context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	values := make(map[string][]*repositories.Dependency)
+	for _, id := range ids {
+		values[id] = m.Dependencies[id]
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDependentsByServiceIds(_ context.Context, _ []string) (map[string][]*repositories.Dependency, error) {
+	return map[string][]*repositories.Dependency{}, m.Err
+}
+
+func (m *mockCatalog) GetTeamsByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Team, error) {
+	values := make(map[string][]repositories.Team)
+	for _, id := range ids {
+		for _, teamId := range m.Owners[id] {
+			for _, t := range m.Teams {
+				if t.Id == teamId {
+					values[id] = append(values[id], t)
+				}
+			}
+		}
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetReleasesByServiceIds(_ context.Context, ids []string, limit int) (map[string][]*repositories.Release, error) {
+	values := make(map[string][]*repositories.Release)
+	for _, id := range ids {
+		values[id] = pagination.Slice(m.Releases[id], pagination.First(limit)).Items
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetDebtByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Debt, error) {
+	values := make(map[string][]repositories.Debt)
+	for _, id := range ids {
+		values[id] = m.Debt[id]
+	}
+	return values, m.Err
+}
+
+func (m *mockCatalog) GetServicesByTeamIds(_ context.Context, _ []string) (map[string][]repositories.Service, error) {
+	return map[string][]repositories.Service{}, m.Err
+}
+
+func (m *mockCatalog) CreateApiKey(_ context.Context, _ repositories.ApiKey) (string, error) {
+	return "", m.Err
+}
+
+func (m *mockCatalog) GetApiKeys(_ context.Context, page pagination.Page) (pagination.Result[repositories.ApiKey], error) {
+	return pagination.Slice([]repositories.ApiKey{}, page), m.Err
+}
+
+func (m *mockCatalog) GetApiKeyByHash(_ context.Context, hash string) (*repositories.ApiKey, error) {
+	if key, ok := m.ApiKeys[hash]; ok {
+		return &key, nil
+	}
+	return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+}
+
+func (m *mockCatalog) DeleteApiKey(_ context.Context, _ string) error {
+	return m.Err
+}
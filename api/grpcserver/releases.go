@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/repositories"
+)
+
+type releasesServer struct {
+	atlasv1.UnimplementedReleasesServiceServer
+	repository repositories.ReleaseRepository
+}
+
+func (s *releasesServer) CreateRelease(ctx context.Context, req *atlasv1.CreateReleaseRequest) (*atlasv1.CreateReleaseResponse, error) {
+	release := repositories.Release{
+		ServiceId: req.GetServiceId(),
+		Version:   req.GetVersion(),
+		Url:       req.GetUrl(),
+	}
+	if req.GetReleaseDate() != nil {
+		release.ReleaseDate = req.GetReleaseDate().AsTime()
+	}
+	if err := release.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.repository.CreateRelease(ctx, release); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.CreateReleaseResponse{}, nil
+}
+
+func (s *releasesServer) ListReleases(ctx context.Context, req *atlasv1.ListReleasesRequest) (*atlasv1.ListReleasesResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	page, err := pageParams(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	releases, err := s.repository.GetReleasesByServiceId(ctx, req.GetServiceId(), page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*atlasv1.Release, 0, len(releases.Items))
+	for _, r := range releases.Items {
+		out = append(out, toRelease(r))
+	}
+	return &atlasv1.ListReleasesResponse{Releases: out}, nil
+}
+
+func (s *releasesServer) ListReleasesInRange(ctx context.Context, req *atlasv1.ListReleasesInRangeRequest) (*atlasv1.ListReleasesInRangeResponse, error) {
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, invalidArgument("start_date and end_date are required")
+	}
+	startDate, endDate := req.GetStartDate().AsTime(), req.GetEndDate().AsTime()
+	if endDate.Before(startDate) {
+		return nil, invalidArgument("End date must be after start date")
+	}
+	page, err := pageParams(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	releases, err := s.repository.GetReleasesInDateRange(ctx, startDate, endDate, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*atlasv1.ServiceRelease, 0, len(releases.Items))
+	for _, r := range releases.Items {
+		out = append(out, &atlasv1.ServiceRelease{
+			ServiceName: r.ServiceName,
+			ServiceType: r.ServiceType,
+			Release:     toRelease(&r.Release),
+		})
+	}
+	return &atlasv1.ListReleasesInRangeResponse{Releases: out}, nil
+}
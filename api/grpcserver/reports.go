@@ -0,0 +1,41 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+)
+
+type reportsServer struct {
+	atlasv1.UnimplementedReportsServiceServer
+	repository repositories.ReportRepository
+}
+
+func (s *reportsServer) GetServiceRiskReport(ctx context.Context, req *atlasv1.GetServiceRiskReportRequest) (*atlasv1.GetServiceRiskReportResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	report, err := s.repository.GetServiceRiskReport(ctx, req.GetServiceId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.GetServiceRiskReportResponse{
+		DebtCount:      report.DebtCount,
+		DependentCount: report.DependentCount,
+	}, nil
+}
+
+func (s *reportsServer) GetDebtCountByService(ctx context.Context, _ *atlasv1.GetDebtCountByServiceRequest) (*atlasv1.GetDebtCountByServiceResponse, error) {
+	report, err := pagination.All(maxPageSize, func(page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+		return s.repository.GetDebtCountByService(ctx, page)
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*atlasv1.ServiceDebtCount, 0, len(report))
+	for _, r := range report {
+		out = append(out, &atlasv1.ServiceDebtCount{Id: r.Id, Name: r.Name, Count: r.Count})
+	}
+	return &atlasv1.GetDebtCountByServiceResponse{Services: out}, nil
+}
@@ -0,0 +1,33 @@
+package grpcserver
+
+import (
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/config"
+	"service-atlas/repositories"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// New creates a gRPC server exposing the catalog kept in repos, with the request id, authentication, logging and
+// recovery interceptors applied to every call. Callers authenticate with the same API keys and scopes as the REST API.
+func New(repos repositories.Repositories, authConfig config.Auth, opts ...grpc.ServerOption) *grpc.Server {
+	authenticator := auth.FromConfig(authConfig, repos.ApiKeys, repos.Services, repos.Debt)
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(unaryRequestId, unaryAuthenticate(authenticator), unaryLogger, unaryRecoverer,
+			unaryAuthorize(authenticator), unaryTimeout),
+		grpc.ChainStreamInterceptor(streamRequestId, streamAuthenticate(authenticator), streamLogger, streamRecoverer,
+			streamAuthorize(authenticator)),
+	)
+	server := grpc.NewServer(opts...)
+	atlasv1.RegisterServicesServiceServer(server, &servicesServer{repository: repos.Services})
+	atlasv1.RegisterDependenciesServiceServer(server, &dependenciesServer{repository: repos.Dependencies})
+	atlasv1.RegisterTeamsServiceServer(server, &teamsServer{repository: repos.Teams, reports: repos.Reports})
+	atlasv1.RegisterDebtServiceServer(server, &debtServer{repository: repos.Debt})
+	atlasv1.RegisterReleasesServiceServer(server, &releasesServer{repository: repos.Releases})
+	atlasv1.RegisterReportsServiceServer(server, &reportsServer{repository: repos.Reports})
+	atlasv1.RegisterExportServiceServer(server, &exportServer{services: repos.Services, teams: repos.Teams, graph: repos.Graph})
+	reflection.Register(server)
+	return server
+}
@@ -0,0 +1,374 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/config"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	cartId = "11111111-1111-1111-1111-111111111111"
+	dbId   = "22222222-2222-2222-2222-222222222222"
+	shopId = "44444444-4444-4444-4444-444444444444"
+)
+
+func newTestCatalog() *mockCatalog {
+	return &mockCatalog{
+		Services: []repositories.Service{
+			{Id: cartId, Name: "cart", ServiceType: "api", Url: "https://cart"},
+			{Id: dbId, Name: "cart-db", ServiceType: "database", Url: "https://cart-db"},
+		},
+		Teams:  []repositories.Team{{Id: shopId, Name: "Shop"}},
+		Owners: map[string][]string{cartId: {shopId}},
+		Dependencies: map[string][]*repositories.Dependency{
+			cartId: {{Id: dbId, Name: "cart-db", ServiceType: "database", Version: "16"}},
+		},
+		Releases: map[string][]*repositories.Release{
+			cartId: {
+				{ServiceId: cartId, Version: "1.1.0", ReleaseDate: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+				{ServiceId: cartId, Version: "1.0.0", ReleaseDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		Debt: map[string][]repositories.Debt{
+			dbId: {{Id: "d1", ServiceId: dbId, Title: "no backups", Type: "infrastructure", Status: "pending"}},
+		},
+	}
+}
+
+// dial starts a server backed by catalog on an in-memory listener and returns a client connection to it.
+func dial(t *testing.T, catalog *mockCatalog) *grpc.ClientConn {
+	t.Helper()
+	return dialWithAuth(t, catalog, config.Auth{})
+}
+
+func dialWithAuth(t *testing.T, catalog *mockCatalog, authConfig config.Auth) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := New(repositories.Repositories{
+		Services:     catalog,
+		Dependencies: catalog,
+		Teams:        catalog,
+		Debt:         catalog,
+		Releases:     catalog,
+		Reports:      catalog,
+		Graph:        catalog,
+		ApiKeys:      catalog,
+	}, authConfig)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestServicesService_GetService(t *testing.T) {
+	client := atlasv1.NewServicesServiceClient(dial(t, newTestCatalog()))
+
+	resp, err := client.GetService(context.Background(), &atlasv1.GetServiceRequest{Id: cartId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetService().GetName() != "cart" || resp.GetService().GetType() != "api" {
+		t.Errorf("unexpected service %+v", resp.GetService())
+	}
+
+	_, err = client.GetService(context.Background(), &atlasv1.GetServiceRequest{Id: "99999999-9999-9999-9999-999999999999"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestServicesService_CreateServiceValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *atlasv1.CreateServiceRequest
+		code codes.Code
+	}{
+		{"Valid", &atlasv1.CreateServiceRequest{Name: "orders", Type: "api", Url: "https://orders"}, codes.OK},
+		{"MissingName", &atlasv1.CreateServiceRequest{Type: "api", Url: "https://orders"}, codes.InvalidArgument},
+		{"InvalidUrl", &atlasv1.CreateServiceRequest{Name: "orders", Type: "api", Url: "ftp://orders"}, codes.InvalidArgument},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog := newTestCatalog()
+			client := atlasv1.NewServicesServiceClient(dial(t, catalog))
+			resp, err := client.CreateService(context.Background(), tc.req)
+			if status.Code(err) != tc.code {
+				t.Fatalf("expected %v, got %v", tc.code, err)
+			}
+			if tc.code == codes.OK && (resp.GetId() == "" || len(catalog.CreatedServices) != 1) {
+				t.Errorf("expected service to be created, got %+v", catalog.CreatedServices)
+			}
+		})
+	}
+}
+
+func TestServicesService_UpdateServiceSetsLifecycleAndTags(t *testing.T) {
+	catalog := newTestCatalog()
+	client := atlasv1.NewServicesServiceClient(dial(t, catalog))
+
+	_, err := client.UpdateService(context.Background(), &atlasv1.UpdateServiceRequest{
+		Service: &atlasv1.Service{Id: cartId, Name: "basket", Type: "api", Url: "https://cart", Lifecycle: "Active",
+			Tags: []string{"Checkout"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(catalog.UpdatedServices) != 1 {
+		t.Fatalf("expected the service to be updated, got %+v", catalog.UpdatedServices)
+	}
+	updated := catalog.UpdatedServices[0]
+	if updated.Name != "basket" || updated.Lifecycle != "active" || len(updated.Tags) != 1 || updated.Tags[0] != "checkout" {
+		t.Errorf("expected the lifecycle and tags to be set, got %+v", updated)
+	}
+
+	_, err = client.UpdateService(context.Background(), &atlasv1.UpdateServiceRequest{
+		Service: &atlasv1.Service{Id: cartId, Name: "basket", Type: "api", Url: "https://cart", Lifecycle: "sunset"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for an unknown lifecycle, got %v", err)
+	}
+}
+
+func TestServicesService_ListServicesFilters(t *testing.T) {
+	catalog := newTestCatalog()
+	client := atlasv1.NewServicesServiceClient(dial(t, catalog))
+	hasOpenDebt := true
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := client.ListServices(context.Background(), &atlasv1.ListServicesRequest{
+		Filter: &atlasv1.ServiceFilter{Type: "api", Team: "payments", Lifecycle: "Active", Tags: []string{"Checkout"},
+			HasOpenDebt: &hasOpenDebt, CreatedAfter: timestamppb.New(after)},
+		Sort: "-name",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter := catalog.ListedFilters[0]
+	if filter.Type != "api" || filter.Team != "payments" || filter.Lifecycle != "active" || !slices.Equal(filter.Tags, []string{"checkout"}) ||
+		filter.HasOpenDebt == nil || !*filter.HasOpenDebt || !filter.CreatedAfter.Equal(after) || !filter.CreatedBefore.IsZero() ||
+		filter.Sort != repositories.SortByName || !filter.Descending {
+		t.Errorf("unexpected filter %+v", filter)
+	}
+
+	for _, req := range []*atlasv1.ListServicesRequest{
+		{Filter: &atlasv1.ServiceFilter{Lifecycle: "sunset"}},
+		{Sort: "colour"},
+	} {
+		if _, err = client.ListServices(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %+v, got %v", req, err)
+		}
+	}
+}
+
+func TestServicesService_ListServicesPageValidation(t *testing.T) {
+	client := atlasv1.NewServicesServiceClient(dial(t, newTestCatalog()))
+
+	resp, err := client.ListServices(context.Background(), &atlasv1.ListServicesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetServices()) != 2 {
+		t.Errorf("expected 2 services with default paging, got %d", len(resp.GetServices()))
+	}
+	for _, page := range []*atlasv1.Page{{Page: -1}, {PageSize: 101}} {
+		if _, err = client.ListServices(context.Background(), &atlasv1.ListServicesRequest{Page: page}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument for %+v, got %v", page, err)
+		}
+	}
+}
+
+func TestTeamsService_GetTeamNotFound(t *testing.T) {
+	client := atlasv1.NewTeamsServiceClient(dial(t, newTestCatalog()))
+	_, err := client.GetTeam(context.Background(), &atlasv1.GetTeamRequest{Id: cartId})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+}
+
+func TestDebtService(t *testing.T) {
+	catalog := newTestCatalog()
+	client := atlasv1.NewDebtServiceClient(dial(t, catalog))
+	ctx := context.Background()
+
+	if _, err := client.CreateDebt(ctx, &atlasv1.CreateDebtRequest{ServiceId: cartId, Type: "code", Title: "todo"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(catalog.CreatedDebt) != 1 || catalog.CreatedDebt[0].Status != "pending" {
+		t.Errorf("expected pending debt to be created, got %+v", catalog.CreatedDebt)
+	}
+	if _, err := client.CreateDebt(ctx, &atlasv1.CreateDebtRequest{ServiceId: cartId, Type: "unknown", Title: "todo"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for unknown type, got %v", err)
+	}
+	if _, err := client.UpdateDebtStatus(ctx, &atlasv1.UpdateDebtStatusRequest{Id: dbId, Status: "remediated"}); err != nil {
+		t.Fatal(err)
+	}
+	if catalog.UpdatedStatus[dbId] != "remediated" {
+		t.Errorf("unexpected status updates %+v", catalog.UpdatedStatus)
+	}
+	if _, err := client.UpdateDebtStatus(ctx, &atlasv1.UpdateDebtStatusRequest{Id: dbId, Status: "done"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for unknown status, got %v", err)
+	}
+}
+
+func TestReleasesService_CreateRelease(t *testing.T) {
+	catalog := newTestCatalog()
+	client := atlasv1.NewReleasesServiceClient(dial(t, catalog))
+	releaseDate := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := client.CreateRelease(context.Background(), &atlasv1.CreateReleaseRequest{
+		ServiceId:   cartId,
+		Version:     "2.0.0",
+		ReleaseDate: timestamppb.New(releaseDate),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(catalog.CreatedReleases) != 1 || !catalog.CreatedReleases[0].ReleaseDate.Equal(releaseDate) {
+		t.Errorf("unexpected releases %+v", catalog.CreatedReleases)
+	}
+	if _, err = client.CreateRelease(context.Background(), &atlasv1.CreateReleaseRequest{ServiceId: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestReportsService_GetServiceRiskReport(t *testing.T) {
+	client := atlasv1.NewReportsServiceClient(dial(t, newTestCatalog()))
+	resp, err := client.GetServiceRiskReport(context.Background(), &atlasv1.GetServiceRiskReportRequest{ServiceId: cartId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetDependentCount() != 3 || resp.GetDebtCount()["code"] != 2 {
+		t.Errorf("unexpected report %+v", resp)
+	}
+}
+
+func TestExportService_ExportGraph(t *testing.T) {
+	client := atlasv1.NewExportServiceClient(dial(t, newTestCatalog()))
+	stream, err := client.ExportGraph(context.Background(), &atlasv1.ExportGraphRequest{ReleasesPerService: 1, IncludeDebt: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []string
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch item.GetItem().(type) {
+		case *atlasv1.ExportGraphResponse_Team:
+			kinds = append(kinds, "team")
+		case *atlasv1.ExportGraphResponse_Service:
+			kinds = append(kinds, "service:"+item.GetService().GetName())
+		case *atlasv1.ExportGraphResponse_Dependency:
+			kinds = append(kinds, "dependency")
+		case *atlasv1.ExportGraphResponse_Ownership:
+			kinds = append(kinds, "ownership")
+		case *atlasv1.ExportGraphResponse_Release:
+			kinds = append(kinds, "release:"+item.GetRelease().GetVersion())
+		case *atlasv1.ExportGraphResponse_Debt:
+			kinds = append(kinds, "debt")
+		}
+	}
+	want := []string{"team", "service:cart", "dependency", "ownership", "release:1.1.0", "service:cart-db", "debt"}
+	if len(kinds) != len(want) {
+		t.Fatalf("unexpected export %v, want %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("unexpected export %v, want %v", kinds, want)
+		}
+	}
+}
+
+func TestExportService_ExportGraphError(t *testing.T) {
+	catalog := newTestCatalog()
+	catalog.Err = errors.New("database unavailable")
+	client := atlasv1.NewExportServiceClient(dial(t, catalog))
+	stream, err := client.ExportGraph(context.Background(), &atlasv1.ExportGraphRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal, got %v", err)
+	}
+}
+
+func TestRequestIdIsEchoed(t *testing.T) {
+	client := atlasv1.NewServicesServiceClient(dial(t, newTestCatalog()))
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestIdKey, "req-123")
+	var header metadata.MD
+	if _, err := client.ListServices(ctx, &atlasv1.ListServicesRequest{}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get(requestIdKey); len(got) != 1 || got[0] != "req-123" {
+		t.Errorf("expected request id to be echoed, got %v", got)
+	}
+
+	header = nil
+	if _, err := client.ListServices(context.Background(), &atlasv1.ListServicesRequest{}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get(requestIdKey); len(got) != 1 || got[0] == "" {
+		t.Errorf("expected a generated request id, got %v", got)
+	}
+}
+
+func TestPanicsAreRecovered(t *testing.T) {
+	catalog := newTestCatalog()
+	catalog.Panic = true
+	client := atlasv1.NewServicesServiceClient(dial(t, catalog))
+	if _, err := client.ListServices(context.Background(), &atlasv1.ListServicesRequest{}); status.Code(err) != codes.Internal {
+		t.Errorf("expected Internal, got %v", err)
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"HTTPErrorValue", customerrors.HTTPError{Status: http.StatusNotFound, Msg: "missing"}, codes.NotFound},
+		{"HTTPErrorPointer", &customerrors.HTTPError{Status: http.StatusBadRequest, Msg: "bad"}, codes.InvalidArgument},
+		{"Conflict", customerrors.HTTPError{Status: http.StatusConflict, Msg: "exists"}, codes.AlreadyExists},
+		{"ConflictError", customerrors.NewConflictError("exists", repositories.Service{}), codes.AlreadyExists},
+		{"Deadline", context.DeadlineExceeded, codes.DeadlineExceeded},
+		{"Status", status.Error(codes.Unavailable, "down"), codes.Unavailable},
+		{"Other", errors.New("boom"), codes.Internal},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := status.Code(toStatus(tc.err)); got != tc.code {
+				t.Errorf("expected %v, got %v", tc.code, got)
+			}
+		})
+	}
+}
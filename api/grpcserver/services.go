@@ -0,0 +1,125 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type servicesServer struct {
+	atlasv1.UnimplementedServicesServiceServer
+	repository repositories.ServiceRepository
+}
+
+func (s *servicesServer) ListServices(ctx context.Context, req *atlasv1.ListServicesRequest) (*atlasv1.ListServicesResponse, error) {
+	page, err := pageParams(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	filter, err := serviceFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	services, err := s.repository.GetAllServices(ctx, filter, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListServicesResponse{Services: toServices(services.Items)}, nil
+}
+
+func (s *servicesServer) GetService(ctx context.Context, req *atlasv1.GetServiceRequest) (*atlasv1.GetServiceResponse, error) {
+	if err := requireId("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	service, err := s.repository.GetServiceById(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	// Id will be empty if not found
+	if service.Id == "" {
+		return nil, status.Error(codes.NotFound, "Service not found")
+	}
+	return &atlasv1.GetServiceResponse{Service: toService(service)}, nil
+}
+
+func (s *servicesServer) CreateService(ctx context.Context, req *atlasv1.CreateServiceRequest) (*atlasv1.CreateServiceResponse, error) {
+	service := repositories.Service{
+		Name:        req.GetName(),
+		ServiceType: req.GetType(),
+		Description: req.GetDescription(),
+		Url:         req.GetUrl(),
+		Lifecycle:   req.GetLifecycle(),
+		Tags:        req.GetTags(),
+	}
+	if err := service.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	id, err := s.repository.CreateService(ctx, service)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.CreateServiceResponse{Id: id}, nil
+}
+
+func (s *servicesServer) UpdateService(ctx context.Context, req *atlasv1.UpdateServiceRequest) (*atlasv1.UpdateServiceResponse, error) {
+	if err := requireId("service.id", req.GetService().GetId()); err != nil {
+		return nil, err
+	}
+	service := repositories.Service{
+		Id:          req.GetService().GetId(),
+		Name:        req.GetService().GetName(),
+		ServiceType: req.GetService().GetType(),
+		Description: req.GetService().GetDescription(),
+		Url:         req.GetService().GetUrl(),
+		Lifecycle:   req.GetService().GetLifecycle(),
+		Tags:        req.GetService().GetTags(),
+	}
+	if err := service.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.repository.UpdateService(ctx, service); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.UpdateServiceResponse{}, nil
+}
+
+func (s *servicesServer) DeleteService(ctx context.Context, req *atlasv1.DeleteServiceRequest) (*atlasv1.DeleteServiceResponse, error) {
+	if err := requireId("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteService(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.DeleteServiceResponse{}, nil
+}
+
+func (s *servicesServer) SearchServices(ctx context.Context, req *atlasv1.SearchServicesRequest) (*atlasv1.SearchServicesResponse, error) {
+	if req.GetQuery() == "" {
+		return nil, invalidArgument("query is required")
+	}
+	query, err := searchquery.Parse(req.GetQuery())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	services, err := s.repository.Search(ctx, query, pagination.First(maxSearchResults))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.SearchServicesResponse{Services: toServices(services.Items)}, nil
+}
+
+func (s *servicesServer) ListServiceTeams(ctx context.Context, req *atlasv1.ListServiceTeamsRequest) (*atlasv1.ListServiceTeamsResponse, error) {
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	teams, err := s.repository.GetTeamsByServiceId(ctx, req.GetServiceId(), everything)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListServiceTeamsResponse{Teams: toTeams(teams.Items)}, nil
+}
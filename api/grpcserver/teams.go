@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+	atlasv1 "service-atlas/gen/serviceatlas/v1"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+)
+
+type teamsServer struct {
+	atlasv1.UnimplementedTeamsServiceServer
+	repository repositories.TeamRepository
+	reports    repositories.ReportRepository
+}
+
+func (s *teamsServer) ListTeams(ctx context.Context, req *atlasv1.ListTeamsRequest) (*atlasv1.ListTeamsResponse, error) {
+	page, err := pageParams(req.GetPage())
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.repository.GetTeams(ctx, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListTeamsResponse{Teams: toTeams(teams.Items)}, nil
+}
+
+func (s *teamsServer) GetTeam(ctx context.Context, req *atlasv1.GetTeamRequest) (*atlasv1.GetTeamResponse, error) {
+	if err := requireId("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	team, err := s.repository.GetTeam(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.GetTeamResponse{Team: toTeam(*team)}, nil
+}
+
+func (s *teamsServer) CreateTeam(ctx context.Context, req *atlasv1.CreateTeamRequest) (*atlasv1.CreateTeamResponse, error) {
+	team := repositories.Team{Name: req.GetName()}
+	if err := team.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	id, err := s.repository.CreateTeam(ctx, team)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.CreateTeamResponse{Id: id}, nil
+}
+
+func (s *teamsServer) UpdateTeam(ctx context.Context, req *atlasv1.UpdateTeamRequest) (*atlasv1.UpdateTeamResponse, error) {
+	if err := requireId("team.id", req.GetTeam().GetId()); err != nil {
+		return nil, err
+	}
+	team := repositories.Team{Id: req.GetTeam().GetId(), Name: req.GetTeam().GetName()}
+	if err := team.Validate(); err != nil {
+		return nil, invalidArgument(err.Error())
+	}
+	if err := s.repository.UpdateTeam(ctx, team); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.UpdateTeamResponse{}, nil
+}
+
+func (s *teamsServer) DeleteTeam(ctx context.Context, req *atlasv1.DeleteTeamRequest) (*atlasv1.DeleteTeamResponse, error) {
+	if err := requireId("id", req.GetId()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteTeam(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.DeleteTeamResponse{}, nil
+}
+
+func (s *teamsServer) AddTeamService(ctx context.Context, req *atlasv1.AddTeamServiceRequest) (*atlasv1.AddTeamServiceResponse, error) {
+	if err := requireId("team_id", req.GetTeamId()); err != nil {
+		return nil, err
+	}
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.CreateTeamAssociation(ctx, req.GetTeamId(), req.GetServiceId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.AddTeamServiceResponse{}, nil
+}
+
+func (s *teamsServer) RemoveTeamService(ctx context.Context, req *atlasv1.RemoveTeamServiceRequest) (*atlasv1.RemoveTeamServiceResponse, error) {
+	if err := requireId("team_id", req.GetTeamId()); err != nil {
+		return nil, err
+	}
+	if err := requireId("service_id", req.GetServiceId()); err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteTeamAssociation(ctx, req.GetTeamId(), req.GetServiceId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.RemoveTeamServiceResponse{}, nil
+}
+
+func (s *teamsServer) ListTeamServices(ctx context.Context, req *atlasv1.ListTeamServicesRequest) (*atlasv1.ListTeamServicesResponse, error) {
+	if err := requireId("team_id", req.GetTeamId()); err != nil {
+		return nil, err
+	}
+	services, err := pagination.All(maxPageSize, func(page pagination.Page) (pagination.Result[repositories.Service], error) {
+		return s.reports.GetServicesByTeam(ctx, req.GetTeamId(), page)
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &atlasv1.ListTeamServicesResponse{Services: toServices(services)}, nil
+}
@@ -0,0 +1,59 @@
+package integrations
+
+import (
+	"service-atlas/internal/config"
+	"service-atlas/repositories"
+	"slices"
+	"sync"
+)
+
+// CallsHandler handles release webhooks sent by source control and CI systems.
+type CallsHandler struct {
+	ServiceRepository repositories.ServiceRepository
+	ReleaseRepository repositories.ReleaseRepository
+	// GitHubSecret is the secret configured on GitHub webhooks, used to verify X-Hub-Signature-256.
+	GitHubSecret string
+	// CISecret is the secret used to verify X-Signature-256 (or the GitLab X-Gitlab-Token) on the generic CI endpoint.
+	CISecret string
+	// ReleaseBranches are the branches whose GitLab pipelines are recorded as releases, besides tag pipelines.
+	ReleaseBranches []string
+	// mu guards the settings, which SetWebhooks replaces while requests are served.
+	mu sync.RWMutex
+}
+
+func New(services repositories.ServiceRepository, releases repositories.ReleaseRepository, secrets config.Webhooks) *CallsHandler {
+	return &CallsHandler{
+		ServiceRepository: services,
+		ReleaseRepository: releases,
+		GitHubSecret:      secrets.GitHubSecret.Value(),
+		CISecret:          secrets.CISecret.Value(),
+		ReleaseBranches:   secrets.ReleaseBranches,
+	}
+}
+
+// SetWebhooks replaces the webhook secrets and release branches, for configuration reloads.
+func (c *CallsHandler) SetWebhooks(webhooks config.Webhooks) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.GitHubSecret = webhooks.GitHubSecret.Value()
+	c.CISecret = webhooks.CISecret.Value()
+	c.ReleaseBranches = webhooks.ReleaseBranches
+}
+
+func (c *CallsHandler) gitHubSecret() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.GitHubSecret
+}
+
+func (c *CallsHandler) ciSecret() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.CISecret
+}
+
+func (c *CallsHandler) isReleaseBranch(ref string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Contains(c.ReleaseBranches, ref)
+}
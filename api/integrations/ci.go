@@ -0,0 +1,122 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"time"
+)
+
+// gitLabTimeFormat is the timestamp format used in GitLab pipeline hook payloads.
+const gitLabTimeFormat = "2006-01-02 15:04:05 MST"
+
+// gitLabPipelinePayload is the subset of the GitLab "Pipeline Hook" payload used to record a release.
+type gitLabPipelinePayload struct {
+	ObjectKind       string `json:"object_kind"`
+	ObjectAttributes struct {
+		Ref        string `json:"ref"`
+		Tag        bool   `json:"tag"`
+		Sha        string `json:"sha"`
+		Status     string `json:"status"`
+		FinishedAt string `json:"finished_at"`
+		Url        string `json:"url"`
+	} `json:"object_attributes"`
+	Project struct {
+		WebUrl string `json:"web_url"`
+	} `json:"project"`
+}
+
+// ciReleasePayload is a CI-agnostic release notification for pipelines that are not GitLab.
+type ciReleasePayload struct {
+	RepositoryUrl string    `json:"repositoryUrl"`
+	Version       string    `json:"version"`
+	Url           string    `json:"url"`
+	ReleaseDate   time.Time `json:"releaseDate"`
+}
+
+// CIRelease records a release from a CI system. GitLab pipeline hooks are detected by their
+// X-Gitlab-Event header, and only successful pipelines for tags or the release branches are recorded; any other
+// body is read as a ciReleasePayload.
+// Requests must carry an X-Signature-256 HMAC of the body, or for GitLab, which cannot sign payloads,
+// the shared secret in X-Gitlab-Token.
+func (c *CallsHandler) CIRelease(rw http.ResponseWriter, req *http.Request) {
+	logger := internal.LoggerFromContext(req.Context())
+	secret := c.ciSecret()
+	if secret == "" {
+		http.Error(rw, "CI webhook secret is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	body, err := readBody(rw, req)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	gitLabEvent := req.Header.Get("X-Gitlab-Event")
+	verified := verifySignature(secret, body, req.Header.Get(ciSignature)) ||
+		(gitLabEvent != "" && verifyToken(secret, req.Header.Get(gitLabTokenHeader)))
+	if !verified {
+		logger.Warn("Rejected CI webhook with invalid signature")
+		http.Error(rw, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var repoUrl string
+	release := repositories.Release{}
+	if gitLabEvent != "" {
+		payload := gitLabPipelinePayload{}
+		if err = json.Unmarshal(body, &payload); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if payload.ObjectKind != "pipeline" {
+			writeIgnored(rw, "event "+gitLabEvent+" ignored")
+			return
+		}
+		if payload.ObjectAttributes.Status != "success" {
+			writeIgnored(rw, "pipeline status "+payload.ObjectAttributes.Status+" ignored")
+			return
+		}
+		// branch and merge request pipelines are builds, not releases, unless the branch is released from
+		if !payload.ObjectAttributes.Tag && !c.isReleaseBranch(payload.ObjectAttributes.Ref) {
+			writeIgnored(rw, "pipeline for "+payload.ObjectAttributes.Ref+", which is not a tag or release branch, ignored")
+			return
+		}
+		repoUrl = payload.Project.WebUrl
+		release.Url = payload.ObjectAttributes.Url
+		release.Version = payload.ObjectAttributes.Sha
+		if payload.ObjectAttributes.Tag {
+			release.Version = payload.ObjectAttributes.Ref
+		}
+		if finished, parseErr := time.Parse(gitLabTimeFormat, payload.ObjectAttributes.FinishedAt); parseErr == nil {
+			release.ReleaseDate = finished.UTC()
+		} else if finished, parseErr = time.Parse(time.RFC3339, payload.ObjectAttributes.FinishedAt); parseErr == nil {
+			release.ReleaseDate = finished.UTC()
+		}
+	} else {
+		payload := ciReleasePayload{}
+		if err = json.Unmarshal(body, &payload); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		repoUrl = payload.RepositoryUrl
+		release.Version = payload.Version
+		release.Url = payload.Url
+		release.ReleaseDate = payload.ReleaseDate
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+	defer cancel()
+	created, err := c.createRelease(ctxWithTimeout, repoUrl, release)
+	if err != nil {
+		logger.Debug("Error ingesting CI release",
+			slog.String("repository", repoUrl),
+			slog.String("error", err.Error()))
+		customerrors.HandleError(rw, err)
+		return
+	}
+	writeCreated(rw, req, created)
+}
@@ -0,0 +1,192 @@
+package integrations
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+	"time"
+)
+
+const gitLabPipelineBody = `{
+	"object_kind": "pipeline",
+	"object_attributes": {
+		"ref": "v3.1.0",
+		"tag": true,
+		"sha": "bcbb5ec396a2c0f828686f14fac9b80b780504f2",
+		"status": "success",
+		"finished_at": "2025-08-12 15:26:29 UTC",
+		"url": "https://gitlab.com/shop/cart/-/pipelines/31"
+	},
+	"project": {"web_url": "https://gitlab.com/shop/cart"}
+}`
+
+var gitLabService = repositories.Service{Id: testServiceId, Name: "cart", Url: "https://gitlab.com/shop/cart.git"}
+
+func newCIRequest(body string, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/integrations/releases/ci", strings.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func TestCIReleaseGitLabPipelineWithToken(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases, gitLabService)
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(gitLabPipelineBody, map[string]string{
+		"X-Gitlab-Event":  "Pipeline Hook",
+		gitLabTokenHeader: testSecret,
+	}))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rw.Code, rw.Body.String())
+	}
+	if len(releases.Created) != 1 {
+		t.Fatalf("expected 1 release created, got %d", len(releases.Created))
+	}
+	created := releases.Created[0]
+	switch {
+	case created.ServiceId != testServiceId:
+		t.Errorf("unexpected service id %q", created.ServiceId)
+	case created.Version != "v3.1.0":
+		t.Errorf("unexpected version %q", created.Version)
+	case created.Url != "https://gitlab.com/shop/cart/-/pipelines/31":
+		t.Errorf("unexpected url %q", created.Url)
+	case !created.ReleaseDate.Equal(time.Date(2025, 8, 12, 15, 26, 29, 0, time.UTC)):
+		t.Errorf("unexpected release date %v", created.ReleaseDate)
+	}
+}
+
+func TestCIReleaseGitLabBranchPipelineUsesSha(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases, gitLabService)
+	handler.ReleaseBranches = []string{"main"}
+	body := strings.Replace(gitLabPipelineBody, `"tag": true`, `"tag": false`, 1)
+	body = strings.Replace(body, `"ref": "v3.1.0"`, `"ref": "main"`, 1)
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{
+		"X-Gitlab-Event": "Pipeline Hook",
+		ciSignature:      Sign(testSecret, []byte(body)),
+	}))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rw.Code, rw.Body.String())
+	}
+	if releases.Created[0].Version != "bcbb5ec396a2c0f828686f14fac9b80b780504f2" {
+		t.Errorf("expected sha as version, got %q", releases.Created[0].Version)
+	}
+}
+
+func TestCIReleaseGitLabIgnoresOtherBranchPipelines(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases, gitLabService)
+	handler.ReleaseBranches = []string{"main"}
+	body := strings.Replace(gitLabPipelineBody, `"tag": true`, `"tag": false`, 1)
+	body = strings.Replace(body, `"ref": "v3.1.0"`, `"ref": "feature/checkout"`, 1)
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{
+		"X-Gitlab-Event": "Pipeline Hook",
+		ciSignature:      Sign(testSecret, []byte(body)),
+	}))
+
+	if rw.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, rw.Code, rw.Body.String())
+	}
+	if len(releases.Created) != 0 {
+		t.Errorf("expected no release for a feature branch, got %+v", releases.Created)
+	}
+}
+
+func TestCIReleaseGitLabIgnoresUnsuccessfulPipelines(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases, gitLabService)
+	body := strings.Replace(gitLabPipelineBody, `"status": "success"`, `"status": "failed"`, 1)
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{
+		"X-Gitlab-Event":  "Pipeline Hook",
+		gitLabTokenHeader: testSecret,
+	}))
+
+	if rw.Code != http.StatusAccepted {
+		t.Errorf("expected status %d, got %d", http.StatusAccepted, rw.Code)
+	}
+	if len(releases.Created) != 0 {
+		t.Errorf("expected no releases created, got %d", len(releases.Created))
+	}
+}
+
+func TestCIReleaseGenericPayload(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases)
+	body := `{"repositoryUrl": "git@github.com:shop/cart.git", "version": "2025.10.1", "url": "https://ci.example.com/builds/42"}`
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{ciSignature: Sign(testSecret, []byte(body))}))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rw.Code, rw.Body.String())
+	}
+	if len(releases.Created) != 1 || releases.Created[0].Version != "2025.10.1" {
+		t.Fatalf("unexpected releases created: %+v", releases.Created)
+	}
+}
+
+func TestCIReleaseGenericPayloadMissingVersionAndUrl(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{})
+	body := `{"repositoryUrl": "https://github.com/shop/cart"}`
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{ciSignature: Sign(testSecret, []byte(body))}))
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
+	}
+}
+
+func TestCIReleaseRejectsInvalidSignature(t *testing.T) {
+	body := `{"repositoryUrl": "https://github.com/shop/cart", "version": "1"}`
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{"Missing", nil},
+		{"WrongSignature", map[string]string{ciSignature: Sign("wrong", []byte(body))}},
+		// the token fallback only applies to GitLab hooks
+		{"TokenWithoutGitLabEvent", map[string]string{gitLabTokenHeader: testSecret}},
+		{"WrongGitLabToken", map[string]string{"X-Gitlab-Event": "Pipeline Hook", gitLabTokenHeader: "wrong"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			releases := &mockReleaseRepository{}
+			handler := newTestHandler(releases)
+			rw := httptest.NewRecorder()
+			handler.CIRelease(rw, newCIRequest(body, tc.headers))
+			if rw.Code != http.StatusUnauthorized {
+				t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rw.Code)
+			}
+			if len(releases.Created) != 0 {
+				t.Errorf("expected no releases created, got %d", len(releases.Created))
+			}
+		})
+	}
+}
+
+func TestCIReleaseRepositoryError(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{Err: errors.New("database unavailable")})
+	body := `{"repositoryUrl": "https://github.com/shop/cart", "version": "1"}`
+	rw := httptest.NewRecorder()
+
+	handler.CIRelease(rw, newCIRequest(body, map[string]string{ciSignature: Sign(testSecret, []byte(body))}))
+
+	if rw.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rw.Code)
+	}
+}
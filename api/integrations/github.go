@@ -0,0 +1,109 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"strings"
+	"time"
+)
+
+type gitHubRepository struct {
+	HtmlUrl string `json:"html_url"`
+}
+
+// gitHubReleasePayload is the subset of the GitHub "release" webhook payload used to record a release.
+type gitHubReleasePayload struct {
+	Action  string `json:"action"`
+	Release struct {
+		TagName     string     `json:"tag_name"`
+		HtmlUrl     string     `json:"html_url"`
+		Draft       bool       `json:"draft"`
+		PublishedAt *time.Time `json:"published_at"`
+	} `json:"release"`
+	Repository gitHubRepository `json:"repository"`
+}
+
+// gitHubCreatePayload is the subset of the GitHub "create" webhook payload, sent when a branch or tag is created.
+type gitHubCreatePayload struct {
+	Ref        string           `json:"ref"`
+	RefType    string           `json:"ref_type"`
+	Repository gitHubRepository `json:"repository"`
+}
+
+// GitHubRelease records a release from a GitHub "release" (published) or tag "create" webhook.
+// The repository html_url is matched against Service.Url to find the service.
+func (c *CallsHandler) GitHubRelease(rw http.ResponseWriter, req *http.Request) {
+	logger := internal.LoggerFromContext(req.Context())
+	secret := c.gitHubSecret()
+	if secret == "" {
+		http.Error(rw, "GitHub webhook secret is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	body, err := readBody(rw, req)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	if !verifySignature(secret, body, req.Header.Get(gitHubSignature)) {
+		logger.Warn("Rejected GitHub webhook with invalid signature")
+		http.Error(rw, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var repoUrl string
+	release := repositories.Release{}
+	switch event := req.Header.Get("X-GitHub-Event"); event {
+	case "ping":
+		writeIgnored(rw, "pong")
+		return
+	case "release":
+		payload := gitHubReleasePayload{}
+		if err = json.Unmarshal(body, &payload); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if payload.Action != "published" || payload.Release.Draft {
+			writeIgnored(rw, "release action "+payload.Action+" ignored")
+			return
+		}
+		repoUrl = payload.Repository.HtmlUrl
+		release.Version = payload.Release.TagName
+		release.Url = payload.Release.HtmlUrl
+		if payload.Release.PublishedAt != nil {
+			release.ReleaseDate = payload.Release.PublishedAt.UTC()
+		}
+	case "create":
+		payload := gitHubCreatePayload{}
+		if err = json.Unmarshal(body, &payload); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if payload.RefType != "tag" {
+			writeIgnored(rw, payload.RefType+" creation ignored")
+			return
+		}
+		repoUrl = payload.Repository.HtmlUrl
+		release.Version = payload.Ref
+		release.Url = strings.TrimSuffix(repoUrl, "/") + "/releases/tag/" + payload.Ref
+	default:
+		writeIgnored(rw, "event "+event+" ignored")
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+	defer cancel()
+	created, err := c.createRelease(ctxWithTimeout, repoUrl, release)
+	if err != nil {
+		logger.Debug("Error ingesting GitHub release",
+			slog.String("repository", repoUrl),
+			slog.String("error", err.Error()))
+		customerrors.HandleError(rw, err)
+		return
+	}
+	writeCreated(rw, req, created)
+}
@@ -0,0 +1,192 @@
+package integrations
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testSecret    = "It's a Secret to Everybody"
+	testServiceId = "123e4567-e89b-12d3-a456-426614174000"
+)
+
+func newTestHandler(releases *mockReleaseRepository, services ...repositories.Service) *CallsHandler {
+	if services == nil {
+		services = []repositories.Service{{Id: testServiceId, Name: "cart", Url: "https://github.com/shop/cart"}}
+	}
+	return &CallsHandler{
+		ServiceRepository: mockServiceRepository{Services: services},
+		ReleaseRepository: releases,
+		GitHubSecret:      testSecret,
+		CISecret:          testSecret,
+	}
+}
+
+func newGitHubRequest(event, body, signature string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/integrations/releases/github", strings.NewReader(body))
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set(gitHubSignature, signature)
+	return req
+}
+
+const gitHubReleaseBody = `{
+	"action": "published",
+	"release": {
+		"tag_name": "v1.4.0",
+		"html_url": "https://github.com/shop/cart/releases/tag/v1.4.0",
+		"draft": false,
+		"published_at": "2025-10-01T12:30:00Z"
+	},
+	"repository": {"html_url": "https://github.com/shop/cart"}
+}`
+
+func TestGitHubReleasePublished(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases)
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("release", gitHubReleaseBody, Sign(testSecret, []byte(gitHubReleaseBody))))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rw.Code, rw.Body.String())
+	}
+	if len(releases.Created) != 1 {
+		t.Fatalf("expected 1 release created, got %d", len(releases.Created))
+	}
+	created := releases.Created[0]
+	switch {
+	case created.ServiceId != testServiceId:
+		t.Errorf("unexpected service id %q", created.ServiceId)
+	case created.Version != "v1.4.0":
+		t.Errorf("unexpected version %q", created.Version)
+	case created.Url != "https://github.com/shop/cart/releases/tag/v1.4.0":
+		t.Errorf("unexpected url %q", created.Url)
+	case !created.ReleaseDate.Equal(time.Date(2025, 10, 1, 12, 30, 0, 0, time.UTC)):
+		t.Errorf("unexpected release date %v", created.ReleaseDate)
+	}
+}
+
+func TestGitHubReleaseInvalidSignature(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases)
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{"Missing", ""},
+		{"WrongSecret", Sign("wrong", []byte(gitHubReleaseBody))},
+		{"NotHex", "sha256=zzzz"},
+		{"WrongAlgorithm", "sha1=abcdef"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			handler.GitHubRelease(rw, newGitHubRequest("release", gitHubReleaseBody, tc.signature))
+			if rw.Code != http.StatusUnauthorized {
+				t.Errorf("expected status %d, got %d", http.StatusUnauthorized, rw.Code)
+			}
+		})
+	}
+	if len(releases.Created) != 0 {
+		t.Errorf("expected no releases created, got %d", len(releases.Created))
+	}
+}
+
+func TestGitHubReleaseSecretNotConfigured(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{})
+	handler.GitHubSecret = ""
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("release", gitHubReleaseBody, Sign("", []byte(gitHubReleaseBody))))
+
+	if rw.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, rw.Code)
+	}
+}
+
+func TestGitHubTagCreate(t *testing.T) {
+	releases := &mockReleaseRepository{}
+	handler := newTestHandler(releases)
+	body := `{"ref": "v2.0.0", "ref_type": "tag", "repository": {"html_url": "https://github.com/shop/cart"}}`
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("create", body, Sign(testSecret, []byte(body))))
+
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, rw.Code, rw.Body.String())
+	}
+	if len(releases.Created) != 1 || releases.Created[0].Version != "v2.0.0" {
+		t.Fatalf("unexpected releases created: %+v", releases.Created)
+	}
+	if releases.Created[0].ReleaseDate.IsZero() {
+		t.Error("expected release date to default to now")
+	}
+}
+
+func TestGitHubIgnoredEvents(t *testing.T) {
+	tests := []struct {
+		name  string
+		event string
+		body  string
+	}{
+		{"Ping", "ping", `{"zen": "Keep it logically awesome."}`},
+		{"DraftRelease", "release", `{"action": "created", "release": {"tag_name": "v1", "draft": true}}`},
+		{"BranchCreate", "create", `{"ref": "main", "ref_type": "branch"}`},
+		{"Push", "push", `{"ref": "refs/heads/main"}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			releases := &mockReleaseRepository{}
+			handler := newTestHandler(releases)
+			rw := httptest.NewRecorder()
+			handler.GitHubRelease(rw, newGitHubRequest(tc.event, tc.body, Sign(testSecret, []byte(tc.body))))
+			if rw.Code != http.StatusAccepted {
+				t.Errorf("expected status %d, got %d", http.StatusAccepted, rw.Code)
+			}
+			if len(releases.Created) != 0 {
+				t.Errorf("expected no releases created, got %d", len(releases.Created))
+			}
+		})
+	}
+}
+
+func TestGitHubReleaseUnknownRepository(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{}, repositories.Service{Id: testServiceId, Url: "https://github.com/shop/orders"})
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("release", gitHubReleaseBody, Sign(testSecret, []byte(gitHubReleaseBody))))
+
+	if rw.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rw.Code)
+	}
+}
+
+func TestGitHubReleaseAmbiguousRepository(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{},
+		repositories.Service{Id: testServiceId, Url: "https://github.com/shop/cart"},
+		repositories.Service{Id: "223e4567-e89b-12d3-a456-426614174000", Url: "git@github.com:shop/cart.git"},
+	)
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("release", gitHubReleaseBody, Sign(testSecret, []byte(gitHubReleaseBody))))
+
+	if rw.Code != http.StatusConflict {
+		t.Errorf("expected status %d, got %d", http.StatusConflict, rw.Code)
+	}
+}
+
+func TestGitHubReleaseInvalidJSON(t *testing.T) {
+	handler := newTestHandler(&mockReleaseRepository{})
+	body := "not json"
+	rw := httptest.NewRecorder()
+
+	handler.GitHubRelease(rw, newGitHubRequest("release", body, Sign(testSecret, []byte(body))))
+
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
+	}
+}
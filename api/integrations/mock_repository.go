@@ -0,0 +1,78 @@
+package integrations
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"time"
+)
+
+// mockServiceRepository only supports looking services up by url; the remaining methods are unused by webhooks.
+type mockServiceRepository struct {
+	Services []repositories.Service
+	Err      error
+}
+
+func (repo mockServiceRepository) GetServicesByUrl(_ context.Context, repoUrl string) ([]repositories.Service, error) {
+	if repo.Err != nil {
+		return nil, repo.Err
+	}
+	matches := make([]repositories.Service, 0)
+	for _, svc := range repo.Services {
+		if repositories.NormalizeRepositoryUrl(svc.Url) == repositories.NormalizeRepositoryUrl(repoUrl) {
+			matches = append(matches, svc)
+		}
+	}
+	return matches, nil
+}
+
+func (repo mockServiceRepository) GetAllServices(_ context.Context, _ repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	return pagination.Slice(repo.Services, page), repo.Err
+}
+
+func (repo mockServiceRepository) CreateService(_ context.Context, _ repositories.Service) (string, error) {
+	return "", repo.Err
+}
+
+func (repo mockServiceRepository) UpdateService(_ context.Context, _ repositories.Service) error {
+	return repo.Err
+}
+
+func (repo mockServiceRepository) DeleteService(_ context.Context, _ string) error {
+	return repo.Err
+}
+
+func (repo mockServiceRepository) GetServiceById(_ context.Context, _ string) (repositories.Service, error) {
+	return repositories.Service{}, repo.Err
+}
+
+func (repo mockServiceRepository) Search(_ context.Context, _ searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	return pagination.Slice(repo.Services, page), repo.Err
+}
+
+func (repo mockServiceRepository) GetTeamsByServiceId(_ context.Context, _ string, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	return pagination.NewResult([]repositories.Team{}, page), repo.Err
+}
+
+// mockReleaseRepository records the releases it is asked to create.
+type mockReleaseRepository struct {
+	Created []repositories.Release
+	Err     error
+}
+
+func (repo *mockReleaseRepository) CreateRelease(_ context.Context, release repositories.Release) error {
+	if repo.Err != nil {
+		return repo.Err
+	}
+	repo.Created = append(repo.Created, release)
+	return nil
+}
+
+func (repo *mockReleaseRepository) GetReleasesByServiceId(_ context.Context, _ string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
+	return pagination.Slice([]*repositories.Release{}, page), repo.Err
+}
+
+func (repo *mockReleaseRepository) GetReleasesInDateRange(_ context.Context, _, _ time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
+	return pagination.Slice([]*repositories.ServiceReleaseInfo{}, page), repo.Err
+}
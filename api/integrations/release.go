@@ -0,0 +1,82 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"time"
+)
+
+// ingestedRelease is returned to the webhook caller once a release has been recorded.
+type ingestedRelease struct {
+	ServiceId   string    `json:"service_id"`
+	ServiceName string    `json:"service_name"`
+	ReleaseDate time.Time `json:"release_date"`
+	Url         string    `json:"url,omitempty"`
+	Version     string    `json:"version,omitempty"`
+}
+
+// createRelease resolves the service registered for repoUrl and records the release against it.
+func (c *CallsHandler) createRelease(ctx context.Context, repoUrl string, release repositories.Release) (*ingestedRelease, error) {
+	if repoUrl == "" {
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusBadRequest,
+			Msg:    "Payload does not contain a repository url",
+		}
+	}
+	services, err := c.ServiceRepository.GetServicesByUrl(ctx, repoUrl)
+	if err != nil {
+		return nil, err
+	}
+	switch len(services) {
+	case 0:
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("No service registered with url %s", repoUrl),
+		}
+	case 1:
+	default:
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusConflict,
+			Msg:    fmt.Sprintf("%d services are registered with url %s", len(services), repoUrl),
+		}
+	}
+	release.ServiceId = services[0].Id
+	if err = release.Validate(); err != nil {
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusBadRequest,
+			Msg:    err.Error(),
+		}
+	}
+	if err = c.ReleaseRepository.CreateRelease(ctx, release); err != nil {
+		return nil, err
+	}
+	return &ingestedRelease{
+		ServiceId:   services[0].Id,
+		ServiceName: services[0].Name,
+		ReleaseDate: release.ReleaseDate,
+		Url:         release.Url,
+		Version:     release.Version,
+	}, nil
+}
+
+func writeCreated(rw http.ResponseWriter, req *http.Request, release *ingestedRelease) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(release); err != nil {
+		internal.LoggerFromContext(req.Context()).Debug("Error encoding release json",
+			slog.String("error", err.Error()))
+	}
+}
+
+// writeIgnored acknowledges a valid webhook that does not describe a release.
+func writeIgnored(rw http.ResponseWriter, reason string) {
+	rw.Header().Set("Content-Type", "text/plain")
+	rw.WriteHeader(http.StatusAccepted)
+	_, _ = rw.Write([]byte(reason))
+}
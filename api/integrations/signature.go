@@ -0,0 +1,63 @@
+package integrations
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"strings"
+)
+
+const (
+	// maxPayloadSize limits webhook bodies; GitHub caps payloads at 25 MB but release events are far smaller.
+	maxPayloadSize    = 1 << 20 // 1 MB
+	signaturePrefix   = "sha256="
+	gitHubSignature   = "X-Hub-Signature-256"
+	ciSignature       = "X-Signature-256"
+	gitLabTokenHeader = "X-Gitlab-Token"
+)
+
+// readBody reads the raw request body, which is needed as-is to verify the signature.
+func readBody(rw http.ResponseWriter, req *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(rw, req.Body, maxPayloadSize))
+	if err != nil {
+		return nil, &customerrors.HTTPError{
+			Status: internal.BodyErrorStatus(err, http.StatusBadRequest),
+			Msg:    "Unable to read request body",
+		}
+	}
+	return body, nil
+}
+
+// verifySignature checks a "sha256=<hex>" HMAC-SHA256 signature of body using secret.
+func verifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	provided, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(provided, mac.Sum(nil))
+}
+
+// verifyToken compares a shared secret token in constant time.
+func verifyToken(secret, token string) bool {
+	if secret == "" || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
+
+// Sign returns the "sha256=<hex>" signature for body, as expected in X-Hub-Signature-256 and X-Signature-256.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
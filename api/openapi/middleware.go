@@ -0,0 +1,251 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"service-atlas/internal"
+	"service-atlas/internal/versioning"
+	"strconv"
+	"strings"
+)
+
+// Mode selects what the validation middleware checks.
+type Mode string
+
+const (
+	// ModeOff disables validation.
+	ModeOff Mode = "off"
+	// ModeRequests rejects requests that do not match the document with a 400.
+	ModeRequests Mode = "requests"
+	// ModeAll also replaces responses that do not match the document with a 500, surfacing drift in development.
+	ModeAll Mode = "all"
+)
+
+// maxBodySize bounds the request and response bodies buffered for validation.
+const maxBodySize = 1 << 20
+
+// ParseMode parses the OPENAPI_VALIDATION setting, defaulting to ModeOff.
+func ParseMode(value string) (Mode, bool) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(value))); mode {
+	case "":
+		return ModeOff, true
+	case ModeOff, ModeRequests, ModeAll:
+		return mode, true
+	}
+	return ModeOff, false
+}
+
+// ValidationError is the body returned when a request or response does not match the document.
+type ValidationError struct {
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors"`
+}
+
+// Validator returns middleware validating requests, and in ModeAll responses, against the document of the API
+// version the request path is under. Paths without a version prefix are checked against the latest version.
+func Validator(mode Mode) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if mode != ModeRequests && mode != ModeAll {
+			return next
+		}
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			doc, path, versionedOnly := Spec(), r.URL.Path, false
+			for _, version := range versioning.Versions {
+				if rest, ok := strings.CutPrefix(r.URL.Path, version.Prefix()); ok && (rest == "" || rest[0] == '/') {
+					doc, path, versionedOnly = SpecFor(version), rest, true
+				}
+			}
+			doc.serve(mode, next, rw, r, path, versionedOnly)
+		})
+	}
+}
+
+// Validator returns middleware validating requests, and in ModeAll responses, against the document.
+// Requests for paths or methods the document does not describe pass through untouched.
+func (d *Document) Validator(mode Mode) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if mode != ModeRequests && mode != ModeAll {
+			return next
+		}
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			d.serve(mode, next, rw, r, r.URL.Path, false)
+		})
+	}
+}
+
+// serve checks a request for path, and its response in ModeAll, before and after calling next.
+func (d *Document) serve(mode Mode, next http.Handler, rw http.ResponseWriter, r *http.Request, path string, versionedOnly bool) {
+	operation, pathParams := d.matchOperation(r.Method, path, versionedOnly)
+	if operation == nil {
+		next.ServeHTTP(rw, r)
+		return
+	}
+	errs, err := d.validateRequest(r, operation, pathParams)
+	if err != nil {
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
+		return
+	}
+	if len(errs) > 0 {
+		writeValidationError(rw, http.StatusBadRequest, "request does not match the API specification", errs)
+		return
+	}
+	if mode != ModeAll {
+		next.ServeHTTP(rw, r)
+		return
+	}
+
+	recorder := &responseRecorder{header: rw.Header(), status: http.StatusOK}
+	next.ServeHTTP(recorder, r)
+	if errs = d.validateResponse(operation, recorder); len(errs) > 0 {
+		internal.LoggerFromContext(r.Context()).Error("Response does not match the API specification",
+			slog.String("operation", operation.OperationId),
+			slog.Int("status", recorder.status),
+			slog.Any("errors", errs))
+		writeValidationError(rw, http.StatusInternalServerError, "response does not match the API specification", errs)
+		return
+	}
+	rw.WriteHeader(recorder.status)
+	_, _ = rw.Write(recorder.body.Bytes())
+}
+
+// validateRequest checks the parameters and body of r. The body is restored so handlers can read it again.
+func (d *Document) validateRequest(r *http.Request, operation *Operation, pathParams map[string]string) ([]FieldError, error) {
+	var errs []FieldError
+	query := r.URL.Query()
+	for _, p := range operation.Parameters {
+		var value string
+		var present bool
+		switch p.In {
+		case "path":
+			value, present = pathParams[p.Name]
+			value, _ = url.PathUnescape(value)
+		case "query":
+			present = query.Has(p.Name)
+			value = query.Get(p.Name)
+		case "header":
+			value = r.Header.Get(p.Name)
+			present = value != ""
+		default:
+			continue
+		}
+		field := p.In + "." + p.Name
+		if !present {
+			if p.Required {
+				errs = append(errs, FieldError{Field: field, Message: "is required"})
+			}
+			continue
+		}
+		errs = append(errs, d.validate(field, coerce(value, p.Schema), p.Schema)...)
+	}
+
+	if operation.RequestBody == nil {
+		return errs, nil
+	}
+	media, ok := operation.RequestBody.Content["application/json"]
+	if !ok {
+		return errs, nil
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if len(bytes.TrimSpace(body)) == 0 {
+		if operation.RequestBody.Required {
+			errs = append(errs, FieldError{Field: "body", Message: "is required"})
+		}
+		return errs, nil
+	}
+	value, err := decode(body)
+	if err != nil {
+		return append(errs, FieldError{Field: "body", Message: "must be valid JSON"}), nil
+	}
+	return append(errs, d.validate("body", value, media.Schema)...), nil
+}
+
+// validateResponse checks that the status is documented and that JSON bodies match their schema.
+func (d *Document) validateResponse(operation *Operation, recorder *responseRecorder) []FieldError {
+	response, ok := d.response(operation, recorder.status)
+	if !ok {
+		return []FieldError{{Field: "status", Message: strconv.Itoa(recorder.status) + " is not documented"}}
+	}
+	mediaType, _, _ := mime.ParseMediaType(recorder.header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		return nil
+	}
+	media, ok := response.Content[mediaType]
+	if !ok {
+		return []FieldError{{Field: "body", Message: "application/json is not documented for " + strconv.Itoa(recorder.status)}}
+	}
+	value, err := decode(recorder.body.Bytes())
+	if err != nil {
+		return []FieldError{{Field: "body", Message: "must be valid JSON"}}
+	}
+	return d.validate("body", value, media.Schema)
+}
+
+// coerce converts a parameter string to the JSON type its schema expects, leaving it unchanged if it does not parse.
+func coerce(value string, schema *Schema) any {
+	if schema == nil {
+		return value
+	}
+	for _, t := range schema.Type {
+		switch t {
+		case "integer", "number":
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				return json.Number(value)
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+		}
+	}
+	return value
+}
+
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func writeValidationError(rw http.ResponseWriter, status int, message string, errs []FieldError) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Del("Content-Length")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(ValidationError{Message: message, Errors: errs})
+}
+
+// responseRecorder buffers a response so it can be validated before being sent.
+type responseRecorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.body.Write(b)
+}
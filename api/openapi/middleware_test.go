@@ -0,0 +1,138 @@
+package openapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const serviceId = "c56e119a-94ed-425d-b936-9dfc54b2307f"
+
+// echoHandler records the request body it received and replies with the configured response.
+type echoHandler struct {
+	status      int
+	contentType string
+	body        string
+	received    string
+	called      bool
+}
+
+func (h *echoHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	h.called = true
+	b, _ := io.ReadAll(r.Body)
+	h.received = string(b)
+	if h.contentType != "" {
+		rw.Header().Set("Content-Type", h.contentType)
+	}
+	rw.WriteHeader(h.status)
+	_, _ = io.WriteString(rw, h.body)
+}
+
+func fieldErrors(t *testing.T, rw *httptest.ResponseRecorder) []string {
+	t.Helper()
+	var body ValidationError
+	if err := json.Unmarshal(rw.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid validation error body %q: %v", rw.Body.String(), err)
+	}
+	fields := make([]string, 0, len(body.Errors))
+	for _, e := range body.Errors {
+		fields = append(fields, e.Field)
+	}
+	return fields
+}
+
+func TestValidator_Requests(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		fields []string
+	}{
+		{"ValidCreateService", http.MethodPost, "/services", `{"name":"cart","type":"api","url":"https://cart"}`, nil},
+		{"MissingRequiredFields", http.MethodPost, "/services", `{"description":"cart"}`, []string{"body.name", "body.type", "body.url"}},
+		{"WrongType", http.MethodPost, "/services", `{"name":1,"type":"api","url":"https://cart"}`, []string{"body.name"}},
+		{"BadUrlScheme", http.MethodPost, "/services", `{"name":"cart","type":"api","url":"ftp://cart"}`, []string{"body.url"}},
+		{"InvalidJson", http.MethodPost, "/services", `{"name":`, []string{"body"}},
+		{"MissingBody", http.MethodPost, "/teams", ``, []string{"body"}},
+		{"EnumMismatch", http.MethodPost, "/services/" + serviceId + "/debt", `{"type":"vibes","title":"x"}`, []string{"body.type"}},
+		{"InvalidPathId", http.MethodGet, "/services/not-a-uuid", ``, []string{"path.id"}},
+		{"MissingRequiredQuery", http.MethodGet, "/services/search", ``, []string{"query.query"}},
+		{"QueryOutOfRange", http.MethodGet, "/services?page=1&pageSize=500", ``, []string{"query.pageSize"}},
+		{"QueryNotInteger", http.MethodGet, "/teams?page=first", ``, []string{"query.page"}},
+		{"InvalidDate", http.MethodGet, "/releases/2025-01-01/tomorrow", ``, []string{"path.endDate"}},
+		{"MissingHeader", http.MethodPost, "/integrations/releases/github", `{}`, []string{"header.X-Hub-Signature-256", "header.X-GitHub-Event"}},
+		{"UndocumentedPath", http.MethodGet, "/nowhere", ``, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := &echoHandler{status: http.StatusOK}
+			rw := httptest.NewRecorder()
+			Spec().Validator(ModeRequests)(next).ServeHTTP(rw, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+
+			if tc.fields == nil {
+				if !next.called || rw.Code != http.StatusOK {
+					t.Fatalf("expected request to pass, got %d: %s", rw.Code, rw.Body.String())
+				}
+				if next.received != tc.body {
+					t.Errorf("handler received %q, want %q", next.received, tc.body)
+				}
+				return
+			}
+			if next.called || rw.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d", rw.Code)
+			}
+			if fields := fieldErrors(t, rw); !slices.Equal(fields, tc.fields) {
+				t.Errorf("got field errors %v, want %v", fields, tc.fields)
+			}
+		})
+	}
+}
+
+func TestValidator_Responses(t *testing.T) {
+	validService := `{"id":"` + serviceId + `","name":"cart","type":"api","description":"","created":"2025-01-01T00:00:00Z","url":"https://cart"}`
+	tests := []struct {
+		name   string
+		next   *echoHandler
+		status int
+	}{
+		{"Valid", &echoHandler{status: http.StatusOK, contentType: "application/json", body: validService}, http.StatusOK},
+		{"DocumentedTextError", &echoHandler{status: http.StatusNotFound, contentType: "text/plain", body: "Service not found"}, http.StatusNotFound},
+		{"SchemaMismatch", &echoHandler{status: http.StatusOK, contentType: "application/json", body: `{"id":"x"}`}, http.StatusInternalServerError},
+		{"UndocumentedStatus", &echoHandler{status: http.StatusTeapot, contentType: "text/plain", body: "teapot"}, http.StatusInternalServerError},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			Spec().Validator(ModeAll)(tc.next).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/services/"+serviceId, nil))
+			if rw.Code != tc.status {
+				t.Fatalf("expected %d, got %d: %s", tc.status, rw.Code, rw.Body.String())
+			}
+			if tc.status != http.StatusInternalServerError && rw.Body.String() != tc.next.body {
+				t.Errorf("response body was altered: %q", rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestValidator_ResponsesNotCheckedInRequestMode(t *testing.T) {
+	next := &echoHandler{status: http.StatusOK, contentType: "application/json", body: `{"id":"x"}`}
+	rw := httptest.NewRecorder()
+	Spec().Validator(ModeRequests)(next).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/services/"+serviceId, nil))
+	if rw.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rw.Code)
+	}
+}
+
+func TestValidator_Off(t *testing.T) {
+	next := &echoHandler{status: http.StatusOK}
+	rw := httptest.NewRecorder()
+	Spec().Validator(ModeOff)(next).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/services", nil))
+	if !next.called {
+		t.Error("expected request to pass through when validation is off")
+	}
+}
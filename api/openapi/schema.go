@@ -0,0 +1,215 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Schema is the subset of JSON Schema used by the document.
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       schemaTypes        `json:"type"`
+	Format     string             `json:"format"`
+	Pattern    string             `json:"pattern"`
+	Enum       []any              `json:"enum"`
+	Required   []string           `json:"required"`
+	Properties map[string]*Schema `json:"properties"`
+	Items      *Schema            `json:"items"`
+	MinLength  *int               `json:"minLength"`
+	MaxLength  *int               `json:"maxLength"`
+	Minimum    *float64           `json:"minimum"`
+	Maximum    *float64           `json:"maximum"`
+	// AdditionalProperties is the schema of properties not listed in Properties;
+	// when NoAdditionalProperties is set they are not allowed at all.
+	AdditionalProperties   *Schema `json:"-"`
+	NoAdditionalProperties bool    `json:"-"`
+}
+
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type schema Schema
+	aux := struct {
+		*schema
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}{schema: (*schema)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	switch strings.TrimSpace(string(aux.AdditionalProperties)) {
+	case "", "true":
+	case "false":
+		s.NoAdditionalProperties = true
+	default:
+		s.AdditionalProperties = &Schema{}
+		return json.Unmarshal(aux.AdditionalProperties, s.AdditionalProperties)
+	}
+	return nil
+}
+
+// schemaTypes holds the JSON Schema type keyword, which OpenAPI 3.1 allows to be a string or a list.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+// FieldError describes why the value at Field does not match the document.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// validate checks value, as decoded by encoding/json with UseNumber, against schema.
+func (d *Document) validate(field string, value any, schema *Schema) []FieldError {
+	if schema == nil {
+		return nil
+	}
+	if schema.Ref != "" {
+		resolved, ok := d.Components.Schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+		if !ok {
+			return []FieldError{{Field: field, Message: "unresolved schema " + schema.Ref}}
+		}
+		return d.validate(field, value, resolved)
+	}
+	if len(schema.Type) > 0 && !slices.Contains(schema.Type, jsonType(value)) &&
+		!(jsonType(value) == "integer" && slices.Contains(schema.Type, "number")) {
+		return []FieldError{{Field: field, Message: fmt.Sprintf("must be of type %s", strings.Join(schema.Type, " or "))}}
+	}
+	if len(schema.Enum) > 0 && !slices.ContainsFunc(schema.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(value) }) {
+		return []FieldError{{Field: field, Message: fmt.Sprintf("must be one of %v", schema.Enum)}}
+	}
+
+	var errs []FieldError
+	switch v := value.(type) {
+	case string:
+		errs = append(errs, validateString(field, v, schema)...)
+	case json.Number:
+		n, _ := v.Float64()
+		if schema.Minimum != nil && n < *schema.Minimum {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must be at least %v", *schema.Minimum)})
+		}
+		if schema.Maximum != nil && n > *schema.Maximum {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must be at most %v", *schema.Maximum)})
+		}
+	case []any:
+		for i, item := range v {
+			errs = append(errs, d.validate(fmt.Sprintf("%s[%d]", field, i), item, schema.Items)...)
+		}
+	case map[string]any:
+		for _, name := range schema.Required {
+			if _, ok := v[name]; !ok {
+				errs = append(errs, FieldError{Field: join(field, name), Message: "is required"})
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			if property, ok := schema.Properties[name]; ok {
+				errs = append(errs, d.validate(join(field, name), v[name], property)...)
+			} else if schema.NoAdditionalProperties {
+				errs = append(errs, FieldError{Field: join(field, name), Message: "is not allowed"})
+			} else if schema.AdditionalProperties != nil {
+				errs = append(errs, d.validate(join(field, name), v[name], schema.AdditionalProperties)...)
+			}
+		}
+	}
+	return errs
+}
+
+func validateString(field, v string, schema *Schema) []FieldError {
+	var errs []FieldError
+	length := len([]rune(v))
+	if schema.MinLength != nil && length < *schema.MinLength {
+		if *schema.MinLength == 1 {
+			errs = append(errs, FieldError{Field: field, Message: "must not be empty"})
+		} else {
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must be at least %d characters", *schema.MinLength)})
+		}
+	}
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("must be at most %d characters", *schema.MaxLength)})
+	}
+	if schema.Pattern != "" {
+		if re, err := regexp.Compile(schema.Pattern); err == nil && !re.MatchString(v) {
+			errs = append(errs, FieldError{Field: field, Message: "must match " + schema.Pattern})
+		}
+	}
+	if msg := checkFormat(schema.Format, v); msg != "" {
+		errs = append(errs, FieldError{Field: field, Message: msg})
+	}
+	return errs
+}
+
+func checkFormat(format, v string) string {
+	switch format {
+	case "uuid":
+		if uuid.Validate(v) != nil {
+			return "must be a uuid"
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, v); err != nil {
+			return "must be a date (YYYY-MM-DD)"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return "must be an RFC 3339 date-time"
+		}
+	case "uri":
+		if u, err := url.Parse(v); err != nil || u.Scheme == "" {
+			return "must be an absolute uri"
+		}
+	}
+	return ""
+}
+
+// jsonType names the JSON Schema type of a value decoded with UseNumber.
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return "unknown"
+}
+
+func join(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
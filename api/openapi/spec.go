@@ -0,0 +1,252 @@
+// Package openapi serves the OpenAPI description of the REST API and validates traffic against it.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/versioning"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// specJSON is the OpenAPI 3.1 document for the v1 and unversioned routes registered in routes.SetupRouter.
+// A test in the routes package fails when the two disagree.
+//
+//go:embed openapi.json
+var specJSON []byte
+
+// Document is the subset of an OpenAPI 3.1 document used for validation.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Servers    []Server            `json:"servers"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components struct {
+		Schemas   map[string]*Schema   `json:"schemas"`
+		Responses map[string]*Response `json:"responses"`
+	} `json:"components"`
+
+	raw    []byte
+	routes []route
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem maps lower case http methods to operations.
+type PathItem map[string]*Operation
+
+// pathItemServers reads the path level servers, which PathItem drops.
+type pathItemServers struct {
+	Paths map[string]struct {
+		Servers []Server `json:"servers"`
+	} `json:"paths"`
+}
+
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// UnmarshalJSON keeps the operations of a path item, skipping fields such as servers and parameters.
+func (p *PathItem) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*p = make(PathItem)
+	for _, method := range methods {
+		raw, ok := fields[method]
+		if !ok {
+			continue
+		}
+		operation := &Operation{}
+		if err := json.Unmarshal(raw, operation); err != nil {
+			return err
+		}
+		(*p)[method] = operation
+	}
+	return nil
+}
+
+type Operation struct {
+	OperationId string               `json:"operationId"`
+	Parameters  []Parameter          `json:"parameters"`
+	RequestBody *RequestBody         `json:"requestBody"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Ref     string               `json:"$ref"`
+	Content map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Route is an operation in the document. Unversioned routes are served from the root rather than a version prefix.
+type Route struct {
+	Method      string
+	Path        string
+	Unversioned bool
+}
+
+// route is a path template split into segments, for matching request paths.
+type route struct {
+	template    string
+	segments    []string
+	literals    int
+	unversioned bool
+}
+
+var loadSpecs = sync.OnceValues(func() (map[versioning.Version]*Document, error) {
+	v1, err := Parse(specJSON)
+	if err != nil {
+		return nil, err
+	}
+	v2JSON, err := deriveV2(specJSON)
+	if err != nil {
+		return nil, err
+	}
+	v2, err := Parse(v2JSON)
+	if err != nil {
+		return nil, err
+	}
+	return map[versioning.Version]*Document{versioning.V1: v1, versioning.V2: v2}, nil
+})
+
+// Spec returns the embedded OpenAPI document, which describes the latest stable version.
+func Spec() *Document {
+	return SpecFor(versioning.Latest)
+}
+
+// SpecFor returns the OpenAPI document of an API version, or nil if the version is unknown.
+func SpecFor(version versioning.Version) *Document {
+	docs, err := loadSpecs()
+	if err != nil {
+		// the document is embedded at build time and covered by tests
+		panic("invalid embedded OpenAPI document: " + err.Error())
+	}
+	return docs[version]
+}
+
+// Parse decodes an OpenAPI document.
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{raw: data}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	var servers pathItemServers
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, err
+	}
+	for template := range doc.Paths {
+		r := route{template: template, segments: strings.Split(strings.Trim(template, "/"), "/")}
+		for _, server := range servers.Paths[template].Servers {
+			r.unversioned = r.unversioned || server.URL == "/"
+		}
+		for _, s := range r.segments {
+			if !isParam(s) {
+				r.literals++
+			}
+		}
+		doc.routes = append(doc.routes, r)
+	}
+	// prefer literal segments, so /services/search wins over /services/{id}
+	sort.Slice(doc.routes, func(i, j int) bool {
+		if doc.routes[i].literals != doc.routes[j].literals {
+			return doc.routes[i].literals > doc.routes[j].literals
+		}
+		return doc.routes[i].template < doc.routes[j].template
+	})
+	return doc, nil
+}
+
+// Routes lists every operation in the document, sorted by path then method.
+func (d *Document) Routes() []Route {
+	routes := make([]Route, 0)
+	for _, r := range d.routes {
+		for method := range d.Paths[r.template] {
+			routes = append(routes, Route{Method: strings.ToUpper(method), Path: r.template, Unversioned: r.unversioned})
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// findOperation matches a request path against the document's path templates.
+func (d *Document) findOperation(method, path string) (*Operation, map[string]string) {
+	return d.matchOperation(method, path, false)
+}
+
+// matchOperation is findOperation, optionally skipping unversioned paths for requests made under a version prefix.
+func (d *Document) matchOperation(method, path string, versionedOnly bool) (*Operation, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, r := range d.routes {
+		if len(r.segments) != len(segments) || (versionedOnly && r.unversioned) {
+			continue
+		}
+		params := make(map[string]string)
+		matched := true
+		for i, s := range r.segments {
+			if isParam(s) {
+				params[strings.Trim(s, "{}")] = segments[i]
+			} else if s != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if operation, ok := d.Paths[r.template][strings.ToLower(method)]; ok {
+			return operation, params
+		}
+	}
+	return nil, nil
+}
+
+// response resolves the response documented for a status code, following references.
+func (d *Document) response(operation *Operation, status int) (*Response, bool) {
+	response, ok := operation.Responses[strconv.Itoa(status)]
+	if !ok {
+		response, ok = operation.Responses["default"]
+	}
+	if ok && response.Ref != "" {
+		response, ok = d.Components.Responses[strings.TrimPrefix(response.Ref, "#/components/responses/")]
+	}
+	return response, ok
+}
+
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// Handler serves the OpenAPI document of the version the request was routed to as JSON.
+func Handler(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	if _, err := rw.Write(SpecFor(versioning.FromContext(r.Context())).raw); err != nil {
+		internal.LoggerFromContext(r.Context()).Debug("Error writing OpenAPI document",
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,110 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSpec_IsOpenAPI31(t *testing.T) {
+	if Spec().OpenAPI != "3.1.0" {
+		t.Errorf("unexpected openapi version %q", Spec().OpenAPI)
+	}
+}
+
+// TestSpec_ReferencesResolve walks every schema reachable from the document and checks each $ref exists.
+func TestSpec_ReferencesResolve(t *testing.T) {
+	doc := Spec()
+	var walk func(where string, s *Schema)
+	walk = func(where string, s *Schema) {
+		if s == nil {
+			return
+		}
+		if s.Ref != "" {
+			if _, ok := doc.Components.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]; !ok {
+				t.Errorf("%s: unresolved reference %s", where, s.Ref)
+			}
+		}
+		for name, p := range s.Properties {
+			walk(where+"."+name, p)
+		}
+		walk(where+"[]", s.Items)
+		walk(where+".*", s.AdditionalProperties)
+	}
+	for name, s := range doc.Components.Schemas {
+		walk(name, s)
+	}
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			where := method + " " + path
+			if op.OperationId == "" {
+				t.Errorf("%s has no operationId", where)
+			}
+			for _, p := range op.Parameters {
+				walk(where+" "+p.Name, p.Schema)
+			}
+			if op.RequestBody != nil {
+				for _, media := range op.RequestBody.Content {
+					walk(where+" body", media.Schema)
+				}
+			}
+			for status, r := range op.Responses {
+				if r.Ref != "" {
+					if _, ok := doc.Components.Responses[strings.TrimPrefix(r.Ref, "#/components/responses/")]; !ok {
+						t.Errorf("%s %s: unresolved reference %s", where, status, r.Ref)
+					}
+				}
+				for _, media := range r.Content {
+					walk(where+" "+status, media.Schema)
+				}
+			}
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	rw := httptest.NewRecorder()
+	Handler(rw, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if rw.Code != http.StatusOK || rw.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("unexpected response %d %q", rw.Code, rw.Header().Get("Content-Type"))
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(rw.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+}
+
+func TestFindOperation_PrefersLiteralSegments(t *testing.T) {
+	op, params := Spec().findOperation(http.MethodGet, "/services/search")
+	if op == nil || op.OperationId != "searchServices" || len(params) != 0 {
+		t.Errorf("expected searchServices, got %+v", op)
+	}
+	op, params = Spec().findOperation(http.MethodGet, "/services/c56e119a-94ed-425d-b936-9dfc54b2307f/")
+	if op == nil || op.OperationId != "getService" || params["id"] != "c56e119a-94ed-425d-b936-9dfc54b2307f" {
+		t.Errorf("expected getService, got %+v %v", op, params)
+	}
+	if op, _ = Spec().findOperation(http.MethodPatch, "/services"); op != nil {
+		t.Errorf("expected no operation, got %+v", op)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		value string
+		mode  Mode
+		ok    bool
+	}{
+		{"", ModeOff, true},
+		{"off", ModeOff, true},
+		{"Requests", ModeRequests, true},
+		{"all", ModeAll, true},
+		{"strict", ModeOff, false},
+	}
+	for _, tc := range tests {
+		if mode, ok := ParseMode(tc.value); mode != tc.mode || ok != tc.ok {
+			t.Errorf("ParseMode(%q) = %q, %v; want %q, %v", tc.value, mode, ok, tc.mode, tc.ok)
+		}
+	}
+}
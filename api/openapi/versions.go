@@ -0,0 +1,57 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// deriveV2 builds the v2 document from the v1 document. v2 serves the same operations under /v2,
+// with successful array responses wrapped in a pagination.Result, which carries the total in place of the
+// X-Total-Count header. Unversioned paths are left to v1.
+func deriveV2(v1 []byte) ([]byte, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(v1, &doc); err != nil {
+		return nil, err
+	}
+	doc["servers"] = []any{map[string]any{"url": "/v2", "description": "Version 2"}}
+
+	paths, _ := doc["paths"].(map[string]any)
+	for template, value := range paths {
+		item, _ := value.(map[string]any)
+		if _, unversioned := item["servers"]; unversioned {
+			delete(paths, template)
+			continue
+		}
+		for _, method := range methods {
+			operation, _ := item[method].(map[string]any)
+			responses, _ := operation["responses"].(map[string]any)
+			for status, value := range responses {
+				if !strings.HasPrefix(status, "2") {
+					continue
+				}
+				response, _ := value.(map[string]any)
+				content, _ := response["content"].(map[string]any)
+				media, _ := content["application/json"].(map[string]any)
+				if schema, _ := media["schema"].(map[string]any); schema["type"] == "array" {
+					media["schema"] = envelopeSchema(schema)
+					headers, _ := response["headers"].(map[string]any)
+					delete(headers, "X-Total-Count")
+				}
+			}
+		}
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// envelopeSchema describes a pagination.Result of items.
+func envelopeSchema(items map[string]any) map[string]any {
+	return map[string]any{
+		"type":     "object",
+		"required": []any{"items"},
+		"properties": map[string]any{
+			"items":      items,
+			"nextCursor": map[string]any{"type": "string"},
+			"totalCount": map[string]any{"type": "integer", "minimum": 0},
+		},
+	}
+}
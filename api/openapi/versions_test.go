@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/versioning"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSpecFor_V2(t *testing.T) {
+	v1, v2 := SpecFor(versioning.V1), SpecFor(versioning.V2)
+	if v2 == nil || len(v2.Servers) != 1 || v2.Servers[0].URL != "/v2" {
+		t.Fatalf("unexpected v2 servers %v", v2.Servers)
+	}
+	for _, r := range v2.Routes() {
+		if r.Unversioned {
+			t.Errorf("%s %s: unversioned paths belong to v1 only", r.Method, r.Path)
+		}
+	}
+	if _, ok := v2.Paths["/graphql"]; ok {
+		t.Error("expected /graphql to be left out of v2")
+	}
+
+	list, _ := v2.findOperation(http.MethodGet, "/services")
+	schema := list.Responses["200"].Content["application/json"].Schema
+	if schema.Properties["items"] == nil || !slices.Contains(schema.Properties["items"].Type, "array") {
+		t.Errorf("expected list responses to be enveloped in v2")
+	}
+	single, _ := v2.findOperation(http.MethodGet, "/services/"+serviceId)
+	if got := single.Responses["200"].Content["application/json"].Schema; got.Ref == "" {
+		t.Errorf("expected single resources to be unchanged in v2")
+	}
+	if original, _ := v1.findOperation(http.MethodGet, "/services"); !slices.Contains(original.Responses["200"].Content["application/json"].Schema.Type, "array") {
+		t.Error("deriving v2 changed the v1 document")
+	}
+}
+
+func TestValidator_Versions(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		body   string
+		status int
+	}{
+		{"V1", "/v1/services?page=1", `[]`, http.StatusOK},
+		{"V2Envelope", "/v2/services?page=1", `{"items":[],"nextCursor":"eyJvIjoyNX0"}`, http.StatusOK},
+		{"V2ArrayRejected", "/v2/services?page=1", `[]`, http.StatusInternalServerError},
+		{"Legacy", "/services?page=1", `[]`, http.StatusOK},
+		{"LegacyRequestChecked", "/services?limit=0", `[]`, http.StatusBadRequest},
+		{"VersionedRequestChecked", "/v2/services?limit=101", `{"items":[]}`, http.StatusBadRequest},
+		// unversioned paths are not served under a version prefix, so are not checked there
+		{"UnversionedUnderPrefix", "/v1/time", `[]`, http.StatusOK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := &echoHandler{status: http.StatusOK, contentType: "application/json", body: tc.body}
+			rw := httptest.NewRecorder()
+			Validator(ModeAll)(next).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, tc.target, nil))
+			if rw.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, rw.Code, strings.TrimSpace(rw.Body.String()))
+			}
+		})
+	}
+}
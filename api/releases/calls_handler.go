@@ -1,18 +1,13 @@
 package releases
 
-import (
-	"service-atlas/neo4jrepositories/releaserepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type ServiceCallsHandler struct {
 	Repository repositories.ReleaseRepository
 }
 
-func New(driver neo4j.DriverWithContext) *ServiceCallsHandler {
+func New(repository repositories.ReleaseRepository) *ServiceCallsHandler {
 	return &ServiceCallsHandler{
-		Repository: releaserepository.New(driver),
+		Repository: repository,
 	}
 }
@@ -16,11 +16,9 @@ func (s *ServiceCallsHandler) CreateRelease(rw http.ResponseWriter, req *http.Re
 	}
 
 	r := &repositories.Release{}
-	const maxBodySize = 1 << 20 // 1 MB
-	req.Body = http.MaxBytesReader(rw, req.Body, maxBodySize)
 	err := json.NewDecoder(req.Body).Decode(r)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 
@@ -1,11 +1,10 @@
 package releases
 
 import (
-	"encoding/json"
 	"net/http"
 	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
-	"strconv"
+	"service-atlas/internal/pagination"
 )
 
 func (s *ServiceCallsHandler) GetReleasesByServiceId(rw http.ResponseWriter, req *http.Request) {
@@ -15,27 +14,18 @@ func (s *ServiceCallsHandler) GetReleasesByServiceId(rw http.ResponseWriter, req
 		return
 	}
 
-	page, pageSize, err := validatePageParams(req)
+	page, err := pagination.FromRequest(req, pagination.DefaultLimit)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
 
-	releases, err := s.Repository.GetReleasesByServiceId(req.Context(), serviceId, page, pageSize)
+	releases, err := s.Repository.GetReleasesByServiceId(req.Context(), serviceId, page)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-
-	// Set content type header
-	rw.Header().Set("Content-Type", "application/json")
-
-	// Encode the releases as JSON and write to the response
-	err = json.NewEncoder(rw).Encode(releases)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	pagination.Write(rw, req, releases)
 }
 
 func (s *ServiceCallsHandler) GetReleasesInDateRange(rw http.ResponseWriter, req *http.Request) {
@@ -54,57 +44,16 @@ func (s *ServiceCallsHandler) GetReleasesInDateRange(rw http.ResponseWriter, req
 		return
 	}
 
-	page, pageSize, err := validatePageParams(req)
+	page, err := pagination.FromRequest(req, pagination.DefaultLimit)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
 
-	releases, err := s.Repository.GetReleasesInDateRange(req.Context(), startDate, endDate, page, pageSize)
+	releases, err := s.Repository.GetReleasesInDateRange(req.Context(), startDate, endDate, page)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(releases)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
-	}
-}
-
-func validatePageParams(req *http.Request) (int, int, error) {
-
-	// Parse page and page size from query parameters
-	pageStr := req.URL.Query().Get("page")
-	pageSizeStr := req.URL.Query().Get("pageSize")
-
-	// Default values
-	page := 1
-	pageSize := 25
-
-	// Parse page if provided
-	if pageStr != "" {
-		parsedPage, err := strconv.Atoi(pageStr)
-		if err != nil || parsedPage <= 0 {
-			return 0, 0, &customerrors.HTTPError{
-				Status: http.StatusBadRequest,
-				Msg:    "Invalid page parameter",
-			}
-		}
-		page = parsedPage
-	}
-
-	// Parse page size if provided
-	if pageSizeStr != "" {
-		parsedPageSize, err := strconv.Atoi(pageSizeStr)
-		if err != nil || parsedPageSize <= 0 {
-			return 0, 0, &customerrors.HTTPError{
-				Status: http.StatusBadRequest,
-				Msg:    "Invalid pageSize parameter",
-			}
-		}
-		pageSize = parsedPageSize
-	}
-
-	return page, pageSize, nil
+	pagination.Write(rw, req, releases)
 }
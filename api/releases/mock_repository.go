@@ -2,6 +2,7 @@ package releases
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 	"time"
 )
@@ -21,47 +22,16 @@ func (repo mockReleaseRepository) CreateRelease(_ context.Context, _ repositorie
 	return nil
 }
 
-func (repo mockReleaseRepository) GetReleasesByServiceId(_ context.Context, _ string, page, pageSize int) ([]*repositories.Release, error) {
+func (repo mockReleaseRepository) GetReleasesByServiceId(_ context.Context, _ string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[*repositories.Release]{}, repo.Err
 	}
-
-	// Calculate start and end indices for pagination
-	start := (page - 1) * pageSize
-	end := start + pageSize
-
-	// Check if start is beyond the array length
-	if start >= len(repo.Releases) {
-		return []*repositories.Release{}, nil
-	}
-
-	// Ensure end doesn't exceed array length
-	if end > len(repo.Releases) {
-		end = len(repo.Releases)
-	}
-
-	// Return the paginated mock releases
-	return repo.Releases[start:end], nil
+	return pagination.Slice(repo.Releases, page), nil
 }
 
-func (repo mockReleaseRepository) GetReleasesInDateRange(_ context.Context, _ time.Time, _ time.Time, page, pageSize int) ([]*repositories.ServiceReleaseInfo, error) {
+func (repo mockReleaseRepository) GetReleasesInDateRange(_ context.Context, _ time.Time, _ time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[*repositories.ServiceReleaseInfo]{}, repo.Err
 	}
-	// Calculate start and end indices for pagination
-	start := (page - 1) * pageSize
-	end := start + pageSize
-
-	// Check if start is beyond the array length
-	if start >= len(repo.ServiceInfo) {
-		return []*repositories.ServiceReleaseInfo{}, nil
-	}
-
-	// Ensure end doesn't exceed array length
-	if end > len(repo.ServiceInfo) {
-		end = len(repo.ServiceInfo)
-	}
-
-	// Return the paginated mock releases
-	return repo.ServiceInfo[start:end], nil
+	return pagination.Slice(repo.ServiceInfo, page), nil
 }
@@ -1,18 +1,13 @@
 package reports
 
-import (
-	"service-atlas/neo4jrepositories/reportrepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type CallsHandler struct {
 	repository repositories.ReportRepository
 }
 
-func New(driver neo4j.DriverWithContext) *CallsHandler {
+func New(repository repositories.ReportRepository) *CallsHandler {
 	return &CallsHandler{
-		repository: reportrepository.New(driver),
+		repository: repository,
 	}
 }
@@ -2,29 +2,24 @@ package reports
 
 import (
 	"context"
-	"encoding/json"
-	"log/slog"
 	"net/http"
-	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	"time"
 )
 
 func (c *CallsHandler) GetServiceDebtReport(rw http.ResponseWriter, req *http.Request) {
-	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
-	defer cancel()
-	report, err := c.repository.GetDebtCountByService(ctxWithTimeout)
+	page, err := pagination.FromRequest(req, pagination.Unlimited)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	encoder := json.NewEncoder(rw)
-	err = encoder.Encode(report)
+	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+	defer cancel()
+	report, err := c.repository.GetDebtCountByService(ctxWithTimeout, page)
 	if err != nil {
-		logger := internal.LoggerFromContext(req.Context())
-		logger.Debug("Error encoding debt report json",
-			slog.String("error", err.Error()),
-		)
+		customerrors.HandleError(rw, err)
+		return
 	}
+	pagination.Write(rw, req, report)
 }
@@ -0,0 +1,30 @@
+package reports
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"time"
+)
+
+// GetIntegrityReport lists duplicated service and team names and service urls, and debt and releases without a
+// service, so they can be cleaned up.
+func (c *CallsHandler) GetIntegrityReport(rw http.ResponseWriter, req *http.Request) {
+	// the whole catalog is read, so allow longer than the other reports
+	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 30*time.Second)
+	defer cancel()
+	report, err := c.repository.GetIntegrityReport(ctxWithTimeout)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(report); err != nil {
+		internal.LoggerFromContext(req.Context()).Debug("Error encoding integrity report json",
+			slog.String("error", err.Error()),
+		)
+	}
+}
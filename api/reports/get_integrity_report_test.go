@@ -0,0 +1,42 @@
+package reports
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/repositories"
+	"testing"
+)
+
+func TestGetIntegrityReport(t *testing.T) {
+	handler := CallsHandler{
+		repository: mockReportRepository{Integrity: &repositories.IntegrityReport{
+			DuplicateTeamNames: []repositories.Duplicate{{Value: "shop", Ids: []string{"t1", "t2"}}},
+			OrphanedDebt:       []repositories.Debt{{Id: "d1", Title: "lost"}},
+		}},
+	}
+	rw := httptest.NewRecorder()
+	handler.GetIntegrityReport(rw, httptest.NewRequest(http.MethodGet, "/reports/integrity", nil))
+
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rw.Code)
+	}
+	var got repositories.IntegrityReport
+	if err := json.NewDecoder(rw.Body).Decode(&got); err != nil {
+		t.Fatalf("failed decoding response: %v", err)
+	}
+	if len(got.DuplicateTeamNames) != 1 || got.DuplicateTeamNames[0].Value != "shop" || len(got.OrphanedDebt) != 1 {
+		t.Errorf("unexpected report %+v", got)
+	}
+}
+
+func TestGetIntegrityReportRepositoryError(t *testing.T) {
+	handler := CallsHandler{repository: mockReportRepository{Err: errors.New("boom")}}
+	rw := httptest.NewRecorder()
+	handler.GetIntegrityReport(rw, httptest.NewRequest(http.MethodGet, "/reports/integrity", nil))
+
+	if rw.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rw.Code)
+	}
+}
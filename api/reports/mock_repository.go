@@ -2,15 +2,18 @@ package reports
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 )
 
 // mockReportRepository is a mock implementation of the ReportRepository interface
 type mockReportRepository struct {
-	Err      error
-	Report   *repositories.ServiceRiskReport
-	Services []repositories.Service
-	Debt     []repositories.ServiceDebtReport
+	Err       error
+	Report    *repositories.ServiceRiskReport
+	Services  []repositories.Service
+	Debt      []repositories.ServiceDebtReport
+	Counts    *repositories.CatalogCounts
+	Integrity *repositories.IntegrityReport
 }
 
 func (repo mockReportRepository) GetServiceRiskReport(_ context.Context, _ string) (*repositories.ServiceRiskReport, error) {
@@ -20,19 +23,30 @@ func (repo mockReportRepository) GetServiceRiskReport(_ context.Context, _ strin
 	return repo.Report, nil
 }
 
-func (repo mockReportRepository) GetServicesByTeam(_ context.Context, _ string) ([]repositories.Service, error) {
+func (repo mockReportRepository) GetServicesByTeam(_ context.Context, _ string, page pagination.Page) (pagination.Result[repositories.Service], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[repositories.Service]{}, repo.Err
+	}
+	return pagination.Slice(repo.Services, page), nil
+}
+
+func (repo mockReportRepository) GetDebtCountByService(_ context.Context, page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+	if repo.Err != nil {
+		return pagination.Result[repositories.ServiceDebtReport]{}, repo.Err
 	}
-	if repo.Services != nil {
-		return repo.Services, nil
+	return pagination.Slice(repo.Debt, page), nil
+}
+
+func (repo mockReportRepository) GetCatalogCounts(_ context.Context) (*repositories.CatalogCounts, error) {
+	if repo.Err != nil {
+		return nil, repo.Err
 	}
-	return []repositories.Service{}, nil
+	return repo.Counts, nil
 }
 
-func (repo mockReportRepository) GetDebtCountByService(_ context.Context) ([]repositories.ServiceDebtReport, error) {
+func (repo mockReportRepository) GetIntegrityReport(_ context.Context) (*repositories.IntegrityReport, error) {
 	if repo.Err != nil {
 		return nil, repo.Err
 	}
-	return repo.Debt, nil
+	return repo.Integrity, nil
 }
@@ -3,90 +3,229 @@ package routes
 import (
 	"log/slog"
 	"net/http"
+	"service-atlas/api/apikeys"
 	"service-atlas/api/debt"
 	"service-atlas/api/dependencies"
+	"service-atlas/api/graph"
 	"service-atlas/api/helloworld"
+	"service-atlas/api/integrations"
+	"service-atlas/api/openapi"
 	"service-atlas/api/releases"
 	"service-atlas/api/reports"
+	"service-atlas/api/search"
 	"service-atlas/api/services"
 	"service-atlas/api/system"
 	"service-atlas/api/teams"
 	"service-atlas/internal"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/config"
+	"service-atlas/internal/metrics"
+	"service-atlas/internal/ratelimit"
+	"service-atlas/internal/tracing"
+	"service-atlas/internal/versioning"
+	"service-atlas/repositories"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func SetupRouter(driver neo4j.DriverWithContext) http.Handler {
+// legacyDeprecatedAt is when the unversioned API paths were deprecated in favour of /v1.
+var legacyDeprecatedAt = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+// defaultLegacySunset is when the unversioned API paths stop being served, unless api.legacySunset says otherwise.
+var defaultLegacySunset = legacyDeprecatedAt.AddDate(0, 6, 0)
+
+// maxEntityBodySize bounds the bodies of the routes creating and updating catalog entities, which are small
+// JSON documents; the global limits.maxBodyBytes still applies to everything else.
+const maxEntityBodySize int64 = 64 << 10 // 64 KB
+
+// handlers are the REST handlers mounted under every API version.
+type handlers struct {
+	service    *services.ServiceCallsHandler
+	debt       *debt.CallsHandler
+	dependency *dependencies.ServiceCallsHandler
+	release    *releases.ServiceCallsHandler
+	report     *reports.CallsHandler
+	search     *search.CallsHandler
+	team       *teams.CallsHandler
+	auth       *auth.Authenticator
+}
+
+// SetupRouter builds the HTTP API over the catalog kept in repos, from the configuration in effect. Rate limits and
+// webhook secrets follow reloads of live. probes serve /healthz and /readyz, so the caller can drain them on shutdown.
+func SetupRouter(live *config.Live, repos repositories.Repositories, probes *system.Probes) http.Handler {
 	slog.Debug("Setting up router")
 	router := chi.NewRouter()
+	cfg := live.Current()
+	limits := ratelimit.NewReloadable(cfg.Limits.RateLimit)
+	live.OnReload(func(cfg *config.Config) { limits.Reload(cfg.Limits.RateLimit) })
+
+	authenticator := auth.FromConfig(cfg.Auth, repos.ApiKeys, repos.Services, repos.Debt)
 
+	// requests rejected by the limits or authentication are logged and counted like any other
+	router.Use(middleware.Recoverer)
 	router.Use(internal.RequestIDLogger)
+	router.Use(tracing.Middleware)
 	router.Use(internal.StructuredLoggerFromContext())
-	router.Use(middleware.Recoverer)
+	router.Use(limits.AddressMiddleware)
+	router.Use(authenticator.Authenticate)
+	router.Use(limits.Middleware)
+	router.Use(internal.MaxBodySize(cfg.Limits.MaxBodyBytes))
 	router.Use(middleware.Compress(5))
-	setupSystemCalls(router)
+	router.Use(openapi.Validator(validationMode(cfg.API.Validation)))
+	setupSystemCalls(router, live, authenticator, repos.Reports, probes)
+
+	h := handlers{
+		service:    services.New(repos.Services),
+		debt:       debt.New(repos.Debt),
+		dependency: dependencies.New(repos.Dependencies),
+		release:    releases.New(repos.Releases),
+		report:     reports.New(repos.Reports),
+		search:     search.New(repos.Search),
+		team:       teams.New(repos.Teams),
+		auth:       authenticator,
+	}
+	integrationHandler := integrations.New(repos.Services, repos.Releases, cfg.Webhooks)
+	live.OnReload(func(cfg *config.Config) { integrationHandler.SetWebhooks(cfg.Webhooks) })
+	apiKeyHandler := apikeys.New(repos.ApiKeys)
+
+	if cfg.Features.GraphQL {
+		graphHandler := graph.New(repos)
+		router.With(authenticator.Require(auth.ScopeRead)).Get("/graphql", graphHandler.Query)
+		router.With(authenticator.Require(auth.ScopeRead)).Post("/graphql", graphHandler.Query)
+	}
+
+	// webhooks authenticate with their own signatures
+
+	router.Route("/integrations/releases", func(r chi.Router) {
+		r.Post("/github", integrationHandler.GitHubRelease)
+		r.Post("/ci", integrationHandler.CIRelease)
+	})
 
-	serviceHandler := services.New(driver)
-	debtHandler := debt.New(driver)
-	dependencyHandler := dependencies.New(driver)
-	releaseHandler := releases.New(driver)
-	reportHandler := reports.New(driver)
-	teamHandler := teams.New(driver)
+	router.Route("/admin/keys", func(r chi.Router) {
+		r.Use(authenticator.Require(auth.ScopeAdmin))
+		r.With(internal.MaxBodySize(maxEntityBodySize)).Post("/", apiKeyHandler.CreateApiKey)
+		r.Get("/", apiKeyHandler.GetApiKeys)
+		r.Delete("/{id}", apiKeyHandler.DeleteApiKey)
+	})
+
+	router.Route(versioning.V1.Prefix(), func(r chi.Router) {
+		r.Use(versioning.WithVersion(versioning.V1))
+		r.Get("/openapi.json", openapi.Handler)
+		setupApiCalls(r, h)
+	})
+	router.Route(versioning.V2.Prefix(), func(r chi.Router) {
+		r.Use(versioning.WithVersion(versioning.V2))
+		r.Get("/openapi.json", openapi.Handler)
+		setupApiCalls(r, h)
+	})
+	// the document of the latest version stays at the root, where it is not going away with the unversioned paths
+	router.Get("/openapi.json", openapi.Handler)
+	// the unversioned paths predate /v1 and are kept as a deprecated alias of it
+	router.Group(func(r chi.Router) {
+		r.Use(versioning.Deprecated(versioning.V1, legacyDeprecatedAt, legacySunset(cfg.API.LegacySunset)))
+		setupApiCalls(r, h)
+	})
+	return router
+}
 
-	router.Get("/releases/{startDate}/{endDate}", releaseHandler.GetReleasesInDateRange)
-	router.Get("/reports/services/{id}/risk", reportHandler.GetServiceRiskReport)
-	router.Get("/reports/services/debt", reportHandler.GetServiceDebtReport)
-	router.Patch("/debt/{id}", debtHandler.UpdateDebtStatus)
+// setupApiCalls registers the versioned REST API on r, each route requiring the scope it needs.
+func setupApiCalls(router chi.Router, h handlers) {
+	read := h.auth.Require(auth.ScopeRead)
+	writeServices := h.auth.Require(auth.ScopeWriteServices)
+	// callers signed in through OIDC can only change services and teams they are members of
+	ownService := h.auth.RequireServiceOwner(auth.ScopeWriteServices, "id")
+	ownServiceDebt := h.auth.RequireServiceOwner(auth.ScopeWriteDebt, "id")
+	ownDebt := h.auth.RequireDebtOwner(auth.ScopeWriteDebt, "id")
+	memberOfTeam := h.auth.RequireTeamMember("id")
+	entityBody := internal.MaxBodySize(maxEntityBodySize)
+
+	router.With(read).Get("/search", h.search.Search)
+	router.With(read).Get("/autocomplete", h.search.Autocomplete)
+	router.With(read).Get("/releases/{startDate}/{endDate}", h.release.GetReleasesInDateRange)
+	router.With(read).Get("/reports/services/{id}/risk", h.report.GetServiceRiskReport)
+	router.With(read).Get("/reports/services/debt", h.report.GetServiceDebtReport)
+	router.With(read).Get("/reports/integrity", h.report.GetIntegrityReport)
+	router.With(ownDebt, entityBody).Patch("/debt/{id}", h.debt.UpdateDebtStatus)
 
 	router.Route("/services", func(r chi.Router) {
-		r.Get("/", serviceHandler.GetAllServices)
-		r.Post("/", serviceHandler.CreateService)
-		r.Get("/search", serviceHandler.Search)
+		r.With(read).Get("/", h.service.GetAllServices)
+		r.With(writeServices, entityBody).Post("/", h.service.CreateService)
+		r.With(read).Get("/search", h.service.Search)
 
 		r.Route("/{id}", func(r chi.Router) {
-			r.Get("/", serviceHandler.GetById)
-			r.Put("/", serviceHandler.UpdateService)
-			r.Delete("/", serviceHandler.DeleteServiceById)
-			r.Get("/teams", serviceHandler.GetTeamsByServiceId)
+			r.With(read).Get("/", h.service.GetById)
+			r.With(ownService, entityBody).Put("/", h.service.UpdateService)
+			r.With(ownService).Delete("/", h.service.DeleteServiceById)
+			r.With(read).Get("/teams", h.service.GetTeamsByServiceId)
 
-			r.Get("/dependencies", dependencyHandler.GetDependencies)
-			r.Get("/dependents", dependencyHandler.GetDependents)
-			r.Post("/dependency", dependencyHandler.CreateDependency)
-			r.Delete("/dependency/{id2}", dependencyHandler.DeleteDependency)
+			r.With(read).Get("/dependencies", h.dependency.GetDependencies)
+			r.With(read).Get("/dependents", h.dependency.GetDependents)
+			r.With(ownService, entityBody).Post("/dependency", h.dependency.CreateDependency)
+			r.With(ownService).Delete("/dependency/{id2}", h.dependency.DeleteDependency)
 
 			r.Route("/debt", func(r chi.Router) {
-				r.Post("/", debtHandler.CreateDebt)
-				r.Get("/", debtHandler.GetDebtByServiceId)
+				r.With(ownServiceDebt, entityBody).Post("/", h.debt.CreateDebt)
+				r.With(read).Get("/", h.debt.GetDebtByServiceId)
 			})
 
 			r.Route("/release", func(r chi.Router) {
-				r.Post("/", releaseHandler.CreateRelease)
-				r.Get("/", releaseHandler.GetReleasesByServiceId)
+				r.With(ownService, entityBody).Post("/", h.release.CreateRelease)
+				r.With(read).Get("/", h.release.GetReleasesByServiceId)
 			})
 
 		})
 	})
 
 	router.Route("/teams", func(r chi.Router) {
-		r.Post("/", teamHandler.CreateTeam)
-		r.Get("/", teamHandler.GetTeams)
-		r.Delete("/{id}", teamHandler.DeleteTeam)
-		r.Get("/{id}", teamHandler.GetTeam)
-		r.Put("/{id}", teamHandler.UpdateTeam)
+		r.With(writeServices, entityBody).Post("/", h.team.CreateTeam)
+		r.With(read).Get("/", h.team.GetTeams)
+		r.With(memberOfTeam).Delete("/{id}", h.team.DeleteTeam)
+		r.With(read).Get("/{id}", h.team.GetTeam)
+		r.With(memberOfTeam, entityBody).Put("/{id}", h.team.UpdateTeam)
 		r.Route("/{teamId}/services/{serviceId}", func(r chi.Router) {
-			r.Put("/", teamHandler.CreateTeamAssociation)
-			r.Delete("/", teamHandler.DeleteTeamAssociation)
+			changeOwners := h.auth.RequireOwnershipChange("teamId", "serviceId")
+			r.With(changeOwners).Put("/", h.team.CreateTeamAssociation)
+			r.With(changeOwners).Delete("/", h.team.DeleteTeamAssociation)
 		})
-		r.Get("/{teamId}/services", reportHandler.GetServicesByTeam)
+		r.With(read).Get("/{teamId}/services", h.report.GetServicesByTeam)
 	})
-	return router
 }
 
-func setupSystemCalls(r chi.Router) {
+func setupSystemCalls(r chi.Router, live *config.Live, authenticator *auth.Authenticator, reports repositories.ReportRepository, probes *system.Probes) {
 	slog.Debug("Setting up system calls")
+	r.Get("/healthz", probes.Liveness)
+	r.Get("/readyz", probes.Readiness)
 	r.Get("/time", system.GetTime)
-	r.Get("/database", system.GetDbAddress)
+	cfg := live.Current()
+	if cfg.Features.Metrics {
+		r.Method(http.MethodGet, "/metrics", metrics.Handler(system.NewCatalogCollector(reports)))
+	}
+	r.With(authenticator.Require(auth.ScopeRead)).Get("/database", system.GetDbAddress(cfg.Database.URL))
+	r.With(authenticator.Require(auth.ScopeAdmin)).Get("/config", system.GetConfig(live))
 	r.Get("/helloworld", helloworld.HelloWorld)
 }
+
+// legacySunset parses api.legacySunset, the date the unversioned paths will be removed.
+func legacySunset(value string) time.Time {
+	if value == "" {
+		return defaultLegacySunset
+	}
+	sunset, err := versioning.ParseSunset(value)
+	if err != nil {
+		slog.Warn("Invalid legacy API sunset, using the default", slog.String("value", value),
+			slog.String("default", defaultLegacySunset.Format(time.DateOnly)))
+		return defaultLegacySunset
+	}
+	return sunset
+}
+
+// validationMode parses api.validation, disabling validation if it is not a known mode.
+func validationMode(value string) openapi.Mode {
+	mode, ok := openapi.ParseMode(value)
+	if !ok {
+		slog.Warn("Unknown OpenAPI validation mode, validation disabled", slog.String("value", value))
+	}
+	return mode
+}
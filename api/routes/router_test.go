@@ -0,0 +1,474 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"service-atlas/api/openapi"
+	"service-atlas/api/system"
+	"service-atlas/internal/config"
+	"service-atlas/internal/metrics"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/versioning"
+	"service-atlas/memoryrepositories"
+	"service-atlas/repositories"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// testConfig is the default configuration, which is what the documented routes are served with.
+func testConfig() *config.Config {
+	cfg := config.Default()
+	cfg.Database.URL = "neo4j://localhost:7687"
+	return &cfg
+}
+
+// TestRoutesMatchOpenAPI fails when a route is registered without being documented in api/openapi/openapi.json,
+// or documented without being registered. Versioned paths are documented relative to their version prefix,
+// and are also served unversioned as the deprecated alias of the latest version.
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	router, ok := SetupRouter(config.NewLive(testConfig()), memoryrepositories.New().Repositories(), system.NewProbes()).(chi.Routes)
+	if !ok {
+		t.Fatal("router does not expose its routes")
+	}
+	registered := make([]openapi.Route, 0)
+	err := chi.Walk(router, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path := strings.TrimSuffix(strings.ReplaceAll(route, "/*", ""), "/")
+		if path == "" {
+			path = "/"
+		}
+		registered = append(registered, openapi.Route{Method: method, Path: path})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registered) == 0 {
+		t.Fatal("no routes registered")
+	}
+
+	documented := make([]openapi.Route, 0)
+	for _, version := range versioning.Versions {
+		for _, r := range openapi.SpecFor(version).Routes() {
+			switch {
+			case r.Unversioned && version == versioning.Latest:
+				documented = append(documented, openapi.Route{Method: r.Method, Path: r.Path})
+			case r.Unversioned:
+				t.Errorf("%s %s is unversioned but documented for %s", r.Method, r.Path, version)
+			default:
+				documented = append(documented, openapi.Route{Method: r.Method, Path: version.Prefix() + r.Path})
+				if version == versioning.Latest {
+					documented = append(documented, openapi.Route{Method: r.Method, Path: r.Path})
+				}
+			}
+		}
+	}
+
+	for _, r := range registered {
+		if !slices.Contains(documented, r) {
+			t.Errorf("%s %s is registered but not documented", r.Method, r.Path)
+		}
+	}
+	for _, r := range documented {
+		if !slices.Contains(registered, r) {
+			t.Errorf("%s %s is documented but not registered", r.Method, r.Path)
+		}
+	}
+}
+
+func TestVersionedPaths(t *testing.T) {
+	router := SetupRouter(config.NewLive(testConfig()), memoryrepositories.New().Repositories(), system.NewProbes())
+	tests := []struct {
+		name       string
+		target     string
+		server     string
+		deprecated bool
+	}{
+		{"V1", "/v1/openapi.json", "/v1", false},
+		{"V2", "/v2/openapi.json", "/v2", false},
+		{"Root", "/openapi.json", "/v1", false},
+		{"Legacy", "/services", "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, tc.target, nil))
+			if rw.Code != http.StatusOK {
+				t.Fatalf("expected %d, got %d", http.StatusOK, rw.Code)
+			}
+			if tc.deprecated {
+				if rw.Header().Get("Deprecation") == "" || rw.Header().Get("Sunset") == "" {
+					t.Errorf("expected Deprecation and Sunset headers, got %v", rw.Header())
+				}
+				if want := `</v1/services>; rel="successor-version"`; rw.Header().Get("Link") != want {
+					t.Errorf("expected Link %q, got %q", want, rw.Header().Get("Link"))
+				}
+				return
+			}
+			var doc openapi.Document
+			if err := json.Unmarshal(rw.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("invalid document: %v", err)
+			}
+			if len(doc.Servers) == 0 || doc.Servers[0].URL != tc.server {
+				t.Errorf("expected the document for %s, got servers %v", tc.server, doc.Servers)
+			}
+			if rw.Header().Get("Deprecation") != "" {
+				t.Errorf("unexpected Deprecation header %q", rw.Header().Get("Deprecation"))
+			}
+		})
+	}
+}
+
+func TestUnversionedPathsAreNotDeprecated(t *testing.T) {
+	rw := httptest.NewRecorder()
+	SetupRouter(config.NewLive(testConfig()), memoryrepositories.New().Repositories(), system.NewProbes()).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/helloworld", nil))
+	if rw.Header().Get("Deprecation") != "" {
+		t.Errorf("unexpected Deprecation header %q", rw.Header().Get("Deprecation"))
+	}
+}
+
+func TestLegacySunset(t *testing.T) {
+	if got := legacySunset("2027-01-31").Format("2006-01-02"); got != "2027-01-31" {
+		t.Errorf("expected configured sunset, got %s", got)
+	}
+	if got := legacySunset(""); !got.Equal(defaultLegacySunset) {
+		t.Errorf("expected default sunset, got %s", got)
+	}
+}
+
+func TestRoutesRequireScopes(t *testing.T) {
+	cfg := testConfig()
+	cfg.Auth = config.Auth{Required: true, BootstrapKey: "sa_bootstrap"}
+	router := SetupRouter(config.NewLive(cfg), memoryrepositories.New().Repositories(), system.NewProbes())
+	tests := []struct {
+		name   string
+		method string
+		target string
+		key    string
+		status int
+	}{
+		{"PublicSystemCall", http.MethodGet, "/helloworld", "", http.StatusOK},
+		{"PublicLiveness", http.MethodGet, "/healthz", "", http.StatusOK},
+		{"PublicDocument", http.MethodGet, "/v1/openapi.json", "", http.StatusOK},
+		{"ReadRequiresKey", http.MethodGet, "/v1/services?page=1", "", http.StatusUnauthorized},
+		{"WriteRequiresKey", http.MethodPost, "/services", "", http.StatusUnauthorized},
+		{"AdminRequiresKey", http.MethodGet, "/admin/keys", "", http.StatusUnauthorized},
+		{"ConfigRequiresKey", http.MethodGet, "/config", "", http.StatusUnauthorized},
+		{"ConfigForAdmins", http.MethodGet, "/config", "sa_bootstrap", http.StatusOK},
+		// the bootstrap key passes authorization, then the handler rejects the invalid id before using the database
+		{"BootstrapIsAdmin", http.MethodDelete, "/admin/keys/nope", "sa_bootstrap", http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			if tc.key != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.key)
+			}
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected %d, got %d", tc.status, rw.Code)
+			}
+		})
+	}
+}
+
+func TestAdminRequiresKeyWhenAuthIsOptional(t *testing.T) {
+	cfg := testConfig()
+	cfg.Auth = config.Auth{BootstrapKey: "sa_bootstrap"}
+	router := SetupRouter(config.NewLive(cfg), memoryrepositories.New().Repositories(), system.NewProbes())
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		key    string
+		status int
+	}{
+		{"AnonymousRead", http.MethodGet, "/v1/services", "", "", http.StatusOK},
+		{"AnonymousListKeys", http.MethodGet, "/admin/keys", "", "", http.StatusUnauthorized},
+		{"AnonymousCreateKey", http.MethodPost, "/admin/keys", `{"name":"mine","scopes":["admin"]}`, "", http.StatusUnauthorized},
+		{"AnonymousConfig", http.MethodGet, "/config", "", "", http.StatusUnauthorized},
+		{"AdminConfig", http.MethodGet, "/config", "", "sa_bootstrap", http.StatusOK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.key != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.key)
+			}
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, rw.Code, rw.Body.String())
+			}
+		})
+	}
+}
+
+// signedToken returns a token for a single sign-on user in groups, and the configuration accepting it.
+func signedToken(t *testing.T, groups ...string) (string, config.OIDC) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{{
+		"kid": "test", "kty": "RSA", "use": "sig",
+		"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(path, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": "https://login.example.com", "sub": "ada", "exp": time.Now().Add(time.Hour).Unix(), "groups": groups,
+	})
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed, config.OIDC{Issuer: "https://login.example.com", JWKSFile: path}
+}
+
+func TestDebtStatusRequiresServiceOwner(t *testing.T) {
+	ctx := context.Background()
+	store := memoryrepositories.New()
+	cart, err := store.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "api", Url: "https://cart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shop, _ := store.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	finance, _ := store.CreateTeam(ctx, repositories.Team{Name: "finance"})
+	if err = store.CreateTeamAssociation(ctx, shop, cart); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.CreateDebtItem(ctx, repositories.Debt{ServiceId: cart, Type: "code", Title: "flaky tests"}); err != nil {
+		t.Fatal(err)
+	}
+	debt, err := store.GetDebtByServiceId(ctx, cart, pagination.First(1), false)
+	if err != nil || len(debt.Items) != 1 {
+		t.Fatalf("expected the debt item, got %+v: %v", debt, err)
+	}
+
+	tests := []struct {
+		name   string
+		team   string
+		status int
+	}{
+		{"NotOwner", finance, http.StatusForbidden},
+		{"Owner", shop, http.StatusNoContent},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			token, oidc := signedToken(t, tc.team)
+			cfg := testConfig()
+			cfg.Auth = config.Auth{Required: true, OIDC: oidc}
+			router := SetupRouter(config.NewLive(cfg), store.Repositories(), system.NewProbes())
+
+			req := httptest.NewRequest(http.MethodPatch, "/v1/debt/"+debt.Items[0].Id, strings.NewReader(`{"status":"remediated"}`))
+			req.Header.Set("Authorization", "Bearer "+token)
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, rw.Code, rw.Body.String())
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	cfg := testConfig()
+	cfg.Limits.RateLimit = config.RateLimit{RPS: 1, Burst: 1}
+	live := config.NewLive(cfg)
+	router := SetupRouter(live, memoryrepositories.New().Repositories(), system.NewProbes())
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/helloworld", nil))
+	if rw.Code != http.StatusOK || rw.Header().Get("RateLimit-Remaining") != "0" {
+		t.Fatalf("expected first request to pass, got %d remaining %q", rw.Code, rw.Header().Get("RateLimit-Remaining"))
+	}
+	rw = httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/helloworld", nil))
+	if rw.Code != http.StatusTooManyRequests || rw.Header().Get("Retry-After") == "" {
+		t.Errorf("expected 429 with Retry-After, got %d %v", rw.Code, rw.Header())
+	}
+
+	reloaded := *cfg
+	reloaded.Limits.RateLimit = config.RateLimit{}
+	live.Reload(&reloaded)
+	rw = httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/helloworld", nil))
+	if rw.Code != http.StatusOK {
+		t.Errorf("expected the reloaded configuration to lift the limit, got %d", rw.Code)
+	}
+}
+
+func TestListPageSizesByVersion(t *testing.T) {
+	ctx := context.Background()
+	store := memoryrepositories.New()
+	cart, err := store.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "api", Url: "https://cart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range 30 {
+		name := "service" + strconv.Itoa(i)
+		id, err := store.CreateService(ctx, repositories.Service{Name: name, ServiceType: "api", Url: "https://" + name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = store.AddDependency(ctx, id, repositories.Dependency{Id: cart}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = store.CreateTeam(ctx, repositories.Team{Name: "team" + strconv.Itoa(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	router := SetupRouter(config.NewLive(testConfig()), store.Repositories(), system.NewProbes())
+
+	tests := []struct {
+		path  string
+		items int
+	}{
+		{"/v1/teams", 10},
+		{"/teams", 10},
+		{"/v2/teams", pagination.DefaultLimit},
+		{"/v1/services/" + cart + "/dependents", 30},
+		{"/v2/services/" + cart + "/dependents", pagination.DefaultLimit},
+		{"/v1/services/" + cart + "/dependents?limit=5", 5},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			if rw.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d: %s", rw.Code, rw.Body.String())
+			}
+			var items []json.RawMessage
+			var err error
+			if strings.HasPrefix(tc.path, "/v2") {
+				var result pagination.Result[json.RawMessage]
+				err = json.Unmarshal(rw.Body.Bytes(), &result)
+				items = result.Items
+			} else {
+				err = json.Unmarshal(rw.Body.Bytes(), &items)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != tc.items {
+				t.Errorf("expected %d items, got %d", tc.items, len(items))
+			}
+		})
+	}
+}
+
+func TestBadKeysAreRateLimited(t *testing.T) {
+	cfg := testConfig()
+	cfg.Auth = config.Auth{Required: true}
+	cfg.Limits.RateLimit = config.RateLimit{AddressRPS: 1, AddressBurst: 1}
+	router := SetupRouter(config.NewLive(cfg), memoryrepositories.New().Repositories(), system.NewProbes())
+
+	for i, want := range []int{http.StatusUnauthorized, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/services", nil)
+		req.Header.Set("Authorization", "Bearer sa_guess")
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, req)
+		if rw.Code != want {
+			t.Errorf("request %d: expected %d, got %d", i, want, rw.Code)
+		}
+	}
+}
+
+func TestRejectedRequestsAreCounted(t *testing.T) {
+	cfg := testConfig()
+	cfg.Auth = config.Auth{Required: true}
+	cfg.Limits.RateLimit = config.RateLimit{AddressRPS: 1, AddressBurst: 1}
+	router := SetupRouter(config.NewLive(cfg), memoryrepositories.New().Repositories(), system.NewProbes())
+	before := map[int]float64{http.StatusUnauthorized: requestsCounted(t, http.StatusUnauthorized),
+		http.StatusTooManyRequests: requestsCounted(t, http.StatusTooManyRequests)}
+
+	for _, status := range []int{http.StatusUnauthorized, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/services", nil)
+		req.Header.Set("Authorization", "Bearer sa_guess")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		if got := requestsCounted(t, status); got != before[status]+1 {
+			t.Errorf("expected one more %d counted, got %v after %v", status, got, before[status])
+		}
+	}
+}
+
+// requestsCounted scrapes the GET requests answered with status that no route was matched for, as requests rejected
+// before routing are counted.
+func requestsCounted(t *testing.T, status int) float64 {
+	t.Helper()
+	rw := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	prefix := `service_atlas_http_requests_total{method="GET",route="` + metrics.UnmatchedRoute + `",status="` + strconv.Itoa(status) + `"} `
+	for _, line := range strings.Split(rw.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, prefix); ok {
+			count, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return count
+		}
+	}
+	return 0
+}
+
+func TestEntityBodiesAreLimited(t *testing.T) {
+	body := `{"name":"` + strings.Repeat("x", int(maxEntityBodySize)) + `"}`
+	rw := httptest.NewRecorder()
+	SetupRouter(config.NewLive(testConfig()), memoryrepositories.New().Repositories(), system.NewProbes()).ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/v1/services", strings.NewReader(body)))
+	if rw.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413, got %d", rw.Code)
+	}
+}
+
+func TestFeatureToggles(t *testing.T) {
+	cfg := testConfig()
+	cfg.Features.GraphQL = false
+	cfg.Features.Metrics = false
+	router := SetupRouter(config.NewLive(cfg), memoryrepositories.New().Repositories(), system.NewProbes())
+	for _, target := range []string{"/graphql", "/metrics"} {
+		rw := httptest.NewRecorder()
+		router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, target, nil))
+		if rw.Code != http.StatusNotFound {
+			t.Errorf("expected %s to be disabled, got %d", target, rw.Code)
+		}
+	}
+}
+
+func TestMemoryStorage(t *testing.T) {
+	router := SetupRouter(config.NewLive(testConfig()), memoryrepositories.New().Repositories(), system.NewProbes())
+	body := `{"name":"cart","type":"api","url":"https://github.com/shop/cart"}`
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/v1/services", strings.NewReader(body)))
+	if rw.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rw.Code, rw.Body)
+	}
+	rw = httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/v1/services?page=1&pageSize=10", nil))
+	if rw.Code != http.StatusOK || !strings.Contains(rw.Body.String(), `"name":"cart"`) {
+		t.Errorf("expected the created service, got %d: %s", rw.Code, rw.Body)
+	}
+}
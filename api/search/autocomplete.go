@@ -0,0 +1,78 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultSuggestions and maxSuggestions bound the suggestions an autocomplete request returns.
+	defaultSuggestions = 10
+	maxSuggestions     = 20
+	// autocompleteTimeout is the budget for finding suggestions, which are fetched on every keystroke, so a slow
+	// answer is better failed than waited for.
+	autocompleteTimeout = 500 * time.Millisecond
+)
+
+// autocompleteResponse is the body of an autocomplete response.
+type autocompleteResponse struct {
+	Items []repositories.Suggestion `json:"items"`
+}
+
+// Autocomplete suggests the services and teams whose name or id completes the q parameter, narrowed to the comma
+// separated kinds when given, for pickers to call as the user types.
+func (c *CallsHandler) Autocomplete(rw http.ResponseWriter, r *http.Request) {
+	query := repositories.AutocompleteQuery{
+		Prefix: strings.TrimSpace(r.URL.Query().Get("q")),
+		Limit:  defaultSuggestions,
+	}
+	if query.Prefix == "" {
+		http.Error(rw, "q parameter is required", http.StatusBadRequest)
+		return
+	}
+	if kinds := r.URL.Query().Get("kinds"); kinds != "" {
+		for _, kind := range strings.Split(kinds, ",") {
+			kind := repositories.SearchKind(strings.ToLower(strings.TrimSpace(kind)))
+			if !slices.Contains(repositories.AutocompleteKinds, kind) {
+				http.Error(rw, "kinds must be a comma separated list of service and team", http.StatusBadRequest)
+				return
+			}
+			query.Kinds = append(query.Kinds, kind)
+		}
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 || parsed > maxSuggestions {
+			http.Error(rw, "limit must be between 1 and "+strconv.Itoa(maxSuggestions), http.StatusBadRequest)
+			return
+		}
+		query.Limit = parsed
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), autocompleteTimeout)
+	defer cancel()
+	suggestions, err := c.repository.Autocomplete(ctxWithTimeout, query)
+	if errors.Is(err, context.DeadlineExceeded) {
+		http.Error(rw, "suggestions took too long to find", http.StatusGatewayTimeout)
+		return
+	}
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(autocompleteResponse{Items: suggestions}); err != nil {
+		internal.LoggerFromContext(r.Context()).Debug("Error encoding suggestions",
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,103 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/repositories"
+	"slices"
+	"testing"
+)
+
+func TestAutocompleteSuccess(t *testing.T) {
+	var query repositories.AutocompleteQuery
+	handler := CallsHandler{
+		repository: mockSearchRepository{
+			Suggestions: []repositories.Suggestion{
+				{Kind: repositories.KindService, Id: "1", Name: "orders", Type: "api"},
+				{Kind: repositories.KindTeam, Id: "2", Name: "Order Fulfilment"},
+			},
+			AutocompleteQuery: &query,
+		},
+	}
+	rw := httptest.NewRecorder()
+
+	handler.Autocomplete(rw, httptest.NewRequest(http.MethodGet, "/autocomplete?q=+ord&kinds=Service,team", nil))
+
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rw.Code, rw.Body.String())
+	}
+	if query.Prefix != "ord" || query.Limit != defaultSuggestions ||
+		!slices.Equal(query.Kinds, []repositories.SearchKind{repositories.KindService, repositories.KindTeam}) {
+		t.Errorf("Unexpected query %+v", query)
+	}
+	var body struct {
+		Items []map[string]any `json:"items"`
+	}
+	if err := json.Unmarshal(rw.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Items) != 2 || body.Items[0]["type"] != "api" || body.Items[1]["kind"] != "team" {
+		t.Fatalf("Unexpected body %s", rw.Body.String())
+	}
+	if _, ok := body.Items[1]["type"]; ok {
+		t.Errorf("Expected no type for a team, got %s", rw.Body.String())
+	}
+}
+
+func TestAutocompleteLimit(t *testing.T) {
+	var query repositories.AutocompleteQuery
+	handler := CallsHandler{repository: mockSearchRepository{AutocompleteQuery: &query}}
+	rw := httptest.NewRecorder()
+
+	handler.Autocomplete(rw, httptest.NewRequest(http.MethodGet, "/autocomplete?q=ord&limit=5", nil))
+
+	if rw.Code != http.StatusOK || query.Limit != 5 || query.Kinds != nil {
+		t.Errorf("Expected a limit of 5 over every kind, got %d and %+v", rw.Code, query)
+	}
+	if body := rw.Body.String(); body != "{\"items\":[]}\n" {
+		t.Errorf("Expected no items, got %q", body)
+	}
+}
+
+func TestAutocompleteBadRequest(t *testing.T) {
+	handler := CallsHandler{repository: mockSearchRepository{}}
+	for _, target := range []string{
+		"/autocomplete",
+		"/autocomplete?q=+",
+		"/autocomplete?q=ord&kinds=service,debt",
+		"/autocomplete?q=ord&limit=0",
+		"/autocomplete?q=ord&limit=21",
+	} {
+		rw := httptest.NewRecorder()
+		handler.Autocomplete(rw, httptest.NewRequest(http.MethodGet, target, nil))
+		if rw.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", target, http.StatusBadRequest, rw.Code)
+		}
+	}
+}
+
+func TestAutocompleteRepositoryError(t *testing.T) {
+	handler := CallsHandler{repository: mockSearchRepository{Err: errors.New("index offline")}}
+	rw := httptest.NewRecorder()
+
+	handler.Autocomplete(rw, httptest.NewRequest(http.MethodGet, "/autocomplete?q=ord", nil))
+
+	if rw.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status code %d, got %d", http.StatusInternalServerError, rw.Code)
+	}
+}
+
+func TestAutocompleteTimeout(t *testing.T) {
+	handler := CallsHandler{repository: mockSearchRepository{Err: fmt.Errorf("querying names: %w", context.DeadlineExceeded)}}
+	rw := httptest.NewRecorder()
+
+	handler.Autocomplete(rw, httptest.NewRequest(http.MethodGet, "/autocomplete?q=ord", nil))
+
+	if rw.Code != http.StatusGatewayTimeout {
+		t.Errorf("Expected status code %d, got %d", http.StatusGatewayTimeout, rw.Code)
+	}
+}
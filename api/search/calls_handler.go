@@ -0,0 +1,13 @@
+package search
+
+import "service-atlas/repositories"
+
+type CallsHandler struct {
+	repository repositories.SearchRepository
+}
+
+func New(repository repositories.SearchRepository) *CallsHandler {
+	return &CallsHandler{
+		repository: repository,
+	}
+}
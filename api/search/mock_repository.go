@@ -0,0 +1,41 @@
+package search
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+)
+
+// mockSearchRepository returns Hits for every search and Suggestions for every autocomplete, recording the query it
+// was given.
+type mockSearchRepository struct {
+	Err               error
+	Hits              []repositories.SearchHit
+	Query             *repositories.SearchQuery
+	Suggestions       []repositories.Suggestion
+	AutocompleteQuery *repositories.AutocompleteQuery
+}
+
+func (repo mockSearchRepository) SearchCatalog(_ context.Context, query repositories.SearchQuery, page pagination.Page) (repositories.SearchResults, error) {
+	if repo.Err != nil {
+		return repositories.SearchResults{}, repo.Err
+	}
+	if repo.Query != nil {
+		*repo.Query = query
+	}
+	facets := repositories.NewSearchFacets()
+	for _, hit := range repo.Hits {
+		facets.Count(hit, 1)
+	}
+	return repositories.SearchResults{Result: pagination.Slice(repo.Hits, page), Facets: facets}, nil
+}
+
+func (repo mockSearchRepository) Autocomplete(_ context.Context, query repositories.AutocompleteQuery) ([]repositories.Suggestion, error) {
+	if repo.Err != nil {
+		return nil, repo.Err
+	}
+	if repo.AutocompleteQuery != nil {
+		*repo.AutocompleteQuery = query
+	}
+	return append([]repositories.Suggestion{}, repo.Suggestions[:min(len(repo.Suggestions), query.Limit)]...), nil
+}
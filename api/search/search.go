@@ -0,0 +1,57 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Search finds services, teams, debt and releases matching the query parameter, narrowed to the comma separated
+// kinds and the serviceType when given.
+func (c *CallsHandler) Search(rw http.ResponseWriter, r *http.Request) {
+	query := repositories.SearchQuery{
+		Text:        strings.TrimSpace(r.URL.Query().Get("query")),
+		ServiceType: strings.TrimSpace(r.URL.Query().Get("serviceType")),
+	}
+	if query.Text == "" {
+		http.Error(rw, "query parameter is required", http.StatusBadRequest)
+		return
+	}
+	if kinds := r.URL.Query().Get("kinds"); kinds != "" {
+		for _, kind := range strings.Split(kinds, ",") {
+			kind := repositories.SearchKind(strings.ToLower(strings.TrimSpace(kind)))
+			if !slices.Contains(repositories.SearchKinds, kind) {
+				http.Error(rw, "kinds must be a comma separated list of service, team, debt and release", http.StatusBadRequest)
+				return
+			}
+			query.Kinds = append(query.Kinds, kind)
+		}
+	}
+	page, err := pagination.FromRequest(r, pagination.DefaultLimit)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+
+	ctxWithTimeout, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+	results, err := c.repository.SearchCatalog(ctxWithTimeout, query, page)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
+	}
+	pagination.SetNextLink(rw, r, results.NextCursor)
+	rw.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(rw).Encode(results); err != nil {
+		internal.LoggerFromContext(r.Context()).Debug("Error encoding search results",
+			slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearchSuccess(t *testing.T) {
+	var query repositories.SearchQuery
+	handler := CallsHandler{
+		repository: mockSearchRepository{
+			Hits: []repositories.SearchHit{
+				{Kind: repositories.KindService, Id: "1", Title: "kafka-proxy", ServiceId: "1", ServiceType: "api", Score: 2},
+				{Kind: repositories.KindDebt, Id: "2", Title: "Upgrade kafka", ServiceId: "1", ServiceType: "api", Score: 1},
+			},
+			Query: &query,
+		},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/search?query=kafka&kinds=Service,debt&serviceType=api&limit=1", nil)
+	rw := httptest.NewRecorder()
+
+	handler.Search(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rw.Code, rw.Body.String())
+	}
+	if query.Text != "kafka" || query.ServiceType != "api" ||
+		!slices.Equal(query.Kinds, []repositories.SearchKind{repositories.KindService, repositories.KindDebt}) {
+		t.Errorf("Unexpected query %+v", query)
+	}
+	if link := rw.Header().Get("Link"); !strings.Contains(link, "cursor="+pagination.EncodeCursor(1)) {
+		t.Errorf("Expected a Link header to the next page, got %q", link)
+	}
+
+	var results repositories.SearchResults
+	if err := json.NewDecoder(rw.Body).Decode(&results); err != nil {
+		t.Fatalf("Failed to decode response body: %v", err)
+	}
+	if len(results.Items) != 1 || results.Items[0].Title != "kafka-proxy" || results.NextCursor == "" {
+		t.Errorf("Expected the first hit with a cursor, got %+v", results.Result)
+	}
+	if results.Facets.Kinds[repositories.KindDebt] != 1 || results.Facets.ServiceTypes["api"] != 2 {
+		t.Errorf("Unexpected facets %+v", results.Facets)
+	}
+}
+
+func TestSearchBadRequest(t *testing.T) {
+	handler := CallsHandler{repository: mockSearchRepository{}}
+	for _, target := range []string{
+		"/search",
+		"/search?query=+",
+		"/search?query=kafka&kinds=service,pipeline",
+		"/search?query=kafka&limit=0",
+	} {
+		rw := httptest.NewRecorder()
+		handler.Search(rw, httptest.NewRequest(http.MethodGet, target, nil))
+		if rw.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", target, http.StatusBadRequest, rw.Code)
+		}
+	}
+}
+
+func TestSearchRepositoryError(t *testing.T) {
+	handler := CallsHandler{repository: mockSearchRepository{Err: errors.New("index offline")}}
+	rw := httptest.NewRecorder()
+
+	handler.Search(rw, httptest.NewRequest(http.MethodGet, "/search?query=kafka", nil))
+
+	if rw.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status code %d, got %d", http.StatusInternalServerError, rw.Code)
+	}
+}
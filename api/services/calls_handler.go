@@ -1,18 +1,13 @@
 package services
 
-import (
-	"service-atlas/neo4jrepositories/servicerepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type ServiceCallsHandler struct {
 	Repository repositories.ServiceRepository
 }
 
-func New(driver neo4j.DriverWithContext) *ServiceCallsHandler {
+func New(repository repositories.ServiceRepository) *ServiceCallsHandler {
 	return &ServiceCallsHandler{
-		Repository: servicerepository.New(driver),
+		Repository: repository,
 	}
 }
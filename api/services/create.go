@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
 	"service-atlas/repositories"
 	"time"
 )
@@ -15,8 +16,8 @@ func (u *ServiceCallsHandler) CreateService(rw http.ResponseWriter, r *http.Requ
 	createServiceRequest := &repositories.Service{}
 	err := json.NewDecoder(r.Body).Decode(createServiceRequest)
 	if err != nil {
-		// return HTTP 400 bad request
-		rw.WriteHeader(http.StatusBadRequest)
+		// return HTTP 400 bad request, or 413 if the body was too large
+		rw.WriteHeader(internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	err = createServiceRequest.Validate()
@@ -31,7 +32,7 @@ func (u *ServiceCallsHandler) CreateService(rw http.ResponseWriter, r *http.Requ
 	if err != nil {
 		logger.Error("Error creating service:",
 			slog.String("error", err.Error()))
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		customerrors.HandleError(rw, err)
 		return
 	}
 
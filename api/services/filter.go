@@ -0,0 +1,82 @@
+package services
+
+import (
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// filterFromRequest reads the filter and sort of a service listing from its query parameters.
+func filterFromRequest(r *http.Request) (repositories.ServiceFilter, error) {
+	query := r.URL.Query()
+	filter := repositories.ServiceFilter{
+		Type:      strings.TrimSpace(query.Get("type")),
+		Team:      strings.TrimSpace(query.Get("team")),
+		Lifecycle: strings.ToLower(strings.TrimSpace(query.Get("lifecycle"))),
+	}
+	if filter.Lifecycle != "" && !internal.ServiceLifecycles.IsMember(filter.Lifecycle) {
+		return filter, badFilter("lifecycle must be one of " + strings.Join(internal.ServiceLifecycles.Members(), ", "))
+	}
+
+	if tags := query.Get("tags"); tags != "" {
+		var err error
+		if filter.Tags, err = repositories.NormalizeTags(strings.Split(tags, ",")); err != nil {
+			return filter, badFilter("tags must be a comma separated list of tags")
+		}
+	}
+
+	if value := query.Get("hasOpenDebt"); value != "" {
+		hasOpenDebt, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, badFilter("hasOpenDebt must be true or false")
+		}
+		filter.HasOpenDebt = &hasOpenDebt
+	}
+
+	bounds := []struct {
+		name  string
+		value *time.Time
+	}{
+		{"createdAfter", &filter.CreatedAfter},
+		{"createdBefore", &filter.CreatedBefore},
+		{"updatedAfter", &filter.UpdatedAfter},
+		{"updatedBefore", &filter.UpdatedBefore},
+	}
+	for _, bound := range bounds {
+		value := query.Get(bound.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := parseTime(value)
+		if err != nil {
+			return filter, badFilter(bound.name + " must be a date (YYYY-MM-DD) or an RFC 3339 date-time")
+		}
+		*bound.value = parsed
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		filter.Descending = strings.HasPrefix(sort, "-")
+		filter.Sort = repositories.ServiceSort(strings.TrimPrefix(sort, "-"))
+		if !slices.Contains(repositories.ServiceSorts, filter.Sort) {
+			return filter, badFilter("sort must be one of name, created, updated, dependents or debt, prefixed with - to reverse")
+		}
+	}
+	return filter, nil
+}
+
+// parseTime reads an RFC 3339 date-time, or a date taken as midnight UTC.
+func parseTime(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func badFilter(msg string) error {
+	return &customerrors.HTTPError{Status: http.StatusBadRequest, Msg: msg}
+}
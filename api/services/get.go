@@ -7,44 +7,30 @@ import (
 	"net/http"
 	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
-	"strconv"
+	"service-atlas/internal/pagination"
 	"time"
 )
 
+// v1PageSize is the size of v1 service pages when the request gives none, as before the list was paged by cursor.
+const v1PageSize = 10
+
 func (u *ServiceCallsHandler) GetAllServices(rw http.ResponseWriter, r *http.Request) {
-	logger := internal.LoggerFromContext(r.Context())
-	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	page, err := pagination.FromRequest(r, v1PageSize)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	// Validate that page is positive
-	if page < 1 {
-		http.Error(rw, "page must be positive", http.StatusBadRequest)
+		customerrors.HandleError(rw, err)
 		return
 	}
-	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	filter, err := filterFromRequest(r)
 	if err != nil {
-		pageSize = 10
-	}
-
-	// Validate that pageSize is between 1 and 100
-	if pageSize < 1 || pageSize > 100 {
-		http.Error(rw, "pageSize must be between 1 and 100", http.StatusBadRequest)
+		customerrors.HandleError(rw, err)
 		return
 	}
-	services, err := u.Repository.GetAllServices(r.Context(), page, pageSize)
+	services, err := u.Repository.GetAllServices(r.Context(), filter, page)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(services)
-	if err != nil {
-		logger.Debug("Error encoding services json",
-			slog.String("error", err.Error()))
-	}
+	pagination.Write(rw, r, services)
 }
 
 func (u *ServiceCallsHandler) GetById(rw http.ResponseWriter, r *http.Request) {
@@ -82,17 +68,17 @@ func (u *ServiceCallsHandler) GetTeamsByServiceId(rw http.ResponseWriter, req *h
 		http.Error(rw, "Invalid service ID", http.StatusBadRequest)
 		return
 	}
-	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
-	defer cancel()
-	teams, err := u.Repository.GetTeamsByServiceId(ctxWithTimeout, serviceId)
+	page, err := pagination.FromRequest(req, pagination.Unlimited)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(teams)
+	ctxWithTimeout, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+	defer cancel()
+	teams, err := u.Repository.GetTeamsByServiceId(ctxWithTimeout, serviceId, page)
 	if err != nil {
-		internal.LoggerFromContext(req.Context()).Debug("Error encoding teams json",
-			slog.String("error", err.Error()))
+		customerrors.HandleError(rw, err)
+		return
 	}
+	pagination.Write(rw, req, teams)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"net/http"
 	"net/http/httptest"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 	"strconv"
 	"strings"
@@ -59,6 +60,70 @@ func TestGetAllSuccess(t *testing.T) {
 	}
 }
 
+func TestGetAllWithFilters(t *testing.T) {
+	var filter repositories.ServiceFilter
+	handler := ServiceCallsHandler{
+		Repository: mockServiceRepository{
+			Data:   func() []map[string]any { return nil },
+			Filter: &filter,
+		},
+	}
+	req, err := http.NewRequest("GET", "/services?type=api&team=payments&lifecycle=Active&tags=Kafka,pci&hasOpenDebt=false"+
+		"&createdAfter=2025-01-01&updatedBefore=2025-06-01T12:00:00Z&sort=-dependents", nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	rw := httptest.NewRecorder()
+	handler.GetAllServices(rw, req)
+
+	if rw.Code != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d: %s", http.StatusOK, rw.Code, rw.Body.String())
+	}
+	if filter.Type != "api" || filter.Team != "payments" || filter.Lifecycle != "active" {
+		t.Errorf("Unexpected type, team or lifecycle in %+v", filter)
+	}
+	if len(filter.Tags) != 2 || filter.Tags[0] != "kafka" || filter.Tags[1] != "pci" {
+		t.Errorf("Expected normalized tags, got %v", filter.Tags)
+	}
+	if filter.HasOpenDebt == nil || *filter.HasOpenDebt {
+		t.Errorf("Expected hasOpenDebt to be false, got %v", filter.HasOpenDebt)
+	}
+	if !filter.CreatedAfter.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) || !filter.UpdatedBefore.Equal(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected date bounds in %+v", filter)
+	}
+	if filter.Sort != repositories.SortByDependents || !filter.Descending {
+		t.Errorf("Expected descending dependents sort, got %q, %v", filter.Sort, filter.Descending)
+	}
+}
+
+func TestGetAllWithInvalidFilters(t *testing.T) {
+	handler := ServiceCallsHandler{
+		Repository: mockServiceRepository{Data: func() []map[string]any { return nil }},
+	}
+	for _, query := range []string{
+		"lifecycle=zombie",
+		"tags=kafka,,pci",
+		"hasOpenDebt=maybe",
+		"createdAfter=yesterday",
+		"updatedBefore=2025-13-01",
+		"sort=popularity",
+		"sort=-",
+	} {
+		req, err := http.NewRequest("GET", "/services?"+query, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+
+		rw := httptest.NewRecorder()
+		handler.GetAllServices(rw, req)
+
+		if rw.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", query, http.StatusBadRequest, rw.Code)
+		}
+	}
+}
+
 func TestGetAllBadRequest(t *testing.T) {
 	handler := ServiceCallsHandler{
 		Repository: mockServiceRepository{
@@ -69,8 +134,8 @@ func TestGetAllBadRequest(t *testing.T) {
 		},
 	}
 
-	// Missing page parameter should result in 400 Bad Request
-	req, err := http.NewRequest("GET", "/services", nil)
+	// Giving both a cursor and a page should result in 400 Bad Request
+	req, err := http.NewRequest("GET", "/services?page=2&cursor="+pagination.EncodeCursor(10), nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
 	}
@@ -230,7 +295,7 @@ func TestGetAllWithNonNumericValues(t *testing.T) {
 		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, rw.Code)
 	}
 
-	// Non-numeric pageSize value should result in default pageSize (10)
+	// Non-numeric pageSize value should result in 400 Bad Request
 	req, err = http.NewRequest("GET", "/services?page=1&pageSize=abc", nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
@@ -239,9 +304,8 @@ func TestGetAllWithNonNumericValues(t *testing.T) {
 	rw = httptest.NewRecorder()
 	handler.GetAllServices(rw, req)
 
-	// This should succeed with default pageSize
-	if rw.Code != http.StatusOK {
-		t.Errorf("Expected status code %d, got %d", http.StatusOK, rw.Code)
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, rw.Code)
 	}
 }
 
@@ -335,7 +399,7 @@ func TestGetAllWithDefaultPageSize(t *testing.T) {
 		Repository: mockServiceRepository{
 			Data: func() []map[string]any {
 				var m []map[string]any
-				for i := 0; i < 20; i++ {
+				for i := 0; i < 30; i++ {
 					m = append(m, map[string]any{
 						"id":          strconv.Itoa(i),
 						"name":        "service" + strconv.Itoa(i),
@@ -350,8 +414,8 @@ func TestGetAllWithDefaultPageSize(t *testing.T) {
 		},
 	}
 
-	// Omit the pageSize parameter
-	req, err := http.NewRequest("GET", "/services?page=1", nil)
+	// Omit the limit parameter
+	req, err := http.NewRequest("GET", "/services", nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
 	}
@@ -369,10 +433,12 @@ func TestGetAllWithDefaultPageSize(t *testing.T) {
 		t.Fatalf("Failed to decode response body: %v", err)
 	}
 
-	// Verify that default pageSize (10) items were returned
-	expectedCount := 10
-	if len(returnedServices) != expectedCount {
-		t.Errorf("Expected %d services (default pageSize), got %d", expectedCount, len(returnedServices))
+	// Verify that v1 pages hold the default pageSize (10) items, with a link to the rest
+	if len(returnedServices) != v1PageSize {
+		t.Errorf("Expected %d services (default pageSize), got %d", v1PageSize, len(returnedServices))
+	}
+	if link := rw.Header().Get("Link"); !strings.Contains(link, "cursor="+pagination.EncodeCursor(v1PageSize)) {
+		t.Errorf("Expected a Link header to the next page, got %q", link)
 	}
 }
 
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
 	"service-atlas/repositories"
 	"time"
 )
@@ -10,6 +12,8 @@ import (
 type mockServiceRepository struct {
 	Data func() []map[string]any
 	Err  error
+	// Filter, when set, receives the filter GetAllServices is called with.
+	Filter *repositories.ServiceFilter
 }
 
 func (repo mockServiceRepository) CreateService(_ context.Context, _ repositories.Service) (string, error) {
@@ -110,9 +114,12 @@ func (repo mockServiceRepository) UpdateService(_ context.Context, service repos
 	}
 }
 
-func (repo mockServiceRepository) GetAllServices(_ context.Context, page int, pageSize int) ([]repositories.Service, error) {
+func (repo mockServiceRepository) GetAllServices(_ context.Context, filter repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	if repo.Filter != nil {
+		*repo.Filter = filter
+	}
 	if repo.Err != nil {
-		return []repositories.Service{}, repo.Err
+		return pagination.Result[repositories.Service]{}, repo.Err
 	}
 	d := repo.Data()
 
@@ -159,19 +166,7 @@ func (repo mockServiceRepository) GetAllServices(_ context.Context, page int, pa
 		allServices = append(allServices, service)
 	}
 
-	// Apply pagination
-	startIndex := (page - 1) * pageSize
-	endIndex := startIndex + pageSize
-
-	// Handle edge cases
-	if startIndex >= len(allServices) {
-		return []repositories.Service{}, nil
-	}
-	if endIndex > len(allServices) {
-		endIndex = len(allServices)
-	}
-
-	return allServices[startIndex:endIndex], nil
+	return pagination.Slice(allServices, page), nil
 }
 
 func (repo mockServiceRepository) DeleteService(_ context.Context, id string) error {
@@ -197,16 +192,16 @@ func (repo mockServiceRepository) DeleteService(_ context.Context, id string) er
 	return nil
 }
 
-func (repo mockServiceRepository) Search(ctx context.Context, _ string) ([]repositories.Service, error) {
+func (repo mockServiceRepository) Search(ctx context.Context, _ searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[repositories.Service]{}, repo.Err
 	}
-	return repo.GetAllServices(ctx, 1, 10)
+	return repo.GetAllServices(ctx, repositories.ServiceFilter{}, page)
 }
 
-func (repo mockServiceRepository) GetTeamsByServiceId(ctx context.Context, serviceId string) ([]repositories.Team, error) {
+func (repo mockServiceRepository) GetTeamsByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[repositories.Team]{}, repo.Err
 	}
 	d := repo.Data()
 	teams := make([]repositories.Team, 0)
@@ -233,5 +228,20 @@ func (repo mockServiceRepository) GetTeamsByServiceId(ctx context.Context, servi
 			}
 		}
 	}
-	return teams, nil
+	return pagination.Slice(teams, page), nil
+}
+
+func (repo mockServiceRepository) GetServicesByUrl(ctx context.Context, repoUrl string) ([]repositories.Service, error) {
+	if repo.Err != nil {
+		return nil, repo.Err
+	}
+	services := make([]repositories.Service, 0)
+	for _, v := range repo.Data() {
+		if url, ok := v["url"].(string); ok && repositories.NormalizeRepositoryUrl(url) == repositories.NormalizeRepositoryUrl(repoUrl) {
+			if id, ok := v["id"].(string); ok {
+				services = append(services, repositories.Service{Id: id, Url: url})
+			}
+		}
+	}
+	return services, nil
 }
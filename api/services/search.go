@@ -1,11 +1,10 @@
 package services
 
 import (
-	"encoding/json"
-	"log/slog"
 	"net/http"
-	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
 )
 
 func (u *ServiceCallsHandler) Search(rw http.ResponseWriter, r *http.Request) {
@@ -14,16 +13,20 @@ func (u *ServiceCallsHandler) Search(rw http.ResponseWriter, r *http.Request) {
 		http.Error(rw, "query parameter is required", http.StatusBadRequest)
 		return
 	}
-	logger := internal.LoggerFromContext(r.Context())
-	services, err := u.Repository.Search(r.Context(), query)
+	parsed, err := searchquery.Parse(query)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(services)
+	page, err := pagination.FromRequest(r, pagination.SearchLimit)
 	if err != nil {
-		logger.Debug("Error encoding services json",
-			slog.String("error", err.Error()))
+		customerrors.HandleError(rw, err)
+		return
+	}
+	services, err := u.Repository.Search(r.Context(), parsed, page)
+	if err != nil {
+		customerrors.HandleError(rw, err)
+		return
 	}
+	pagination.Write(rw, r, services)
 }
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 )
 
@@ -48,6 +51,25 @@ func TestServiceSearch_Success(t *testing.T) {
 	}
 }
 
+func TestServiceSearch_V1KeepsItsLimit(t *testing.T) {
+	data := make([]map[string]any, 0, 60)
+	for i := range 60 {
+		data = append(data, map[string]any{"id": strconv.Itoa(i), "name": "match " + strconv.Itoa(i), "type": "api"})
+	}
+	h := &ServiceCallsHandler{Repository: mockServiceRepository{Data: func() []map[string]any { return data }}}
+	rr := httptest.NewRecorder()
+
+	h.Search(rr, httptest.NewRequest(http.MethodGet, "/services/search?query=match", nil))
+
+	var got []repositories.Service
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if len(got) != pagination.SearchLimit {
+		t.Fatalf("expected %d services, got %d", pagination.SearchLimit, len(got))
+	}
+}
+
 func TestServiceSearch_MissingQuery(t *testing.T) {
 	h := &ServiceCallsHandler{Repository: mockServiceRepository{Data: func() []map[string]any { return nil }}}
 	req := httptest.NewRequest(http.MethodGet, "/services/search", nil)
@@ -60,6 +82,21 @@ func TestServiceSearch_MissingQuery(t *testing.T) {
 	}
 }
 
+func TestServiceSearch_InvalidQuery(t *testing.T) {
+	h := &ServiceCallsHandler{Repository: mockServiceRepository{Data: func() []map[string]any { return nil }}}
+	req := httptest.NewRequest(http.MethodGet, "/services/search?query="+url.QueryEscape(`team:payments name:"order svc`), nil)
+	rr := httptest.NewRecorder()
+
+	h.Search(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rr.Code)
+	}
+	if body := rr.Body.String(); body != "invalid search query at position 20: unterminated quote\n" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
 func TestServiceSearch_RepoError(t *testing.T) {
 	repo := mockServiceRepository{Data: func() []map[string]any { return nil }, Err: errors.New("boom")}
 	h := &ServiceCallsHandler{Repository: repo}
@@ -16,7 +16,7 @@ func (u *ServiceCallsHandler) UpdateService(rw http.ResponseWriter, r *http.Requ
 	if err != nil {
 		logger.Error("Error decoding request body:",
 			slog.String("error", err.Error()))
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	if id, ok := internal.GetGuidFromRequestPath("id", r); !ok || updateServiceRequest.Id != id {
@@ -0,0 +1,46 @@
+package system
+
+import (
+	"context"
+	"log/slog"
+	"service-atlas/repositories"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	servicesDesc = prometheus.NewDesc("service_atlas_catalog_services", "Services in the catalog.", nil, nil)
+	teamsDesc    = prometheus.NewDesc("service_atlas_catalog_teams", "Teams in the catalog.", nil, nil)
+	openDebtDesc = prometheus.NewDesc("service_atlas_catalog_open_debt", "Debt items that are pending or in progress.", nil, nil)
+)
+
+// CatalogCollector reports the size of the catalog, counted from the database on each scrape.
+type CatalogCollector struct {
+	Repository repositories.ReportRepository
+}
+
+func NewCatalogCollector(repository repositories.ReportRepository) *CatalogCollector {
+	return &CatalogCollector{Repository: repository}
+}
+
+func (c *CatalogCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- servicesDesc
+	ch <- teamsDesc
+	ch <- openDebtDesc
+}
+
+// Collect counts the catalog. If the database cannot be reached the gauges are left out of the scrape,
+// rather than reported as zero.
+func (c *CatalogCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	counts, err := c.Repository.GetCatalogCounts(ctx)
+	if err != nil {
+		slog.Error("Error counting the catalog for metrics", slog.String("error", err.Error()))
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(servicesDesc, prometheus.GaugeValue, float64(counts.Services))
+	ch <- prometheus.MustNewConstMetric(teamsDesc, prometheus.GaugeValue, float64(counts.Teams))
+	ch <- prometheus.MustNewConstMetric(openDebtDesc, prometheus.GaugeValue, float64(counts.OpenDebt))
+}
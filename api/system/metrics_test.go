@@ -0,0 +1,47 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// countingRepository reports fixed catalog counts.
+type countingRepository struct {
+	repositories.ReportRepository
+	counts *repositories.CatalogCounts
+	err    error
+}
+
+func (r countingRepository) GetCatalogCounts(_ context.Context) (*repositories.CatalogCounts, error) {
+	return r.counts, r.err
+}
+
+func TestCatalogCollector(t *testing.T) {
+	collector := NewCatalogCollector(countingRepository{counts: &repositories.CatalogCounts{Services: 12, Teams: 3, OpenDebt: 7}})
+	expected := `
+# HELP service_atlas_catalog_open_debt Debt items that are pending or in progress.
+# TYPE service_atlas_catalog_open_debt gauge
+service_atlas_catalog_open_debt 7
+# HELP service_atlas_catalog_services Services in the catalog.
+# TYPE service_atlas_catalog_services gauge
+service_atlas_catalog_services 12
+# HELP service_atlas_catalog_teams Teams in the catalog.
+# TYPE service_atlas_catalog_teams gauge
+service_atlas_catalog_teams 3
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCatalogCollector_DatabaseError(t *testing.T) {
+	collector := NewCatalogCollector(countingRepository{err: errors.New("database unavailable")})
+	if n := testutil.CollectAndCount(collector); n != 0 {
+		t.Errorf("expected no gauges when the catalog cannot be counted, got %d", n)
+	}
+}
@@ -0,0 +1,70 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"sync/atomic"
+	"time"
+)
+
+// Check is a dependency the service needs to serve traffic.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Readiness is the body of a readiness response, with the outcome of each check.
+type Readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Probes serves the liveness and readiness probes. Readiness fails once draining starts,
+// so load balancers stop sending traffic before the server shuts down.
+type Probes struct {
+	checks   []Check
+	draining atomic.Bool
+}
+
+func NewProbes(checks ...Check) *Probes {
+	return &Probes{checks: checks}
+}
+
+// Drain marks the service as not ready.
+func (p *Probes) Drain() {
+	p.draining.Store(true)
+}
+
+// Liveness reports that the process is serving requests.
+func (p *Probes) Liveness(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain")
+	_, _ = io.WriteString(rw, "ok")
+}
+
+// Readiness runs every check, responding 200 if all pass and 503 otherwise.
+func (p *Probes) Readiness(rw http.ResponseWriter, r *http.Request) {
+	readiness := Readiness{Status: "ready", Checks: make(map[string]string, len(p.checks))}
+	status := http.StatusOK
+	if p.draining.Load() {
+		readiness.Status, status = "draining", http.StatusServiceUnavailable
+	} else {
+		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+		defer cancel()
+		for _, check := range p.checks {
+			readiness.Checks[check.Name] = "ok"
+			if err := check.Check(ctx); err != nil {
+				internal.LoggerFromContext(r.Context()).Warn("Readiness check failed",
+					slog.String("check", check.Name), slog.String("error", err.Error()))
+				readiness.Checks[check.Name] = err.Error()
+				readiness.Status, status = "not ready", http.StatusServiceUnavailable
+			}
+		}
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(readiness)
+}
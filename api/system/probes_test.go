@@ -0,0 +1,63 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func readiness(t *testing.T, probes *Probes) (int, Readiness) {
+	t.Helper()
+	rw := httptest.NewRecorder()
+	probes.Readiness(rw, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var body Readiness
+	if err := json.Unmarshal(rw.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid readiness body %q: %v", rw.Body.String(), err)
+	}
+	return rw.Code, body
+}
+
+func TestLiveness(t *testing.T) {
+	rw := httptest.NewRecorder()
+	NewProbes().Liveness(rw, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rw.Code != http.StatusOK || rw.Body.String() != "ok" {
+		t.Errorf("unexpected liveness response %d %q", rw.Code, rw.Body.String())
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	ok := Check{Name: "neo4j", Check: func(context.Context) error { return nil }}
+	failing := Check{Name: "indexes", Check: func(context.Context) error { return errors.New("index service_fulltext_index is missing") }}
+
+	status, body := readiness(t, NewProbes(ok))
+	if status != http.StatusOK || body.Status != "ready" || body.Checks["neo4j"] != "ok" {
+		t.Errorf("expected ready, got %d %+v", status, body)
+	}
+
+	status, body = readiness(t, NewProbes(ok, failing))
+	if status != http.StatusServiceUnavailable || body.Status != "not ready" || body.Checks["indexes"] != "index service_fulltext_index is missing" {
+		t.Errorf("expected not ready, got %d %+v", status, body)
+	}
+}
+
+func TestReadinessWhileDraining(t *testing.T) {
+	called := false
+	probes := NewProbes(Check{Name: "neo4j", Check: func(context.Context) error { called = true; return nil }})
+	probes.Drain()
+	status, body := readiness(t, probes)
+	if status != http.StatusServiceUnavailable || body.Status != "draining" {
+		t.Errorf("expected draining, got %d %+v", status, body)
+	}
+	if called {
+		t.Error("expected checks to be skipped while draining")
+	}
+	// liveness is unaffected, so the process is not restarted while it drains
+	rw := httptest.NewRecorder()
+	probes.Liveness(rw, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rw.Code != http.StatusOK {
+		t.Errorf("expected live while draining, got %d", rw.Code)
+	}
+}
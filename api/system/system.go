@@ -1,6 +1,7 @@
 package system
 
 import (
+	"encoding/json"
 	"io"
 	"log"
 	"net/http"
@@ -19,15 +20,26 @@ func GetTime(rw http.ResponseWriter, _ *http.Request) {
 	rw.Header().Set("Content-Type", "text/plain")
 }
 
-func GetDbAddress(rw http.ResponseWriter, _ *http.Request) {
-	url := config.GetConfigValue("neo4j_url")
-
-	if url == "" {
-		http.Error(rw, "Could not find environment variable", http.StatusInternalServerError)
-		return
+// GetDbAddress returns a handler writing the address of the database the service connects to.
+func GetDbAddress(url string) http.HandlerFunc {
+	return func(rw http.ResponseWriter, _ *http.Request) {
+		if url == "" {
+			http.Error(rw, "Database address not configured", http.StatusInternalServerError)
+			return
+		}
+		_, err := io.WriteString(rw, url)
+		if err != nil {
+			log.Println(err)
+		}
 	}
-	_, err := io.WriteString(rw, url)
-	if err != nil {
-		log.Println(err)
+}
+
+// GetConfig returns a handler writing the configuration in effect, with secrets redacted.
+func GetConfig(live *config.Live) http.HandlerFunc {
+	return func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(live.Current().Redacted()); err != nil {
+			log.Println(err)
+		}
 	}
 }
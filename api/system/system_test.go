@@ -1,9 +1,10 @@
 package system
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
-	"os"
+	"service-atlas/internal/config"
 	"strconv"
 	"strings"
 	"testing"
@@ -32,12 +33,11 @@ func TestSystemGetTime(t *testing.T) {
 func TestGetDbAddress(t *testing.T) {
 	req, err := http.NewRequest("GET", "/database", nil)
 	rw := httptest.NewRecorder()
-	err = os.Setenv("NEO4J_URL", "test_url")
-	GetDbAddress(rw, req)
+	GetDbAddress("test_url")(rw, req)
 	if err != nil {
 		t.Errorf("GetDbAddress errored with %s", err.Error())
 	}
-	if rw.Code != http.StatusOK {
+	if rw.Code != http.StatusOK || rw.Body.String() != "test_url" {
 		t.Errorf("GetDbAddress errored with %s", strconv.Itoa(rw.Code))
 	}
 }
@@ -45,8 +45,7 @@ func TestGetDbAddress(t *testing.T) {
 func TestGetDbAddressError(t *testing.T) {
 	req, err := http.NewRequest("GET", "/database", nil)
 	rw := httptest.NewRecorder()
-	_ = os.Unsetenv("NEO4J_URL")
-	GetDbAddress(rw, req)
+	GetDbAddress("")(rw, req)
 	if err != nil {
 		t.Errorf("GetDbAddress errored with %s", err.Error())
 	}
@@ -54,7 +53,28 @@ func TestGetDbAddressError(t *testing.T) {
 		t.Errorf("GetDbAddress errored with %s", strconv.Itoa(rw.Code))
 	}
 	b := rw.Body.String()
-	if !strings.HasPrefix(b, "Could not find environment variable") {
+	if !strings.HasPrefix(b, "Database address not configured") {
 		t.Errorf("GetDbAddress errored with %s", b)
 	}
 }
+
+func TestGetConfig(t *testing.T) {
+	cfg := config.Default()
+	cfg.Database.URL = "neo4j://db:7687"
+	cfg.Database.Password = "hunter2"
+	rw := httptest.NewRecorder()
+	GetConfig(config.NewLive(&cfg))(rw, httptest.NewRequest("GET", "/config", nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rw.Code)
+	}
+	if strings.Contains(rw.Body.String(), "hunter2") {
+		t.Error("expected the password to be redacted")
+	}
+	var body map[string]map[string]any
+	if err := json.Unmarshal(rw.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["database"]["url"] != "neo4j://db:7687" || body["database"]["password"] != "[redacted]" {
+		t.Errorf("unexpected database section %v", body["database"])
+	}
+}
@@ -1,18 +1,13 @@
 package teams
 
-import (
-	"service-atlas/neo4jrepositories/teamrepository"
-	"service-atlas/repositories"
-
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
-)
+import "service-atlas/repositories"
 
 type CallsHandler struct {
 	Repository repositories.TeamRepository
 }
 
-func New(driver neo4j.DriverWithContext) *CallsHandler {
+func New(repository repositories.TeamRepository) *CallsHandler {
 	return &CallsHandler{
-		Repository: teamrepository.New(driver),
+		Repository: repository,
 	}
 }
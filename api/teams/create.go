@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
 	"service-atlas/repositories"
 	"time"
@@ -11,11 +12,9 @@ import (
 
 func (c CallsHandler) CreateTeam(rw http.ResponseWriter, r *http.Request) {
 	team := &repositories.Team{}
-	const maxBodySize = 1 << 20 // 1 MB
-	r.Body = http.MaxBytesReader(rw, r.Body, maxBodySize)
 	err := json.NewDecoder(r.Body).Decode(team)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	if err = team.Validate(); err != nil {
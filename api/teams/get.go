@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"service-atlas/internal"
 	"service-atlas/internal/customerrors"
-	"strconv"
+	"service-atlas/internal/pagination"
 )
 
+// v1PageSize is the size of v1 team pages when the request gives none, as before the list was paged by cursor.
+const v1PageSize = 10
+
 func (c CallsHandler) GetTeam(rw http.ResponseWriter, r *http.Request) {
 	id, ok := internal.GetGuidFromRequestPath("id", r)
 	if !ok {
@@ -28,31 +31,15 @@ func (c CallsHandler) GetTeam(rw http.ResponseWriter, r *http.Request) {
 }
 
 func (c CallsHandler) GetTeams(rw http.ResponseWriter, r *http.Request) {
-	page, err := strconv.Atoi(r.URL.Query().Get("page"))
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
-		return
-	}
-	if page < 1 {
-		http.Error(rw, "page must be positive", http.StatusBadRequest)
-		return
-	}
-	pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	page, err := pagination.FromRequest(r, v1PageSize)
 	if err != nil {
-		pageSize = 10
-	}
-	if pageSize < 1 || pageSize > 100 {
-		http.Error(rw, "pageSize must be between 1 and 100", http.StatusBadRequest)
+		customerrors.HandleError(rw, err)
 		return
 	}
-	teams, err := c.Repository.GetTeams(r.Context(), page, pageSize)
+	teams, err := c.Repository.GetTeams(r.Context(), page)
 	if err != nil {
 		customerrors.HandleError(rw, err)
 		return
 	}
-	rw.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(rw).Encode(teams)
-	if err != nil {
-		log.Println(err)
-	}
+	pagination.Write(rw, r, teams)
 }
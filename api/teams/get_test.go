@@ -118,8 +118,8 @@ func TestGetTeamsInvalidPage(t *testing.T) {
 	if rw.Code != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "invalid syntax") {
-		t.Errorf("expected invalid syntax error in body, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "Invalid page parameter") {
+		t.Errorf("expected invalid page error in body, got %q", body)
 	}
 }
 
@@ -143,10 +143,10 @@ func TestGetTeamsRepositoryError(t *testing.T) {
 }
 
 func TestGetTeamsDefaultPageSize(t *testing.T) {
-	// pageSize is non-numeric -> defaults to 10; handler should still succeed
+	// limit is omitted -> defaults to 25; handler should still succeed
 	h := CallsHandler{Repository: mockTeamRepository{teams: []repositories.Team{{Id: "1", Name: "A"}}}}
 
-	req, err := http.NewRequest("GET", "/teams?page=1&pageSize=not-a-number", nil)
+	req, err := http.NewRequest("GET", "/teams?page=1", nil)
 	if err != nil {
 		t.Fatalf("failed to create request: %v", err)
 	}
@@ -179,8 +179,8 @@ func TestGetTeamsPageSizeTooSmall(t *testing.T) {
 	if rw.Code != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "pageSize must be between 1 and 100") {
-		t.Errorf("expected pageSize bounds error, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "limit must be between 1 and 100") {
+		t.Errorf("expected limit bounds error, got %q", body)
 	}
 }
 
@@ -198,8 +198,8 @@ func TestGetTeamsPageSizeTooLarge(t *testing.T) {
 	if rw.Code != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "pageSize must be between 1 and 100") {
-		t.Errorf("expected pageSize bounds error, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "limit must be between 1 and 100") {
+		t.Errorf("expected limit bounds error, got %q", body)
 	}
 }
 
@@ -217,8 +217,8 @@ func TestGetTeamsPageZero(t *testing.T) {
 	if rw.Code != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "page must be positive") {
-		t.Errorf("expected page positivity error, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "Invalid page parameter") {
+		t.Errorf("expected invalid page error, got %q", body)
 	}
 }
 
@@ -236,13 +236,14 @@ func TestGetTeamsPageNegative(t *testing.T) {
 	if rw.Code != http.StatusBadRequest {
 		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "page must be positive") {
-		t.Errorf("expected page positivity error, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "Invalid page parameter") {
+		t.Errorf("expected invalid page error, got %q", body)
 	}
 }
 
 func TestGetTeamsMissingPageParameter(t *testing.T) {
-	h := CallsHandler{Repository: mockTeamRepository{}}
+	// page is optional -> the first page is returned
+	h := CallsHandler{Repository: mockTeamRepository{teams: []repositories.Team{{Id: "1", Name: "A"}}}}
 
 	req, err := http.NewRequest("GET", "/teams?pageSize=10", nil)
 	if err != nil {
@@ -252,10 +253,10 @@ func TestGetTeamsMissingPageParameter(t *testing.T) {
 
 	h.GetTeams(rw, req)
 
-	if rw.Code != http.StatusBadRequest {
-		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rw.Code)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rw.Code)
 	}
-	if body := rw.Body.String(); !strings.Contains(body, "invalid syntax") {
-		t.Errorf("expected invalid syntax error in body for missing page parameter, got %q", body)
+	if body := rw.Body.String(); !strings.Contains(body, "\"A\"") {
+		t.Errorf("expected team in response, got %q", body)
 	}
 }
@@ -2,6 +2,7 @@ package teams
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"service-atlas/repositories"
 )
 
@@ -18,11 +19,11 @@ func (repo mockTeamRepository) GetTeam(_ context.Context, _ string) (*repositori
 	return &repo.team, nil
 }
 
-func (repo mockTeamRepository) GetTeams(_ context.Context, _ int, _ int) ([]repositories.Team, error) {
+func (repo mockTeamRepository) GetTeams(_ context.Context, page pagination.Page) (pagination.Result[repositories.Team], error) {
 	if repo.Err != nil {
-		return nil, repo.Err
+		return pagination.Result[repositories.Team]{}, repo.Err
 	}
-	return repo.teams, nil
+	return pagination.Slice(repo.teams, page), nil
 }
 func (repo mockTeamRepository) CreateTeam(_ context.Context, _ repositories.Team) (string, error) {
 	if repo.Err != nil {
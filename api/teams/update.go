@@ -17,7 +17,7 @@ func (c CallsHandler) UpdateTeam(rw http.ResponseWriter, r *http.Request) {
 	team := &repositories.Team{}
 	err := json.NewDecoder(r.Body).Decode(team)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusBadRequest)
+		http.Error(rw, err.Error(), internal.BodyErrorStatus(err, http.StatusBadRequest))
 		return
 	}
 	if err := team.Validate(); err != nil {
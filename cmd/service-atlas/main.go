@@ -3,90 +3,223 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"service-atlas/api/grpcserver"
 	"service-atlas/api/routes"
+	"service-atlas/api/system"
 	"service-atlas/internal/config"
-	"service-atlas/neo4jrepositories"
+	"service-atlas/internal/events"
+	"service-atlas/internal/servertls"
+	"service-atlas/internal/tracing"
+	"service-atlas/neo4jrepositories/outbox"
+	"service-atlas/repositories"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"google.golang.org/grpc"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrate(os.Args[2:]))
+	}
 	ctx := context.Background()
-	logger := getLogger()
-	slog.SetDefault(logger)
-	driver, err := neo4j.NewDriverWithContext(
-		config.GetConfigValue("DB_URL"),
-		neo4j.BasicAuth(config.GetConfigValue("DB_USERNAME"), config.GetConfigValue("DB_PASSWORD"), ""))
-	defer func() {
-		closeErr := driver.Close(ctx)
-		if closeErr != nil {
-			slog.Error("error closing driver: ", slog.Any("error", closeErr))
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
 		}
-	}()
+		fmt.Fprintln(os.Stderr, "Invalid configuration:", err)
+		os.Exit(2)
+	}
+	level := new(slog.LevelVar)
+	level.Set(logLevel(cfg.Log.Level))
+	slog.SetDefault(getLogger(level))
+	live := config.NewLive(cfg)
+	live.OnReload(func(cfg *config.Config) { level.Set(logLevel(cfg.Log.Level)) })
+	shutdownTracing, err := tracing.Setup(ctx)
 	if err != nil {
-		slog.Error("Error creating driver: ", slog.Any("error", err))
+		slog.Error("Error setting up tracing: ", slog.Any("error", err))
 		os.Exit(1)
 	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Error flushing traces: ", slog.Any("error", err))
+		}
+	}()
+	store := openStorage(ctx, cfg)
+	defer store.close()
 
-	err = driver.VerifyConnectivity(ctx)
-	if err != nil {
-		panic(err)
-	}
-	//setup indexes for search
-	err = neo4jrepositories.Startup(ctx, driver)
-	if err != nil {
-		panic(err)
-	}
+	stopRelay := startEventRelay(ctx, cfg.Events, store.driver)
+	defer stopRelay()
 
-	mux := routes.SetupRouter(driver)
+	probes := system.NewProbes(store.checks...)
+	mux := routes.SetupRouter(live, store.repos, probes)
 
 	server := &http.Server{
-		Handler: mux,
-		Addr:    config.GetConfigValue("address"),
+		Handler:           mux,
+		Addr:              cfg.Server.Address,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
 	}
 
-	slog.Info("Starting Web Server")
+	serve := server.ListenAndServe
+	var reloader *servertls.Reloader
+	if cfg.Server.TLS.CertFile != "" {
+		reloader, err = servertls.New(cfg.Server.TLS)
+		if err != nil {
+			slog.Error("Error loading TLS certificate: ", slog.Any("error", err))
+			os.Exit(1)
+		}
+		server.TLSConfig = reloader.TLSConfig()
+		serve = func() error { return server.ListenAndServeTLS("", "") }
+	}
+
+	slog.Info("Starting Web Server", slog.String("address", cfg.Server.Address),
+		slog.Bool("tls", server.TLSConfig != nil), slog.String("clientAuth", cfg.Server.TLS.ClientAuth))
 	go func() {
-		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if err := serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			slog.Error("listen error", slog.Any("error", err))
 		}
 	}()
+	grpcServer := startGRPCServer(cfg, store.repos, reloader)
+	stopReloading := reloadOnHangup(live)
+	defer stopReloading()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGQUIT, syscall.SIGTERM)
 	<-quit
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// fail readiness first, so traffic is drained before the server stops accepting it
+	probes.Drain()
+	slog.Info("Draining before shutdown", slog.Duration("delay", cfg.Server.DrainDelay))
+	time.Sleep(cfg.Server.DrainDelay)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 	if err := server.Shutdown(ctx); err != nil {
 		slog.Error("Server forced to shutdown:", slog.Any("error", err))
 	}
+	if grpcServer != nil {
+		grpcServer.GracefulStop()
+	}
 }
 
-func getLogger() *slog.Logger {
-	lvlEnv, ok := os.LookupEnv("LOG_LEVEL")
-	if !ok {
-		lvlEnv = "info"
+// startGRPCServer serves the gRPC API on server.grpcAddress alongside the HTTP server, unless features.grpc is off
+// or the address is "off". When the HTTP server uses TLS, so does the gRPC server, with the same certificate and
+// client certificate checks.
+func startGRPCServer(cfg *config.Config, repos repositories.Repositories, reloader *servertls.Reloader) *grpc.Server {
+	address := cfg.Server.GRPCAddress
+	if !cfg.Features.GRPC || address == "" || strings.EqualFold(address, "off") {
+		slog.Info("gRPC server disabled")
+		return nil
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		slog.Error("Error listening for gRPC: ", slog.Any("error", err))
+		os.Exit(1)
+	}
+	var opts []grpc.ServerOption
+	if reloader != nil {
+		opts = append(opts, grpc.Creds(reloader.GRPCCredentials()))
 	}
-	switch strings.ToLower(lvlEnv) {
+	server := grpcserver.New(repos, cfg.Auth, opts...)
+	slog.Info("Starting gRPC Server", slog.String("address", address), slog.Bool("tls", reloader != nil),
+		slog.String("clientAuth", cfg.Server.TLS.ClientAuth))
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			slog.Error("gRPC serve error", slog.Any("error", err))
+		}
+	}()
+	return server
+}
+
+// startEventRelay starts draining the outbox to the configured sink.
+// When no sink is configured events stay in the outbox until one is.
+// The returned function stops the relay and waits for the current drain to finish.
+func startEventRelay(ctx context.Context, cfg config.Events, driver neo4j.DriverWithContext) func() {
+	if cfg.Sink == "" || strings.EqualFold(cfg.Sink, "none") {
+		slog.Info("No event sink configured, outbox relay disabled")
+		return func() {}
+	}
+	sink, err := events.NewSink(cfg.Sink, cfg.Target)
+	if err != nil {
+		slog.Error("Error creating event sink: ", slog.Any("error", err))
+		os.Exit(1)
+	}
+	relayCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		outbox.NewRelay(driver, sink, cfg.RelayInterval, cfg.Retention).Run(relayCtx)
+	}()
+	slog.Info("Started outbox relay", slog.String("sink", cfg.Sink))
+	return func() {
+		cancel()
+		<-done
+		if closer, ok := sink.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				slog.Error("Error closing event sink: ", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// reloadOnHangup reloads the configuration each time the process receives SIGHUP. The log level, rate limits and
+// webhook secrets change in place without dropping connections; an invalid configuration is logged and the current
+// one kept. The returned function stops listening for the signal.
+func reloadOnHangup(live *config.Live) func() {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	go func() {
+		for range hangup {
+			cfg, err := config.Load(os.Args[1:])
+			if err != nil {
+				slog.Error("Invalid configuration, keeping the current one", slog.Any("error", err))
+				continue
+			}
+			restart := live.Reload(cfg)
+			slog.Info("Reloaded configuration")
+			if len(restart) > 0 {
+				slog.Warn("Some changed settings only take effect after a restart", slog.Any("settings", restart))
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(hangup)
+		close(hangup)
+	}
+}
+
+// getLogger writes JSON logs at level, which can be changed while running. Services started at warn or error
+// log to stderr, others to stdout.
+func getLogger(level *slog.LevelVar) *slog.Logger {
+	out := os.Stdout
+	if level.Level() >= slog.LevelWarn {
+		out = os.Stderr
+	}
+	return slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: level}))
+}
+
+func logLevel(name string) slog.Level {
+	switch strings.ToLower(name) {
 	case "debug":
-		return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level: slog.LevelDebug,
-		}))
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
 	case "error":
-		return slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-			Level: slog.LevelError,
-		}))
-	case "warning":
-		return slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
-			Level: slog.LevelWarn,
-		}))
-	}
-	return slog.New(slog.NewJSONHandler(os.Stdout, nil))
-
+		return slog.LevelError
+	}
+	return slog.LevelInfo
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"service-atlas/internal/config"
+	"service-atlas/neo4jrepositories"
+	"slices"
+	"strings"
+)
+
+// migrate runs `service-atlas migrate [-dry-run] [settings]`, applying the pending schema migrations, or listing
+// them with -dry-run, and returns the exit code. It waits while another replica is migrating.
+func migrate(args []string) int {
+	isDryRun := func(arg string) bool { return arg == "-dry-run" || arg == "--dry-run" }
+	dryRun := slices.ContainsFunc(args, isDryRun)
+	cfg, err := config.Load(slices.DeleteFunc(slices.Clone(args), isDryRun))
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintln(os.Stderr, "Invalid configuration:", err)
+		return 2
+	}
+	if !strings.EqualFold(cfg.Storage.Backend, "neo4j") {
+		fmt.Fprintln(os.Stderr, "Migrations only apply to the neo4j storage backend")
+		return 2
+	}
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+	ctx := context.Background()
+	driver, closeDriver := connectNeo4j(ctx, cfg.Database)
+	defer closeDriver()
+	migrator := neo4jrepositories.NewMigrator(driver)
+
+	if dryRun {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			slog.Error("Error reading the applied migrations", slog.Any("error", err))
+			return 1
+		}
+		if len(pending) == 0 {
+			fmt.Println("The database is up to date")
+		}
+		for _, migration := range pending {
+			fmt.Printf("%d: %s\n", migration.Version, migration.Description)
+			for _, statement := range migration.Statements {
+				fmt.Printf("    %s\n", strings.Join(strings.Fields(statement), " "))
+			}
+		}
+		return 0
+	}
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		slog.Error("Error migrating the database", slog.Any("error", err))
+		return 1
+	}
+	fmt.Printf("Applied %d migrations\n", len(applied))
+	if pending, err := migrator.Pending(ctx); err == nil && len(pending) > 0 {
+		fmt.Printf("Deferred %d migrations until the data blocking them is fixed, see the log\n", len(pending))
+	}
+	return 0
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"service-atlas/api/system"
+	"service-atlas/databaseadapter"
+	"service-atlas/internal/config"
+	"service-atlas/memoryrepositories"
+	"service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/apikeyrepository"
+	"service-atlas/neo4jrepositories/debtrepository"
+	"service-atlas/neo4jrepositories/dependencyrepository"
+	"service-atlas/neo4jrepositories/graphrepository"
+	"service-atlas/neo4jrepositories/releaserepository"
+	"service-atlas/neo4jrepositories/reportrepository"
+	"service-atlas/neo4jrepositories/searchrepository"
+	"service-atlas/neo4jrepositories/servicerepository"
+	"service-atlas/neo4jrepositories/teamrepository"
+	"service-atlas/repositories"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	neo4jconfig "github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
+)
+
+// storage is where the catalog is kept, as selected by storage.backend.
+type storage struct {
+	repos repositories.Repositories
+	// checks are the readiness checks of the backend.
+	checks []system.Check
+	// driver is the Neo4j driver, or nil when the catalog is not kept in Neo4j.
+	driver neo4j.DriverWithContext
+	close  func()
+}
+
+// openStorage connects to the configured backend, exiting when it cannot be used.
+func openStorage(ctx context.Context, cfg *config.Config) storage {
+	switch strings.ToLower(cfg.Storage.Backend) {
+	case "memory":
+		slog.Warn("Keeping the catalog in memory, it is lost when the service stops")
+		return storage{repos: memoryrepositories.New().Repositories(), close: func() {}}
+	case "file":
+		store, err := memoryrepositories.Open(cfg.Storage.Path)
+		if err != nil {
+			slog.Error("Error opening the catalog file", slog.Any("error", err))
+			os.Exit(1)
+		}
+		slog.Info("Keeping the catalog in a file", slog.String("path", cfg.Storage.Path))
+		return storage{repos: store.Repositories(), close: func() {}}
+	}
+	return openNeo4j(ctx, cfg.Database)
+}
+
+func openNeo4j(ctx context.Context, cfg config.Database) storage {
+	driver, closeDriver := connectNeo4j(ctx, cfg)
+	migrator := neo4jrepositories.NewMigrator(driver)
+	if cfg.Migrate {
+		// keep serving, not ready, so a failed migration can be investigated through the API; migrations blocked by
+		// existing data are deferred instead
+		if _, err := migrator.Up(ctx); err != nil {
+			slog.Error("Error migrating the database, the service is not ready until it succeeds", slog.Any("error", err))
+		}
+	} else if pending, err := migrator.Pending(ctx); err == nil && len(pending) > 0 {
+		slog.Warn("Schema migrations are pending, run service-atlas migrate", slog.Int("pending", len(pending)))
+	}
+	return storage{
+		repos: neo4jRepositories(driver),
+		checks: []system.Check{
+			{Name: "neo4j", Check: driver.VerifyConnectivity},
+			{Name: "migrations", Check: migrator.Verify},
+		},
+		driver: driver,
+		close:  closeDriver,
+	}
+}
+
+// connectNeo4j creates the driver and checks the database can be reached, exiting when it cannot.
+func connectNeo4j(ctx context.Context, cfg config.Database) (*databaseadapter.Driver, func()) {
+	neo4jDriver, err := neo4j.NewDriverWithContext(
+		cfg.URL,
+		neo4j.BasicAuth(cfg.Username, cfg.Password.Value(), ""),
+		func(c *neo4jconfig.Config) {
+			c.MaxConnectionPoolSize = cfg.MaxConnectionPoolSize
+			c.ConnectionAcquisitionTimeout = cfg.ConnectionAcquisitionTimeout
+			c.MaxTransactionRetryTime = cfg.MaxTransactionRetryTime
+		})
+	if err != nil {
+		slog.Error("Error creating driver: ", slog.Any("error", err))
+		os.Exit(1)
+	}
+	var bookmarks neo4j.BookmarkManager
+	if cfg.CausalConsistency {
+		bookmarks = neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+	}
+	driver := databaseadapter.Configure(neo4jDriver, databaseadapter.Options{
+		Database:           cfg.Name,
+		Bookmarks:          bookmarks,
+		TransactionTimeout: cfg.TransactionTimeout,
+	})
+
+	err = driver.VerifyConnectivity(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return driver, func() {
+		if closeErr := neo4jDriver.Close(ctx); closeErr != nil {
+			slog.Error("error closing driver: ", slog.Any("error", closeErr))
+		}
+	}
+}
+
+func neo4jRepositories(driver neo4j.DriverWithContext) repositories.Repositories {
+	return repositories.Repositories{
+		Services:     servicerepository.New(driver),
+		Dependencies: dependencyrepository.New(driver),
+		Teams:        teamrepository.New(driver),
+		Debt:         debtrepository.New(driver),
+		Releases:     releaserepository.New(driver),
+		Reports:      reportrepository.New(driver),
+		Graph:        graphrepository.New(driver),
+		ApiKeys:      apikeyrepository.New(driver),
+		Search:       searchrepository.New(driver),
+	}
+}
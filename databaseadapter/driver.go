@@ -0,0 +1,38 @@
+package databaseadapter
+
+import (
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Options are the session settings shared by every transaction the adapter runs.
+type Options struct {
+	// Database is the database transactions run in, or the server's default database when empty.
+	// It lets several catalogs share one cluster.
+	Database string
+	// Bookmarks, if set, chains sessions for causal consistency, so a read routed to a replica
+	// sees the writes made before it.
+	Bookmarks neo4j.BookmarkManager
+	// TransactionTimeout, if set, aborts transactions that run longer.
+	TransactionTimeout time.Duration
+}
+
+// Driver is a Neo4j driver with the Options every repository created with it uses.
+type Driver struct {
+	neo4j.DriverWithContext
+	Options Options
+}
+
+// Configure returns driver with the options applied to the sessions of each DriverManager created from it.
+func Configure(driver neo4j.DriverWithContext, opts Options) *Driver {
+	return &Driver{DriverWithContext: driver, Options: opts}
+}
+
+// options returns the options driver was configured with, if any.
+func options(driver neo4j.DriverWithContext) Options {
+	if configured, ok := driver.(*Driver); ok {
+		return configured.Options
+	}
+	return Options{}
+}
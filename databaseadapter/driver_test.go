@@ -0,0 +1,27 @@
+package databaseadapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestOptions(t *testing.T) {
+	driver, err := neo4j.NewDriverWithContext("neo4j://localhost:7687", neo4j.NoAuth())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts := options(driver); opts != (Options{}) {
+		t.Errorf("expected no options for a plain driver, got %+v", opts)
+	}
+	bookmarks := neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+	configured := Configure(driver, Options{Database: "sandbox", Bookmarks: bookmarks, TransactionTimeout: time.Second})
+	opts := options(configured)
+	if opts.Database != "sandbox" || opts.Bookmarks != bookmarks || opts.TransactionTimeout != time.Second {
+		t.Errorf("unexpected options %+v", opts)
+	}
+	if configured.Target() != driver.Target() {
+		t.Error("expected the configured driver to wrap the original")
+	}
+}
@@ -2,10 +2,16 @@ package databaseadapter
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/metrics"
+	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.opentelemetry.io/otel/codes"
 )
 
 func NewDriverManager(driver neo4j.DriverWithContext) DriverManager {
@@ -18,7 +24,12 @@ type Neo4jDriverAdapter struct {
 }
 
 func (n Neo4jDriverAdapter) executeInSession(ctx context.Context, work func(tx neo4j.ManagedTransaction) (any, error), mode neo4j.AccessMode) (any, error) {
-	session := n.driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: mode})
+	opts := options(n.driver)
+	session := n.driver.NewSession(ctx, neo4j.SessionConfig{
+		AccessMode:      mode,
+		DatabaseName:    opts.Database,
+		BookmarkManager: opts.Bookmarks,
+	})
 	logger := internal.LoggerFromContext(ctx)
 	defer func() {
 		err := session.Close(context.Background())
@@ -29,15 +40,48 @@ func (n Neo4jDriverAdapter) executeInSession(ctx context.Context, work func(tx n
 		}
 	}()
 	requestId := internal.GetRequestIdFromContext(ctx)
+	config := []func(*neo4j.TransactionConfig){neo4j.WithTxMetadata(map[string]any{
+		"requestId": requestId,
+	})}
+	if opts.TransactionTimeout > 0 {
+		config = append(config, neo4j.WithTxTimeout(opts.TransactionTimeout))
+	}
+	ctx, span, work, stats := startTransactionSpan(ctx, modeName(mode), opts.Database, work)
+	defer span.End()
+	start := time.Now()
+	var result any
+	var err error
 	if mode == neo4j.AccessModeWrite {
-		return session.ExecuteWrite(ctx, work,
-			neo4j.WithTxMetadata(map[string]any{
-				"requestId": requestId,
-			}))
+		result, err = session.ExecuteWrite(ctx, work, config...)
+	} else {
+		result, err = session.ExecuteRead(ctx, work, config...)
 	}
-	return session.ExecuteRead(ctx, work, neo4j.WithTxMetadata(map[string]any{
-		"requestId": requestId,
-	}))
+	metrics.ObserveTransaction(modeName(mode), time.Since(start), failed(err))
+	if stats != nil {
+		span.SetAttributes(stats.attributes()...)
+	}
+	if failed(err) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+func modeName(mode neo4j.AccessMode) string {
+	if mode == neo4j.AccessModeWrite {
+		return "write"
+	}
+	return "read"
+}
+
+// failed reports whether err is a failure of the transaction, rather than a client error such as a missing
+// entity that the work function returned to roll it back.
+func failed(err error) bool {
+	if err == nil {
+		return false
+	}
+	var httpErr *customerrors.HTTPError
+	return !errors.As(err, &httpErr) || httpErr.Status >= http.StatusInternalServerError
 }
 
 func (n Neo4jDriverAdapter) ExecuteWrite(ctx context.Context, work func(tx neo4j.ManagedTransaction) (any, error)) (any, error) {
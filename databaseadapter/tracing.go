@@ -0,0 +1,151 @@
+package databaseadapter
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("service-atlas/databaseadapter")
+
+// transactionStats counts what the work of a transaction did, across retries.
+type transactionStats struct {
+	attempts   atomic.Int64
+	statements atomic.Int64
+	records    atomic.Int64
+}
+
+func (s *transactionStats) attributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.DBResponseReturnedRows(int(s.records.Load())),
+		attribute.Int64("db.neo4j.statements", s.statements.Load()),
+		attribute.Int64("db.neo4j.attempts", s.attempts.Load()),
+	}
+}
+
+// startTransactionSpan starts a client span for a transaction, named after the repository method running it.
+// The work is wrapped to count the statements it runs and the records it reads.
+func startTransactionSpan(ctx context.Context, mode, database string, work func(tx neo4j.ManagedTransaction) (any, error)) (context.Context, trace.Span, func(tx neo4j.ManagedTransaction) (any, error), *transactionStats) {
+	name := queryName()
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemNameNeo4j,
+		semconv.DBOperationName(name),
+		attribute.String("db.neo4j.access_mode", mode),
+	}
+	if database != "" {
+		attributes = append(attributes, semconv.DBNamespace(database))
+	}
+	ctx, span := tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	if !span.IsRecording() {
+		return ctx, span, work, nil
+	}
+	stats := &transactionStats{}
+	return ctx, span, func(tx neo4j.ManagedTransaction) (any, error) {
+		stats.attempts.Add(1)
+		return work(&tracedTransaction{ManagedTransaction: tx, stats: stats})
+	}, stats
+}
+
+// queryName names the first caller outside this package, such as "reportrepository.GetDebtCountByService".
+func queryName() string {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "service-atlas/databaseadapter.") {
+			return shortFunctionName(frame.Function)
+		}
+		if !more {
+			return "neo4j"
+		}
+	}
+}
+
+// shortFunctionName reduces "service-atlas/neo4jrepositories/reportrepository.Neo4jReportRepository.GetDebtCountByService.func1"
+// to "reportrepository.GetDebtCountByService".
+func shortFunctionName(function string) string {
+	function = function[strings.LastIndex(function, "/")+1:]
+	parts := strings.Split(function, ".")
+	for len(parts) > 2 && isClosure(parts[len(parts)-1]) {
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) > 2 {
+		parts = []string{parts[0], parts[len(parts)-1]}
+	}
+	return strings.Join(parts, ".")
+}
+
+// isClosure reports whether a function name segment names a closure, such as "func1" or the "2" of "func1.2".
+func isClosure(segment string) bool {
+	return strings.HasPrefix(segment, "func") || strings.Trim(segment, "0123456789") == ""
+}
+
+// tracedTransaction counts the statements run in a transaction and the records they return.
+type tracedTransaction struct {
+	neo4j.ManagedTransaction
+	stats *transactionStats
+}
+
+func (t *tracedTransaction) Run(ctx context.Context, cypher string, params map[string]any) (neo4j.ResultWithContext, error) {
+	t.stats.statements.Add(1)
+	result, err := t.ManagedTransaction.Run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	return &countingResult{ResultWithContext: result, records: &t.stats.records}, nil
+}
+
+// countingResult counts the records read from a result.
+type countingResult struct {
+	neo4j.ResultWithContext
+	records *atomic.Int64
+}
+
+func (r *countingResult) Next(ctx context.Context) bool {
+	ok := r.ResultWithContext.Next(ctx)
+	if ok {
+		r.records.Add(1)
+	}
+	return ok
+}
+
+func (r *countingResult) NextRecord(ctx context.Context, record **neo4j.Record) bool {
+	ok := r.ResultWithContext.NextRecord(ctx, record)
+	if ok {
+		r.records.Add(1)
+	}
+	return ok
+}
+
+func (r *countingResult) Collect(ctx context.Context) ([]*neo4j.Record, error) {
+	records, err := r.ResultWithContext.Collect(ctx)
+	r.records.Add(int64(len(records)))
+	return records, err
+}
+
+func (r *countingResult) Single(ctx context.Context) (*neo4j.Record, error) {
+	record, err := r.ResultWithContext.Single(ctx)
+	if record != nil {
+		r.records.Add(1)
+	}
+	return record, err
+}
+
+func (r *countingResult) Records(ctx context.Context) func(yield func(*neo4j.Record, error) bool) {
+	records := r.ResultWithContext.Records(ctx)
+	return func(yield func(*neo4j.Record, error) bool) {
+		records(func(record *neo4j.Record, err error) bool {
+			if err == nil {
+				r.records.Add(1)
+			}
+			return yield(record, err)
+		})
+	}
+}
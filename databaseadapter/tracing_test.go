@@ -0,0 +1,65 @@
+package databaseadapter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestShortFunctionName(t *testing.T) {
+	tests := map[string]string{
+		"service-atlas/neo4jrepositories/reportrepository.Neo4jReportRepository.GetDebtCountByService": "reportrepository.GetDebtCountByService",
+		"service-atlas/neo4jrepositories/teamrepository.(*Neo4jTeamRepository).GetTeams.func1":         "teamrepository.GetTeams",
+		"service-atlas/neo4jrepositories/outbox.Relay.drain.func2.1":                                   "outbox.drain",
+		"service-atlas/neo4jrepositories.(*Migrator).apply.func1":                                      "neo4jrepositories.apply",
+	}
+	for function, want := range tests {
+		if got := shortFunctionName(function); got != want {
+			t.Errorf("shortFunctionName(%q) = %q, want %q", function, got, want)
+		}
+	}
+}
+
+func TestQueryNameSkipsAdapter(t *testing.T) {
+	// the test itself is in this package, so the first caller outside it is the test runner
+	if name := queryName(); name != "testing.tRunner" {
+		t.Errorf("unexpected query name %q", name)
+	}
+}
+
+// fakeResult yields a fixed number of records.
+type fakeResult struct {
+	neo4j.ResultWithContext
+	remaining int
+}
+
+func (r *fakeResult) Next(context.Context) bool {
+	if r.remaining == 0 {
+		return false
+	}
+	r.remaining--
+	return true
+}
+
+func (r *fakeResult) Collect(context.Context) ([]*neo4j.Record, error) {
+	records := make([]*neo4j.Record, r.remaining)
+	r.remaining = 0
+	return records, nil
+}
+
+func TestCountingResult(t *testing.T) {
+	var records atomic.Int64
+	ctx := context.Background()
+	result := &countingResult{ResultWithContext: &fakeResult{remaining: 3}, records: &records}
+	for result.Next(ctx) {
+	}
+	collected := &countingResult{ResultWithContext: &fakeResult{remaining: 2}, records: &records}
+	if _, err := collected.Collect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if records.Load() != 5 {
+		t.Errorf("expected 5 records, got %d", records.Load())
+	}
+}
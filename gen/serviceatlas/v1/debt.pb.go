@@ -0,0 +1,422 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/debt.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateDebtRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId   string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Type        string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// status defaults to pending.
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebtRequest) Reset() {
+	*x = CreateDebtRequest{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebtRequest) ProtoMessage() {}
+
+func (x *CreateDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebtRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebtRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateDebtRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateDebtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebtResponse) Reset() {
+	*x = CreateDebtResponse{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebtResponse) ProtoMessage() {}
+
+func (x *CreateDebtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebtResponse.ProtoReflect.Descriptor instead.
+func (*CreateDebtResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{1}
+}
+
+type ListDebtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Page          *Page                  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	OnlyResolved  bool                   `protobuf:"varint,3,opt,name=only_resolved,json=onlyResolved,proto3" json:"only_resolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDebtRequest) Reset() {
+	*x = ListDebtRequest{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDebtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDebtRequest) ProtoMessage() {}
+
+func (x *ListDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDebtRequest.ProtoReflect.Descriptor instead.
+func (*ListDebtRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDebtRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListDebtRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListDebtRequest) GetOnlyResolved() bool {
+	if x != nil {
+		return x.OnlyResolved
+	}
+	return false
+}
+
+type ListDebtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debt          []*Debt                `protobuf:"bytes,1,rep,name=debt,proto3" json:"debt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDebtResponse) Reset() {
+	*x = ListDebtResponse{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDebtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDebtResponse) ProtoMessage() {}
+
+func (x *ListDebtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDebtResponse.ProtoReflect.Descriptor instead.
+func (*ListDebtResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDebtResponse) GetDebt() []*Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+type UpdateDebtStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDebtStatusRequest) Reset() {
+	*x = UpdateDebtStatusRequest{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDebtStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDebtStatusRequest) ProtoMessage() {}
+
+func (x *UpdateDebtStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDebtStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDebtStatusRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateDebtStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDebtStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateDebtStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDebtStatusResponse) Reset() {
+	*x = UpdateDebtStatusResponse{}
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDebtStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDebtStatusResponse) ProtoMessage() {}
+
+func (x *UpdateDebtStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_debt_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDebtStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDebtStatusResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_debt_proto_rawDescGZIP(), []int{5}
+}
+
+var File_serviceatlas_v1_debt_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_debt_proto_rawDesc = "" +
+	"\n" +
+	"\x1aserviceatlas/v1/debt.proto\x12\x0fserviceatlas.v1\x1a\x1bserviceatlas/v1/types.proto\"\x96\x01\n" +
+	"\x11CreateDebtRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\x14\n" +
+	"\x12CreateDebtResponse\"\x80\x01\n" +
+	"\x0fListDebtRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.serviceatlas.v1.PageR\x04page\x12#\n" +
+	"\ronly_resolved\x18\x03 \x01(\bR\fonlyResolved\"=\n" +
+	"\x10ListDebtResponse\x12)\n" +
+	"\x04debt\x18\x01 \x03(\v2\x15.serviceatlas.v1.DebtR\x04debt\"A\n" +
+	"\x17UpdateDebtStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x1a\n" +
+	"\x18UpdateDebtStatusResponse2\x9e\x02\n" +
+	"\vDebtService\x12U\n" +
+	"\n" +
+	"CreateDebt\x12\".serviceatlas.v1.CreateDebtRequest\x1a#.serviceatlas.v1.CreateDebtResponse\x12O\n" +
+	"\bListDebt\x12 .serviceatlas.v1.ListDebtRequest\x1a!.serviceatlas.v1.ListDebtResponse\x12g\n" +
+	"\x10UpdateDebtStatus\x12(.serviceatlas.v1.UpdateDebtStatusRequest\x1a).serviceatlas.v1.UpdateDebtStatusResponseB\xaf\x01\n" +
+	"\x13com.serviceatlas.v1B\tDebtProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_debt_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_debt_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_debt_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_debt_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_debt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_debt_proto_rawDesc), len(file_serviceatlas_v1_debt_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_debt_proto_rawDescData
+}
+
+var file_serviceatlas_v1_debt_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_serviceatlas_v1_debt_proto_goTypes = []any{
+	(*CreateDebtRequest)(nil),        // 0: serviceatlas.v1.CreateDebtRequest
+	(*CreateDebtResponse)(nil),       // 1: serviceatlas.v1.CreateDebtResponse
+	(*ListDebtRequest)(nil),          // 2: serviceatlas.v1.ListDebtRequest
+	(*ListDebtResponse)(nil),         // 3: serviceatlas.v1.ListDebtResponse
+	(*UpdateDebtStatusRequest)(nil),  // 4: serviceatlas.v1.UpdateDebtStatusRequest
+	(*UpdateDebtStatusResponse)(nil), // 5: serviceatlas.v1.UpdateDebtStatusResponse
+	(*Page)(nil),                     // 6: serviceatlas.v1.Page
+	(*Debt)(nil),                     // 7: serviceatlas.v1.Debt
+}
+var file_serviceatlas_v1_debt_proto_depIdxs = []int32{
+	6, // 0: serviceatlas.v1.ListDebtRequest.page:type_name -> serviceatlas.v1.Page
+	7, // 1: serviceatlas.v1.ListDebtResponse.debt:type_name -> serviceatlas.v1.Debt
+	0, // 2: serviceatlas.v1.DebtService.CreateDebt:input_type -> serviceatlas.v1.CreateDebtRequest
+	2, // 3: serviceatlas.v1.DebtService.ListDebt:input_type -> serviceatlas.v1.ListDebtRequest
+	4, // 4: serviceatlas.v1.DebtService.UpdateDebtStatus:input_type -> serviceatlas.v1.UpdateDebtStatusRequest
+	1, // 5: serviceatlas.v1.DebtService.CreateDebt:output_type -> serviceatlas.v1.CreateDebtResponse
+	3, // 6: serviceatlas.v1.DebtService.ListDebt:output_type -> serviceatlas.v1.ListDebtResponse
+	5, // 7: serviceatlas.v1.DebtService.UpdateDebtStatus:output_type -> serviceatlas.v1.UpdateDebtStatusResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_debt_proto_init() }
+func file_serviceatlas_v1_debt_proto_init() {
+	if File_serviceatlas_v1_debt_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_debt_proto_rawDesc), len(file_serviceatlas_v1_debt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_debt_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_debt_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_debt_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_debt_proto = out.File
+	file_serviceatlas_v1_debt_proto_goTypes = nil
+	file_serviceatlas_v1_debt_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: serviceatlas/v1/debt.proto
+
+package serviceatlasv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DebtService_CreateDebt_FullMethodName       = "/serviceatlas.v1.DebtService/CreateDebt"
+	DebtService_ListDebt_FullMethodName         = "/serviceatlas.v1.DebtService/ListDebt"
+	DebtService_UpdateDebtStatus_FullMethodName = "/serviceatlas.v1.DebtService/UpdateDebtStatus"
+)
+
+// DebtServiceClient is the client API for DebtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DebtService manages technical debt recorded against services.
+type DebtServiceClient interface {
+	CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*CreateDebtResponse, error)
+	ListDebt(ctx context.Context, in *ListDebtRequest, opts ...grpc.CallOption) (*ListDebtResponse, error)
+	UpdateDebtStatus(ctx context.Context, in *UpdateDebtStatusRequest, opts ...grpc.CallOption) (*UpdateDebtStatusResponse, error)
+}
+
+type debtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebtServiceClient(cc grpc.ClientConnInterface) DebtServiceClient {
+	return &debtServiceClient{cc}
+}
+
+func (c *debtServiceClient) CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*CreateDebtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDebtResponse)
+	err := c.cc.Invoke(ctx, DebtService_CreateDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) ListDebt(ctx context.Context, in *ListDebtRequest, opts ...grpc.CallOption) (*ListDebtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDebtResponse)
+	err := c.cc.Invoke(ctx, DebtService_ListDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debtServiceClient) UpdateDebtStatus(ctx context.Context, in *UpdateDebtStatusRequest, opts ...grpc.CallOption) (*UpdateDebtStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDebtStatusResponse)
+	err := c.cc.Invoke(ctx, DebtService_UpdateDebtStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebtServiceServer is the server API for DebtService service.
+// All implementations must embed UnimplementedDebtServiceServer
+// for forward compatibility.
+//
+// DebtService manages technical debt recorded against services.
+type DebtServiceServer interface {
+	CreateDebt(context.Context, *CreateDebtRequest) (*CreateDebtResponse, error)
+	ListDebt(context.Context, *ListDebtRequest) (*ListDebtResponse, error)
+	UpdateDebtStatus(context.Context, *UpdateDebtStatusRequest) (*UpdateDebtStatusResponse, error)
+	mustEmbedUnimplementedDebtServiceServer()
+}
+
+// UnimplementedDebtServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDebtServiceServer struct{}
+
+func (UnimplementedDebtServiceServer) CreateDebt(context.Context, *CreateDebtRequest) (*CreateDebtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDebt not implemented")
+}
+func (UnimplementedDebtServiceServer) ListDebt(context.Context, *ListDebtRequest) (*ListDebtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDebt not implemented")
+}
+func (UnimplementedDebtServiceServer) UpdateDebtStatus(context.Context, *UpdateDebtStatusRequest) (*UpdateDebtStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDebtStatus not implemented")
+}
+func (UnimplementedDebtServiceServer) mustEmbedUnimplementedDebtServiceServer() {}
+func (UnimplementedDebtServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeDebtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DebtServiceServer will
+// result in compilation errors.
+type UnsafeDebtServiceServer interface {
+	mustEmbedUnimplementedDebtServiceServer()
+}
+
+func RegisterDebtServiceServer(s grpc.ServiceRegistrar, srv DebtServiceServer) {
+	// If the following call panics, it indicates UnimplementedDebtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DebtService_ServiceDesc, srv)
+}
+
+func _DebtService_CreateDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).CreateDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_CreateDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).CreateDebt(ctx, req.(*CreateDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_ListDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).ListDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_ListDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).ListDebt(ctx, req.(*ListDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebtService_UpdateDebtStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDebtStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebtServiceServer).UpdateDebtStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DebtService_UpdateDebtStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebtServiceServer).UpdateDebtStatus(ctx, req.(*UpdateDebtStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DebtService_ServiceDesc is the grpc.ServiceDesc for DebtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DebtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "serviceatlas.v1.DebtService",
+	HandlerType: (*DebtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDebt",
+			Handler:    _DebtService_CreateDebt_Handler,
+		},
+		{
+			MethodName: "ListDebt",
+			Handler:    _DebtService_ListDebt_Handler,
+		},
+		{
+			MethodName: "UpdateDebtStatus",
+			Handler:    _DebtService_UpdateDebtStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serviceatlas/v1/debt.proto",
+}
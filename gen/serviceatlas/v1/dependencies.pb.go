@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/dependencies.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependenciesRequest) Reset() {
+	*x = ListDependenciesRequest{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependenciesRequest) ProtoMessage() {}
+
+func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDependenciesRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type ListDependenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependencies  []*Dependency          `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependenciesResponse) Reset() {
+	*x = ListDependenciesResponse{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependenciesResponse) ProtoMessage() {}
+
+func (x *ListDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDependenciesResponse) GetDependencies() []*Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type ListDependentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependentsRequest) Reset() {
+	*x = ListDependentsRequest{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependentsRequest) ProtoMessage() {}
+
+func (x *ListDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDependentsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type ListDependentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependents    []*Dependency          `protobuf:"bytes,1,rep,name=dependents,proto3" json:"dependents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependentsResponse) Reset() {
+	*x = ListDependentsResponse{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependentsResponse) ProtoMessage() {}
+
+func (x *ListDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListDependentsResponse) GetDependents() []*Dependency {
+	if x != nil {
+		return x.Dependents
+	}
+	return nil
+}
+
+type AddDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyRequest) Reset() {
+	*x = AddDependencyRequest{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyRequest) ProtoMessage() {}
+
+func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddDependencyRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AddDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+func (x *AddDependencyRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type AddDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyResponse) Reset() {
+	*x = AddDependencyResponse{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyResponse) ProtoMessage() {}
+
+func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{5}
+}
+
+type DeleteDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	DependsOnId   string                 `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDependencyRequest) Reset() {
+	*x = DeleteDependencyRequest{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDependencyRequest) ProtoMessage() {}
+
+func (x *DeleteDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDependencyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteDependencyRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DeleteDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+type DeleteDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDependencyResponse) Reset() {
+	*x = DeleteDependencyResponse{}
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDependencyResponse) ProtoMessage() {}
+
+func (x *DeleteDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_dependencies_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDependencyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_dependencies_proto_rawDescGZIP(), []int{7}
+}
+
+var File_serviceatlas_v1_dependencies_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_dependencies_proto_rawDesc = "" +
+	"\n" +
+	"\"serviceatlas/v1/dependencies.proto\x12\x0fserviceatlas.v1\x1a\x1bserviceatlas/v1/types.proto\"8\n" +
+	"\x17ListDependenciesRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"[\n" +
+	"\x18ListDependenciesResponse\x12?\n" +
+	"\fdependencies\x18\x01 \x03(\v2\x1b.serviceatlas.v1.DependencyR\fdependencies\"6\n" +
+	"\x15ListDependentsRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"U\n" +
+	"\x16ListDependentsResponse\x12;\n" +
+	"\n" +
+	"dependents\x18\x01 \x03(\v2\x1b.serviceatlas.v1.DependencyR\n" +
+	"dependents\"s\n" +
+	"\x14AddDependencyRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"\x17\n" +
+	"\x15AddDependencyResponse\"\\\n" +
+	"\x17DeleteDependencyRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\"\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tR\vdependsOnId\"\x1a\n" +
+	"\x18DeleteDependencyResponse2\xaa\x03\n" +
+	"\x13DependenciesService\x12g\n" +
+	"\x10ListDependencies\x12(.serviceatlas.v1.ListDependenciesRequest\x1a).serviceatlas.v1.ListDependenciesResponse\x12a\n" +
+	"\x0eListDependents\x12&.serviceatlas.v1.ListDependentsRequest\x1a'.serviceatlas.v1.ListDependentsResponse\x12^\n" +
+	"\rAddDependency\x12%.serviceatlas.v1.AddDependencyRequest\x1a&.serviceatlas.v1.AddDependencyResponse\x12g\n" +
+	"\x10DeleteDependency\x12(.serviceatlas.v1.DeleteDependencyRequest\x1a).serviceatlas.v1.DeleteDependencyResponseB\xb7\x01\n" +
+	"\x13com.serviceatlas.v1B\x11DependenciesProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_dependencies_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_dependencies_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_dependencies_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_dependencies_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_dependencies_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_dependencies_proto_rawDesc), len(file_serviceatlas_v1_dependencies_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_dependencies_proto_rawDescData
+}
+
+var file_serviceatlas_v1_dependencies_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_serviceatlas_v1_dependencies_proto_goTypes = []any{
+	(*ListDependenciesRequest)(nil),  // 0: serviceatlas.v1.ListDependenciesRequest
+	(*ListDependenciesResponse)(nil), // 1: serviceatlas.v1.ListDependenciesResponse
+	(*ListDependentsRequest)(nil),    // 2: serviceatlas.v1.ListDependentsRequest
+	(*ListDependentsResponse)(nil),   // 3: serviceatlas.v1.ListDependentsResponse
+	(*AddDependencyRequest)(nil),     // 4: serviceatlas.v1.AddDependencyRequest
+	(*AddDependencyResponse)(nil),    // 5: serviceatlas.v1.AddDependencyResponse
+	(*DeleteDependencyRequest)(nil),  // 6: serviceatlas.v1.DeleteDependencyRequest
+	(*DeleteDependencyResponse)(nil), // 7: serviceatlas.v1.DeleteDependencyResponse
+	(*Dependency)(nil),               // 8: serviceatlas.v1.Dependency
+}
+var file_serviceatlas_v1_dependencies_proto_depIdxs = []int32{
+	8, // 0: serviceatlas.v1.ListDependenciesResponse.dependencies:type_name -> serviceatlas.v1.Dependency
+	8, // 1: serviceatlas.v1.ListDependentsResponse.dependents:type_name -> serviceatlas.v1.Dependency
+	0, // 2: serviceatlas.v1.DependenciesService.ListDependencies:input_type -> serviceatlas.v1.ListDependenciesRequest
+	2, // 3: serviceatlas.v1.DependenciesService.ListDependents:input_type -> serviceatlas.v1.ListDependentsRequest
+	4, // 4: serviceatlas.v1.DependenciesService.AddDependency:input_type -> serviceatlas.v1.AddDependencyRequest
+	6, // 5: serviceatlas.v1.DependenciesService.DeleteDependency:input_type -> serviceatlas.v1.DeleteDependencyRequest
+	1, // 6: serviceatlas.v1.DependenciesService.ListDependencies:output_type -> serviceatlas.v1.ListDependenciesResponse
+	3, // 7: serviceatlas.v1.DependenciesService.ListDependents:output_type -> serviceatlas.v1.ListDependentsResponse
+	5, // 8: serviceatlas.v1.DependenciesService.AddDependency:output_type -> serviceatlas.v1.AddDependencyResponse
+	7, // 9: serviceatlas.v1.DependenciesService.DeleteDependency:output_type -> serviceatlas.v1.DeleteDependencyResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_dependencies_proto_init() }
+func file_serviceatlas_v1_dependencies_proto_init() {
+	if File_serviceatlas_v1_dependencies_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_dependencies_proto_rawDesc), len(file_serviceatlas_v1_dependencies_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_dependencies_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_dependencies_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_dependencies_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_dependencies_proto = out.File
+	file_serviceatlas_v1_dependencies_proto_goTypes = nil
+	file_serviceatlas_v1_dependencies_proto_depIdxs = nil
+}
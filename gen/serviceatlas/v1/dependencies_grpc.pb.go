@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: serviceatlas/v1/dependencies.proto
+
+package serviceatlasv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DependenciesService_ListDependencies_FullMethodName = "/serviceatlas.v1.DependenciesService/ListDependencies"
+	DependenciesService_ListDependents_FullMethodName   = "/serviceatlas.v1.DependenciesService/ListDependents"
+	DependenciesService_AddDependency_FullMethodName    = "/serviceatlas.v1.DependenciesService/AddDependency"
+	DependenciesService_DeleteDependency_FullMethodName = "/serviceatlas.v1.DependenciesService/DeleteDependency"
+)
+
+// DependenciesServiceClient is the client API for DependenciesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DependenciesService manages the dependency edges between services.
+type DependenciesServiceClient interface {
+	// ListDependencies lists the services a service depends on.
+	ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*ListDependenciesResponse, error)
+	// ListDependents lists the services that depend on a service.
+	ListDependents(ctx context.Context, in *ListDependentsRequest, opts ...grpc.CallOption) (*ListDependentsResponse, error)
+	AddDependency(ctx context.Context, in *AddDependencyRequest, opts ...grpc.CallOption) (*AddDependencyResponse, error)
+	DeleteDependency(ctx context.Context, in *DeleteDependencyRequest, opts ...grpc.CallOption) (*DeleteDependencyResponse, error)
+}
+
+type dependenciesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDependenciesServiceClient(cc grpc.ClientConnInterface) DependenciesServiceClient {
+	return &dependenciesServiceClient{cc}
+}
+
+func (c *dependenciesServiceClient) ListDependencies(ctx context.Context, in *ListDependenciesRequest, opts ...grpc.CallOption) (*ListDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDependenciesResponse)
+	err := c.cc.Invoke(ctx, DependenciesService_ListDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependenciesServiceClient) ListDependents(ctx context.Context, in *ListDependentsRequest, opts ...grpc.CallOption) (*ListDependentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDependentsResponse)
+	err := c.cc.Invoke(ctx, DependenciesService_ListDependents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependenciesServiceClient) AddDependency(ctx context.Context, in *AddDependencyRequest, opts ...grpc.CallOption) (*AddDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDependencyResponse)
+	err := c.cc.Invoke(ctx, DependenciesService_AddDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dependenciesServiceClient) DeleteDependency(ctx context.Context, in *DeleteDependencyRequest, opts ...grpc.CallOption) (*DeleteDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDependencyResponse)
+	err := c.cc.Invoke(ctx, DependenciesService_DeleteDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DependenciesServiceServer is the server API for DependenciesService service.
+// All implementations must embed UnimplementedDependenciesServiceServer
+// for forward compatibility.
+//
+// DependenciesService manages the dependency edges between services.
+type DependenciesServiceServer interface {
+	// ListDependencies lists the services a service depends on.
+	ListDependencies(context.Context, *ListDependenciesRequest) (*ListDependenciesResponse, error)
+	// ListDependents lists the services that depend on a service.
+	ListDependents(context.Context, *ListDependentsRequest) (*ListDependentsResponse, error)
+	AddDependency(context.Context, *AddDependencyRequest) (*AddDependencyResponse, error)
+	DeleteDependency(context.Context, *DeleteDependencyRequest) (*DeleteDependencyResponse, error)
+	mustEmbedUnimplementedDependenciesServiceServer()
+}
+
+// UnimplementedDependenciesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDependenciesServiceServer struct{}
+
+func (UnimplementedDependenciesServiceServer) ListDependencies(context.Context, *ListDependenciesRequest) (*ListDependenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDependencies not implemented")
+}
+func (UnimplementedDependenciesServiceServer) ListDependents(context.Context, *ListDependentsRequest) (*ListDependentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDependents not implemented")
+}
+func (UnimplementedDependenciesServiceServer) AddDependency(context.Context, *AddDependencyRequest) (*AddDependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDependency not implemented")
+}
+func (UnimplementedDependenciesServiceServer) DeleteDependency(context.Context, *DeleteDependencyRequest) (*DeleteDependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDependency not implemented")
+}
+func (UnimplementedDependenciesServiceServer) mustEmbedUnimplementedDependenciesServiceServer() {}
+func (UnimplementedDependenciesServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeDependenciesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DependenciesServiceServer will
+// result in compilation errors.
+type UnsafeDependenciesServiceServer interface {
+	mustEmbedUnimplementedDependenciesServiceServer()
+}
+
+func RegisterDependenciesServiceServer(s grpc.ServiceRegistrar, srv DependenciesServiceServer) {
+	// If the following call panics, it indicates UnimplementedDependenciesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DependenciesService_ServiceDesc, srv)
+}
+
+func _DependenciesService_ListDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependenciesServiceServer).ListDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependenciesService_ListDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependenciesServiceServer).ListDependencies(ctx, req.(*ListDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependenciesService_ListDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependenciesServiceServer).ListDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependenciesService_ListDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependenciesServiceServer).ListDependents(ctx, req.(*ListDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependenciesService_AddDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependenciesServiceServer).AddDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependenciesService_AddDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependenciesServiceServer).AddDependency(ctx, req.(*AddDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DependenciesService_DeleteDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DependenciesServiceServer).DeleteDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DependenciesService_DeleteDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DependenciesServiceServer).DeleteDependency(ctx, req.(*DeleteDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DependenciesService_ServiceDesc is the grpc.ServiceDesc for DependenciesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DependenciesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "serviceatlas.v1.DependenciesService",
+	HandlerType: (*DependenciesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDependencies",
+			Handler:    _DependenciesService_ListDependencies_Handler,
+		},
+		{
+			MethodName: "ListDependents",
+			Handler:    _DependenciesService_ListDependents_Handler,
+		},
+		{
+			MethodName: "AddDependency",
+			Handler:    _DependenciesService_AddDependency_Handler,
+		},
+		{
+			MethodName: "DeleteDependency",
+			Handler:    _DependenciesService_DeleteDependency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serviceatlas/v1/dependencies.proto",
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/export.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ExportGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// releases_per_service is the number of most recent releases exported per service; 0 exports none.
+	ReleasesPerService int32 `protobuf:"varint,1,opt,name=releases_per_service,json=releasesPerService,proto3" json:"releases_per_service,omitempty"`
+	IncludeDebt        bool  `protobuf:"varint,2,opt,name=include_debt,json=includeDebt,proto3" json:"include_debt,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExportGraphRequest) Reset() {
+	*x = ExportGraphRequest{}
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphRequest) ProtoMessage() {}
+
+func (x *ExportGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphRequest.ProtoReflect.Descriptor instead.
+func (*ExportGraphRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_export_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportGraphRequest) GetReleasesPerService() int32 {
+	if x != nil {
+		return x.ReleasesPerService
+	}
+	return 0
+}
+
+func (x *ExportGraphRequest) GetIncludeDebt() bool {
+	if x != nil {
+		return x.IncludeDebt
+	}
+	return false
+}
+
+type ExportGraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*ExportGraphResponse_Team
+	//	*ExportGraphResponse_Service
+	//	*ExportGraphResponse_Dependency
+	//	*ExportGraphResponse_Ownership
+	//	*ExportGraphResponse_Release
+	//	*ExportGraphResponse_Debt
+	Item          isExportGraphResponse_Item `protobuf_oneof:"item"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGraphResponse) Reset() {
+	*x = ExportGraphResponse{}
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphResponse) ProtoMessage() {}
+
+func (x *ExportGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphResponse.ProtoReflect.Descriptor instead.
+func (*ExportGraphResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_export_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportGraphResponse) GetItem() isExportGraphResponse_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetTeam() *Team {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Team); ok {
+			return x.Team
+		}
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetService() *Service {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Service); ok {
+			return x.Service
+		}
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetDependency() *DependencyEdge {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Dependency); ok {
+			return x.Dependency
+		}
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetOwnership() *Ownership {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Ownership); ok {
+			return x.Ownership
+		}
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetRelease() *Release {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Release); ok {
+			return x.Release
+		}
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetDebt() *Debt {
+	if x != nil {
+		if x, ok := x.Item.(*ExportGraphResponse_Debt); ok {
+			return x.Debt
+		}
+	}
+	return nil
+}
+
+type isExportGraphResponse_Item interface {
+	isExportGraphResponse_Item()
+}
+
+type ExportGraphResponse_Team struct {
+	Team *Team `protobuf:"bytes,1,opt,name=team,proto3,oneof"`
+}
+
+type ExportGraphResponse_Service struct {
+	Service *Service `protobuf:"bytes,2,opt,name=service,proto3,oneof"`
+}
+
+type ExportGraphResponse_Dependency struct {
+	Dependency *DependencyEdge `protobuf:"bytes,3,opt,name=dependency,proto3,oneof"`
+}
+
+type ExportGraphResponse_Ownership struct {
+	Ownership *Ownership `protobuf:"bytes,4,opt,name=ownership,proto3,oneof"`
+}
+
+type ExportGraphResponse_Release struct {
+	Release *Release `protobuf:"bytes,5,opt,name=release,proto3,oneof"`
+}
+
+type ExportGraphResponse_Debt struct {
+	Debt *Debt `protobuf:"bytes,6,opt,name=debt,proto3,oneof"`
+}
+
+func (*ExportGraphResponse_Team) isExportGraphResponse_Item() {}
+
+func (*ExportGraphResponse_Service) isExportGraphResponse_Item() {}
+
+func (*ExportGraphResponse_Dependency) isExportGraphResponse_Item() {}
+
+func (*ExportGraphResponse_Ownership) isExportGraphResponse_Item() {}
+
+func (*ExportGraphResponse_Release) isExportGraphResponse_Item() {}
+
+func (*ExportGraphResponse_Debt) isExportGraphResponse_Item() {}
+
+// DependencyEdge records that service_id depends on the dependency.
+type DependencyEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Dependency    *Dependency            `protobuf:"bytes,2,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyEdge) Reset() {
+	*x = DependencyEdge{}
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyEdge) ProtoMessage() {}
+
+func (x *DependencyEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyEdge.ProtoReflect.Descriptor instead.
+func (*DependencyEdge) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_export_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DependencyEdge) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetDependency() *Dependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+// Ownership records that team_id owns service_id.
+type Ownership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ownership) Reset() {
+	*x = Ownership{}
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ownership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ownership) ProtoMessage() {}
+
+func (x *Ownership) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_export_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ownership.ProtoReflect.Descriptor instead.
+func (*Ownership) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_export_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Ownership) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Ownership) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+var File_serviceatlas_v1_export_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_export_proto_rawDesc = "" +
+	"\n" +
+	"\x1cserviceatlas/v1/export.proto\x12\x0fserviceatlas.v1\x1a\x1bserviceatlas/v1/types.proto\"i\n" +
+	"\x12ExportGraphRequest\x120\n" +
+	"\x14releases_per_service\x18\x01 \x01(\x05R\x12releasesPerService\x12!\n" +
+	"\finclude_debt\x18\x02 \x01(\bR\vincludeDebt\"\xe2\x02\n" +
+	"\x13ExportGraphResponse\x12+\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.serviceatlas.v1.TeamH\x00R\x04team\x124\n" +
+	"\aservice\x18\x02 \x01(\v2\x18.serviceatlas.v1.ServiceH\x00R\aservice\x12A\n" +
+	"\n" +
+	"dependency\x18\x03 \x01(\v2\x1f.serviceatlas.v1.DependencyEdgeH\x00R\n" +
+	"dependency\x12:\n" +
+	"\townership\x18\x04 \x01(\v2\x1a.serviceatlas.v1.OwnershipH\x00R\townership\x124\n" +
+	"\arelease\x18\x05 \x01(\v2\x18.serviceatlas.v1.ReleaseH\x00R\arelease\x12+\n" +
+	"\x04debt\x18\x06 \x01(\v2\x15.serviceatlas.v1.DebtH\x00R\x04debtB\x06\n" +
+	"\x04item\"l\n" +
+	"\x0eDependencyEdge\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12;\n" +
+	"\n" +
+	"dependency\x18\x02 \x01(\v2\x1b.serviceatlas.v1.DependencyR\n" +
+	"dependency\"C\n" +
+	"\tOwnership\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId2k\n" +
+	"\rExportService\x12Z\n" +
+	"\vExportGraph\x12#.serviceatlas.v1.ExportGraphRequest\x1a$.serviceatlas.v1.ExportGraphResponse0\x01B\xb1\x01\n" +
+	"\x13com.serviceatlas.v1B\vExportProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_export_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_export_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_export_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_export_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_export_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_export_proto_rawDesc), len(file_serviceatlas_v1_export_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_export_proto_rawDescData
+}
+
+var file_serviceatlas_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_serviceatlas_v1_export_proto_goTypes = []any{
+	(*ExportGraphRequest)(nil),  // 0: serviceatlas.v1.ExportGraphRequest
+	(*ExportGraphResponse)(nil), // 1: serviceatlas.v1.ExportGraphResponse
+	(*DependencyEdge)(nil),      // 2: serviceatlas.v1.DependencyEdge
+	(*Ownership)(nil),           // 3: serviceatlas.v1.Ownership
+	(*Team)(nil),                // 4: serviceatlas.v1.Team
+	(*Service)(nil),             // 5: serviceatlas.v1.Service
+	(*Release)(nil),             // 6: serviceatlas.v1.Release
+	(*Debt)(nil),                // 7: serviceatlas.v1.Debt
+	(*Dependency)(nil),          // 8: serviceatlas.v1.Dependency
+}
+var file_serviceatlas_v1_export_proto_depIdxs = []int32{
+	4, // 0: serviceatlas.v1.ExportGraphResponse.team:type_name -> serviceatlas.v1.Team
+	5, // 1: serviceatlas.v1.ExportGraphResponse.service:type_name -> serviceatlas.v1.Service
+	2, // 2: serviceatlas.v1.ExportGraphResponse.dependency:type_name -> serviceatlas.v1.DependencyEdge
+	3, // 3: serviceatlas.v1.ExportGraphResponse.ownership:type_name -> serviceatlas.v1.Ownership
+	6, // 4: serviceatlas.v1.ExportGraphResponse.release:type_name -> serviceatlas.v1.Release
+	7, // 5: serviceatlas.v1.ExportGraphResponse.debt:type_name -> serviceatlas.v1.Debt
+	8, // 6: serviceatlas.v1.DependencyEdge.dependency:type_name -> serviceatlas.v1.Dependency
+	0, // 7: serviceatlas.v1.ExportService.ExportGraph:input_type -> serviceatlas.v1.ExportGraphRequest
+	1, // 8: serviceatlas.v1.ExportService.ExportGraph:output_type -> serviceatlas.v1.ExportGraphResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_export_proto_init() }
+func file_serviceatlas_v1_export_proto_init() {
+	if File_serviceatlas_v1_export_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	file_serviceatlas_v1_export_proto_msgTypes[1].OneofWrappers = []any{
+		(*ExportGraphResponse_Team)(nil),
+		(*ExportGraphResponse_Service)(nil),
+		(*ExportGraphResponse_Dependency)(nil),
+		(*ExportGraphResponse_Ownership)(nil),
+		(*ExportGraphResponse_Release)(nil),
+		(*ExportGraphResponse_Debt)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_export_proto_rawDesc), len(file_serviceatlas_v1_export_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_export_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_export_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_export_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_export_proto = out.File
+	file_serviceatlas_v1_export_proto_goTypes = nil
+	file_serviceatlas_v1_export_proto_depIdxs = nil
+}
@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/releases.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateReleaseRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Version   string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Url       string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// release_date defaults to now.
+	ReleaseDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReleaseRequest) Reset() {
+	*x = CreateReleaseRequest{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReleaseRequest) ProtoMessage() {}
+
+func (x *CreateReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReleaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateReleaseRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *CreateReleaseRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CreateReleaseRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateReleaseRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+type CreateReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReleaseResponse) Reset() {
+	*x = CreateReleaseResponse{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReleaseResponse) ProtoMessage() {}
+
+func (x *CreateReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReleaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{1}
+}
+
+type ListReleasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Page          *Page                  `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReleasesRequest) Reset() {
+	*x = ListReleasesRequest{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesRequest) ProtoMessage() {}
+
+func (x *ListReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListReleasesRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListReleasesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListReleasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Releases      []*Release             `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReleasesResponse) Reset() {
+	*x = ListReleasesResponse{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesResponse) ProtoMessage() {}
+
+func (x *ListReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListReleasesResponse) GetReleases() []*Release {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+type ListReleasesInRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page          *Page                  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReleasesInRangeRequest) Reset() {
+	*x = ListReleasesInRangeRequest{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReleasesInRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesInRangeRequest) ProtoMessage() {}
+
+func (x *ListReleasesInRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesInRangeRequest.ProtoReflect.Descriptor instead.
+func (*ListReleasesInRangeRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReleasesInRangeRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListReleasesInRangeRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListReleasesInRangeRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListReleasesInRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Releases      []*ServiceRelease      `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReleasesInRangeResponse) Reset() {
+	*x = ListReleasesInRangeResponse{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReleasesInRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReleasesInRangeResponse) ProtoMessage() {}
+
+func (x *ListReleasesInRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReleasesInRangeResponse.ProtoReflect.Descriptor instead.
+func (*ListReleasesInRangeResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReleasesInRangeResponse) GetReleases() []*ServiceRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+// ServiceRelease is a release together with the name and type of its service.
+type ServiceRelease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceType   string                 `protobuf:"bytes,2,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	Release       *Release               `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceRelease) Reset() {
+	*x = ServiceRelease{}
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRelease) ProtoMessage() {}
+
+func (x *ServiceRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_releases_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRelease.ProtoReflect.Descriptor instead.
+func (*ServiceRelease) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_releases_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServiceRelease) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceRelease) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *ServiceRelease) GetRelease() *Release {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+var File_serviceatlas_v1_releases_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_releases_proto_rawDesc = "" +
+	"\n" +
+	"\x1eserviceatlas/v1/releases.proto\x12\x0fserviceatlas.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bserviceatlas/v1/types.proto\"\xa0\x01\n" +
+	"\x14CreateReleaseRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12=\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\"\x17\n" +
+	"\x15CreateReleaseResponse\"_\n" +
+	"\x13ListReleasesRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.serviceatlas.v1.PageR\x04page\"L\n" +
+	"\x14ListReleasesResponse\x124\n" +
+	"\breleases\x18\x01 \x03(\v2\x18.serviceatlas.v1.ReleaseR\breleases\"\xb9\x01\n" +
+	"\x1aListReleasesInRangeRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12)\n" +
+	"\x04page\x18\x03 \x01(\v2\x15.serviceatlas.v1.PageR\x04page\"Z\n" +
+	"\x1bListReleasesInRangeResponse\x12;\n" +
+	"\breleases\x18\x01 \x03(\v2\x1f.serviceatlas.v1.ServiceReleaseR\breleases\"\x8a\x01\n" +
+	"\x0eServiceRelease\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_type\x18\x02 \x01(\tR\vserviceType\x122\n" +
+	"\arelease\x18\x03 \x01(\v2\x18.serviceatlas.v1.ReleaseR\arelease2\xc0\x02\n" +
+	"\x0fReleasesService\x12^\n" +
+	"\rCreateRelease\x12%.serviceatlas.v1.CreateReleaseRequest\x1a&.serviceatlas.v1.CreateReleaseResponse\x12[\n" +
+	"\fListReleases\x12$.serviceatlas.v1.ListReleasesRequest\x1a%.serviceatlas.v1.ListReleasesResponse\x12p\n" +
+	"\x13ListReleasesInRange\x12+.serviceatlas.v1.ListReleasesInRangeRequest\x1a,.serviceatlas.v1.ListReleasesInRangeResponseB\xb3\x01\n" +
+	"\x13com.serviceatlas.v1B\rReleasesProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_releases_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_releases_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_releases_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_releases_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_releases_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_releases_proto_rawDesc), len(file_serviceatlas_v1_releases_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_releases_proto_rawDescData
+}
+
+var file_serviceatlas_v1_releases_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_serviceatlas_v1_releases_proto_goTypes = []any{
+	(*CreateReleaseRequest)(nil),        // 0: serviceatlas.v1.CreateReleaseRequest
+	(*CreateReleaseResponse)(nil),       // 1: serviceatlas.v1.CreateReleaseResponse
+	(*ListReleasesRequest)(nil),         // 2: serviceatlas.v1.ListReleasesRequest
+	(*ListReleasesResponse)(nil),        // 3: serviceatlas.v1.ListReleasesResponse
+	(*ListReleasesInRangeRequest)(nil),  // 4: serviceatlas.v1.ListReleasesInRangeRequest
+	(*ListReleasesInRangeResponse)(nil), // 5: serviceatlas.v1.ListReleasesInRangeResponse
+	(*ServiceRelease)(nil),              // 6: serviceatlas.v1.ServiceRelease
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+	(*Page)(nil),                        // 8: serviceatlas.v1.Page
+	(*Release)(nil),                     // 9: serviceatlas.v1.Release
+}
+var file_serviceatlas_v1_releases_proto_depIdxs = []int32{
+	7,  // 0: serviceatlas.v1.CreateReleaseRequest.release_date:type_name -> google.protobuf.Timestamp
+	8,  // 1: serviceatlas.v1.ListReleasesRequest.page:type_name -> serviceatlas.v1.Page
+	9,  // 2: serviceatlas.v1.ListReleasesResponse.releases:type_name -> serviceatlas.v1.Release
+	7,  // 3: serviceatlas.v1.ListReleasesInRangeRequest.start_date:type_name -> google.protobuf.Timestamp
+	7,  // 4: serviceatlas.v1.ListReleasesInRangeRequest.end_date:type_name -> google.protobuf.Timestamp
+	8,  // 5: serviceatlas.v1.ListReleasesInRangeRequest.page:type_name -> serviceatlas.v1.Page
+	6,  // 6: serviceatlas.v1.ListReleasesInRangeResponse.releases:type_name -> serviceatlas.v1.ServiceRelease
+	9,  // 7: serviceatlas.v1.ServiceRelease.release:type_name -> serviceatlas.v1.Release
+	0,  // 8: serviceatlas.v1.ReleasesService.CreateRelease:input_type -> serviceatlas.v1.CreateReleaseRequest
+	2,  // 9: serviceatlas.v1.ReleasesService.ListReleases:input_type -> serviceatlas.v1.ListReleasesRequest
+	4,  // 10: serviceatlas.v1.ReleasesService.ListReleasesInRange:input_type -> serviceatlas.v1.ListReleasesInRangeRequest
+	1,  // 11: serviceatlas.v1.ReleasesService.CreateRelease:output_type -> serviceatlas.v1.CreateReleaseResponse
+	3,  // 12: serviceatlas.v1.ReleasesService.ListReleases:output_type -> serviceatlas.v1.ListReleasesResponse
+	5,  // 13: serviceatlas.v1.ReleasesService.ListReleasesInRange:output_type -> serviceatlas.v1.ListReleasesInRangeResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_releases_proto_init() }
+func file_serviceatlas_v1_releases_proto_init() {
+	if File_serviceatlas_v1_releases_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_releases_proto_rawDesc), len(file_serviceatlas_v1_releases_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_releases_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_releases_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_releases_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_releases_proto = out.File
+	file_serviceatlas_v1_releases_proto_goTypes = nil
+	file_serviceatlas_v1_releases_proto_depIdxs = nil
+}
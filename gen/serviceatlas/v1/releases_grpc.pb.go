@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: serviceatlas/v1/releases.proto
+
+package serviceatlasv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReleasesService_CreateRelease_FullMethodName       = "/serviceatlas.v1.ReleasesService/CreateRelease"
+	ReleasesService_ListReleases_FullMethodName        = "/serviceatlas.v1.ReleasesService/ListReleases"
+	ReleasesService_ListReleasesInRange_FullMethodName = "/serviceatlas.v1.ReleasesService/ListReleasesInRange"
+)
+
+// ReleasesServiceClient is the client API for ReleasesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReleasesService records and lists service releases.
+type ReleasesServiceClient interface {
+	CreateRelease(ctx context.Context, in *CreateReleaseRequest, opts ...grpc.CallOption) (*CreateReleaseResponse, error)
+	ListReleases(ctx context.Context, in *ListReleasesRequest, opts ...grpc.CallOption) (*ListReleasesResponse, error)
+	// ListReleasesInRange lists the releases of every service between two dates.
+	ListReleasesInRange(ctx context.Context, in *ListReleasesInRangeRequest, opts ...grpc.CallOption) (*ListReleasesInRangeResponse, error)
+}
+
+type releasesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReleasesServiceClient(cc grpc.ClientConnInterface) ReleasesServiceClient {
+	return &releasesServiceClient{cc}
+}
+
+func (c *releasesServiceClient) CreateRelease(ctx context.Context, in *CreateReleaseRequest, opts ...grpc.CallOption) (*CreateReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReleaseResponse)
+	err := c.cc.Invoke(ctx, ReleasesService_CreateRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *releasesServiceClient) ListReleases(ctx context.Context, in *ListReleasesRequest, opts ...grpc.CallOption) (*ListReleasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReleasesResponse)
+	err := c.cc.Invoke(ctx, ReleasesService_ListReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *releasesServiceClient) ListReleasesInRange(ctx context.Context, in *ListReleasesInRangeRequest, opts ...grpc.CallOption) (*ListReleasesInRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReleasesInRangeResponse)
+	err := c.cc.Invoke(ctx, ReleasesService_ListReleasesInRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReleasesServiceServer is the server API for ReleasesService service.
+// All implementations must embed UnimplementedReleasesServiceServer
+// for forward compatibility.
+//
+// ReleasesService records and lists service releases.
+type ReleasesServiceServer interface {
+	CreateRelease(context.Context, *CreateReleaseRequest) (*CreateReleaseResponse, error)
+	ListReleases(context.Context, *ListReleasesRequest) (*ListReleasesResponse, error)
+	// ListReleasesInRange lists the releases of every service between two dates.
+	ListReleasesInRange(context.Context, *ListReleasesInRangeRequest) (*ListReleasesInRangeResponse, error)
+	mustEmbedUnimplementedReleasesServiceServer()
+}
+
+// UnimplementedReleasesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReleasesServiceServer struct{}
+
+func (UnimplementedReleasesServiceServer) CreateRelease(context.Context, *CreateReleaseRequest) (*CreateReleaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRelease not implemented")
+}
+func (UnimplementedReleasesServiceServer) ListReleases(context.Context, *ListReleasesRequest) (*ListReleasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (UnimplementedReleasesServiceServer) ListReleasesInRange(context.Context, *ListReleasesInRangeRequest) (*ListReleasesInRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReleasesInRange not implemented")
+}
+func (UnimplementedReleasesServiceServer) mustEmbedUnimplementedReleasesServiceServer() {}
+func (UnimplementedReleasesServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReleasesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReleasesServiceServer will
+// result in compilation errors.
+type UnsafeReleasesServiceServer interface {
+	mustEmbedUnimplementedReleasesServiceServer()
+}
+
+func RegisterReleasesServiceServer(s grpc.ServiceRegistrar, srv ReleasesServiceServer) {
+	// If the following call panics, it indicates UnimplementedReleasesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReleasesService_ServiceDesc, srv)
+}
+
+func _ReleasesService_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleasesServiceServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleasesService_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleasesServiceServer).CreateRelease(ctx, req.(*CreateReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReleasesService_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleasesServiceServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleasesService_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleasesServiceServer).ListReleases(ctx, req.(*ListReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReleasesService_ListReleasesInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleasesServiceServer).ListReleasesInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleasesService_ListReleasesInRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleasesServiceServer).ListReleasesInRange(ctx, req.(*ListReleasesInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReleasesService_ServiceDesc is the grpc.ServiceDesc for ReleasesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReleasesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "serviceatlas.v1.ReleasesService",
+	HandlerType: (*ReleasesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRelease",
+			Handler:    _ReleasesService_CreateRelease_Handler,
+		},
+		{
+			MethodName: "ListReleases",
+			Handler:    _ReleasesService_ListReleases_Handler,
+		},
+		{
+			MethodName: "ListReleasesInRange",
+			Handler:    _ReleasesService_ListReleasesInRange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serviceatlas/v1/releases.proto",
+}
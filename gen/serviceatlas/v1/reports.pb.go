@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/reports.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetServiceRiskReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRiskReportRequest) Reset() {
+	*x = GetServiceRiskReportRequest{}
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRiskReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRiskReportRequest) ProtoMessage() {}
+
+func (x *GetServiceRiskReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRiskReportRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRiskReportRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_reports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetServiceRiskReportRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type GetServiceRiskReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// debt_count is keyed by debt type.
+	DebtCount      map[string]int64 `protobuf:"bytes,1,rep,name=debt_count,json=debtCount,proto3" json:"debt_count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	DependentCount int64            `protobuf:"varint,2,opt,name=dependent_count,json=dependentCount,proto3" json:"dependent_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetServiceRiskReportResponse) Reset() {
+	*x = GetServiceRiskReportResponse{}
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRiskReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRiskReportResponse) ProtoMessage() {}
+
+func (x *GetServiceRiskReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRiskReportResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceRiskReportResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_reports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServiceRiskReportResponse) GetDebtCount() map[string]int64 {
+	if x != nil {
+		return x.DebtCount
+	}
+	return nil
+}
+
+func (x *GetServiceRiskReportResponse) GetDependentCount() int64 {
+	if x != nil {
+		return x.DependentCount
+	}
+	return 0
+}
+
+type GetDebtCountByServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtCountByServiceRequest) Reset() {
+	*x = GetDebtCountByServiceRequest{}
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtCountByServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtCountByServiceRequest) ProtoMessage() {}
+
+func (x *GetDebtCountByServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtCountByServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtCountByServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_reports_proto_rawDescGZIP(), []int{2}
+}
+
+type GetDebtCountByServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*ServiceDebtCount    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDebtCountByServiceResponse) Reset() {
+	*x = GetDebtCountByServiceResponse{}
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDebtCountByServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebtCountByServiceResponse) ProtoMessage() {}
+
+func (x *GetDebtCountByServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebtCountByServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtCountByServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_reports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDebtCountByServiceResponse) GetServices() []*ServiceDebtCount {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ServiceDebtCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDebtCount) Reset() {
+	*x = ServiceDebtCount{}
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDebtCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDebtCount) ProtoMessage() {}
+
+func (x *ServiceDebtCount) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_reports_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDebtCount.ProtoReflect.Descriptor instead.
+func (*ServiceDebtCount) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_reports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServiceDebtCount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceDebtCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceDebtCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_serviceatlas_v1_reports_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_reports_proto_rawDesc = "" +
+	"\n" +
+	"\x1dserviceatlas/v1/reports.proto\x12\x0fserviceatlas.v1\"<\n" +
+	"\x1bGetServiceRiskReportRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"\xe2\x01\n" +
+	"\x1cGetServiceRiskReportResponse\x12[\n" +
+	"\n" +
+	"debt_count\x18\x01 \x03(\v2<.serviceatlas.v1.GetServiceRiskReportResponse.DebtCountEntryR\tdebtCount\x12'\n" +
+	"\x0fdependent_count\x18\x02 \x01(\x03R\x0edependentCount\x1a<\n" +
+	"\x0eDebtCountEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x1e\n" +
+	"\x1cGetDebtCountByServiceRequest\"^\n" +
+	"\x1dGetDebtCountByServiceResponse\x12=\n" +
+	"\bservices\x18\x01 \x03(\v2!.serviceatlas.v1.ServiceDebtCountR\bservices\"L\n" +
+	"\x10ServiceDebtCount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count2\xfd\x01\n" +
+	"\x0eReportsService\x12s\n" +
+	"\x14GetServiceRiskReport\x12,.serviceatlas.v1.GetServiceRiskReportRequest\x1a-.serviceatlas.v1.GetServiceRiskReportResponse\x12v\n" +
+	"\x15GetDebtCountByService\x12-.serviceatlas.v1.GetDebtCountByServiceRequest\x1a..serviceatlas.v1.GetDebtCountByServiceResponseB\xb2\x01\n" +
+	"\x13com.serviceatlas.v1B\fReportsProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_reports_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_reports_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_reports_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_reports_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_reports_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_reports_proto_rawDesc), len(file_serviceatlas_v1_reports_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_reports_proto_rawDescData
+}
+
+var file_serviceatlas_v1_reports_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_serviceatlas_v1_reports_proto_goTypes = []any{
+	(*GetServiceRiskReportRequest)(nil),   // 0: serviceatlas.v1.GetServiceRiskReportRequest
+	(*GetServiceRiskReportResponse)(nil),  // 1: serviceatlas.v1.GetServiceRiskReportResponse
+	(*GetDebtCountByServiceRequest)(nil),  // 2: serviceatlas.v1.GetDebtCountByServiceRequest
+	(*GetDebtCountByServiceResponse)(nil), // 3: serviceatlas.v1.GetDebtCountByServiceResponse
+	(*ServiceDebtCount)(nil),              // 4: serviceatlas.v1.ServiceDebtCount
+	nil,                                   // 5: serviceatlas.v1.GetServiceRiskReportResponse.DebtCountEntry
+}
+var file_serviceatlas_v1_reports_proto_depIdxs = []int32{
+	5, // 0: serviceatlas.v1.GetServiceRiskReportResponse.debt_count:type_name -> serviceatlas.v1.GetServiceRiskReportResponse.DebtCountEntry
+	4, // 1: serviceatlas.v1.GetDebtCountByServiceResponse.services:type_name -> serviceatlas.v1.ServiceDebtCount
+	0, // 2: serviceatlas.v1.ReportsService.GetServiceRiskReport:input_type -> serviceatlas.v1.GetServiceRiskReportRequest
+	2, // 3: serviceatlas.v1.ReportsService.GetDebtCountByService:input_type -> serviceatlas.v1.GetDebtCountByServiceRequest
+	1, // 4: serviceatlas.v1.ReportsService.GetServiceRiskReport:output_type -> serviceatlas.v1.GetServiceRiskReportResponse
+	3, // 5: serviceatlas.v1.ReportsService.GetDebtCountByService:output_type -> serviceatlas.v1.GetDebtCountByServiceResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_reports_proto_init() }
+func file_serviceatlas_v1_reports_proto_init() {
+	if File_serviceatlas_v1_reports_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_reports_proto_rawDesc), len(file_serviceatlas_v1_reports_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_reports_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_reports_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_reports_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_reports_proto = out.File
+	file_serviceatlas_v1_reports_proto_goTypes = nil
+	file_serviceatlas_v1_reports_proto_depIdxs = nil
+}
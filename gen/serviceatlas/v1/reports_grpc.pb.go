@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: serviceatlas/v1/reports.proto
+
+package serviceatlasv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReportsService_GetServiceRiskReport_FullMethodName  = "/serviceatlas.v1.ReportsService/GetServiceRiskReport"
+	ReportsService_GetDebtCountByService_FullMethodName = "/serviceatlas.v1.ReportsService/GetDebtCountByService"
+)
+
+// ReportsServiceClient is the client API for ReportsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReportsService aggregates catalog data into reports.
+type ReportsServiceClient interface {
+	GetServiceRiskReport(ctx context.Context, in *GetServiceRiskReportRequest, opts ...grpc.CallOption) (*GetServiceRiskReportResponse, error)
+	// GetDebtCountByService counts the unresolved debt items of each service.
+	GetDebtCountByService(ctx context.Context, in *GetDebtCountByServiceRequest, opts ...grpc.CallOption) (*GetDebtCountByServiceResponse, error)
+}
+
+type reportsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportsServiceClient(cc grpc.ClientConnInterface) ReportsServiceClient {
+	return &reportsServiceClient{cc}
+}
+
+func (c *reportsServiceClient) GetServiceRiskReport(ctx context.Context, in *GetServiceRiskReportRequest, opts ...grpc.CallOption) (*GetServiceRiskReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceRiskReportResponse)
+	err := c.cc.Invoke(ctx, ReportsService_GetServiceRiskReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportsServiceClient) GetDebtCountByService(ctx context.Context, in *GetDebtCountByServiceRequest, opts ...grpc.CallOption) (*GetDebtCountByServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtCountByServiceResponse)
+	err := c.cc.Invoke(ctx, ReportsService_GetDebtCountByService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportsServiceServer is the server API for ReportsService service.
+// All implementations must embed UnimplementedReportsServiceServer
+// for forward compatibility.
+//
+// ReportsService aggregates catalog data into reports.
+type ReportsServiceServer interface {
+	GetServiceRiskReport(context.Context, *GetServiceRiskReportRequest) (*GetServiceRiskReportResponse, error)
+	// GetDebtCountByService counts the unresolved debt items of each service.
+	GetDebtCountByService(context.Context, *GetDebtCountByServiceRequest) (*GetDebtCountByServiceResponse, error)
+	mustEmbedUnimplementedReportsServiceServer()
+}
+
+// UnimplementedReportsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportsServiceServer struct{}
+
+func (UnimplementedReportsServiceServer) GetServiceRiskReport(context.Context, *GetServiceRiskReportRequest) (*GetServiceRiskReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetServiceRiskReport not implemented")
+}
+func (UnimplementedReportsServiceServer) GetDebtCountByService(context.Context, *GetDebtCountByServiceRequest) (*GetDebtCountByServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebtCountByService not implemented")
+}
+func (UnimplementedReportsServiceServer) mustEmbedUnimplementedReportsServiceServer() {}
+func (UnimplementedReportsServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeReportsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportsServiceServer will
+// result in compilation errors.
+type UnsafeReportsServiceServer interface {
+	mustEmbedUnimplementedReportsServiceServer()
+}
+
+func RegisterReportsServiceServer(s grpc.ServiceRegistrar, srv ReportsServiceServer) {
+	// If the following call panics, it indicates UnimplementedReportsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportsService_ServiceDesc, srv)
+}
+
+func _ReportsService_GetServiceRiskReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRiskReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).GetServiceRiskReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_GetServiceRiskReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).GetServiceRiskReport(ctx, req.(*GetServiceRiskReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportsService_GetDebtCountByService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtCountByServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportsServiceServer).GetDebtCountByService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportsService_GetDebtCountByService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportsServiceServer).GetDebtCountByService(ctx, req.(*GetDebtCountByServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportsService_ServiceDesc is the grpc.ServiceDesc for ReportsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "serviceatlas.v1.ReportsService",
+	HandlerType: (*ReportsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetServiceRiskReport",
+			Handler:    _ReportsService_GetServiceRiskReport_Handler,
+		},
+		{
+			MethodName: "GetDebtCountByService",
+			Handler:    _ReportsService_GetDebtCountByService_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serviceatlas/v1/reports.proto",
+}
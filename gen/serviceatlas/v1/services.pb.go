@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/services.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListServicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  *Page                  `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// filter keeps only the services matching every field set.
+	Filter *ServiceFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// sort is one of name, created, updated, dependents or debt, prefixed with - to reverse. Newest first when empty.
+	Sort          string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListServicesRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListServicesRequest) GetFilter() *ServiceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListServicesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// ServiceFilter narrows a list of services like the query parameters of GET /services.
+type ServiceFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// team is the id or name of a team owning the service.
+	Team      string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Lifecycle string `protobuf:"bytes,3,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	// tags must all be on the service.
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// has_open_debt keeps only services with debt that is not remediated when true, and only those without when false.
+	HasOpenDebt   *bool                  `protobuf:"varint,5,opt,name=has_open_debt,json=hasOpenDebt,proto3,oneof" json:"has_open_debt,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// updated_after and updated_before bound when the service was last updated, or created when it never was.
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceFilter) Reset() {
+	*x = ServiceFilter{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceFilter) ProtoMessage() {}
+
+func (x *ServiceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceFilter.ProtoReflect.Descriptor instead.
+func (*ServiceFilter) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceFilter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ServiceFilter) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *ServiceFilter) GetLifecycle() string {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return ""
+}
+
+func (x *ServiceFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetHasOpenDebt() bool {
+	if x != nil && x.HasOpenDebt != nil {
+		return *x.HasOpenDebt
+	}
+	return false
+}
+
+func (x *ServiceFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *ServiceFilter) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+type ListServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesResponse) Reset() {
+	*x = ListServicesResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesResponse) ProtoMessage() {}
+
+func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type GetServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRequest) Reset() {
+	*x = GetServiceRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRequest) ProtoMessage() {}
+
+func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetServiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceResponse) Reset() {
+	*x = GetServiceResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceResponse) ProtoMessage() {}
+
+func (x *GetServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetServiceResponse) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type CreateServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Lifecycle     string                 `protobuf:"bytes,5,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceRequest) Reset() {
+	*x = CreateServiceRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceRequest) ProtoMessage() {}
+
+func (x *CreateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetLifecycle() string {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return ""
+}
+
+func (x *CreateServiceRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServiceResponse) Reset() {
+	*x = CreateServiceResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceResponse) ProtoMessage() {}
+
+func (x *CreateServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateServiceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *Service               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceRequest) Reset() {
+	*x = UpdateServiceRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceRequest) ProtoMessage() {}
+
+func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateServiceRequest) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type UpdateServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceResponse) Reset() {
+	*x = UpdateServiceResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceResponse) ProtoMessage() {}
+
+func (x *UpdateServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{8}
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteServiceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceResponse) Reset() {
+	*x = DeleteServiceResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceResponse) ProtoMessage() {}
+
+func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{10}
+}
+
+type SearchServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchServicesRequest) Reset() {
+	*x = SearchServicesRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServicesRequest) ProtoMessage() {}
+
+func (x *SearchServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServicesRequest.ProtoReflect.Descriptor instead.
+func (*SearchServicesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchServicesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchServicesResponse) Reset() {
+	*x = SearchServicesResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServicesResponse) ProtoMessage() {}
+
+func (x *SearchServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServicesResponse.ProtoReflect.Descriptor instead.
+func (*SearchServicesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ListServiceTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceTeamsRequest) Reset() {
+	*x = ListServiceTeamsRequest{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceTeamsRequest) ProtoMessage() {}
+
+func (x *ListServiceTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListServiceTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListServiceTeamsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type ListServiceTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServiceTeamsResponse) Reset() {
+	*x = ListServiceTeamsResponse{}
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServiceTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServiceTeamsResponse) ProtoMessage() {}
+
+func (x *ListServiceTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_services_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServiceTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListServiceTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_services_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListServiceTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+var File_serviceatlas_v1_services_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_services_proto_rawDesc = "" +
+	"\n" +
+	"\x1eserviceatlas/v1/services.proto\x12\x0fserviceatlas.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bserviceatlas/v1/types.proto\"\x8c\x01\n" +
+	"\x13ListServicesRequest\x12)\n" +
+	"\x04page\x18\x01 \x01(\v2\x15.serviceatlas.v1.PageR\x04page\x126\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1e.serviceatlas.v1.ServiceFilterR\x06filter\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\"\xac\x03\n" +
+	"\rServiceFilter\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04team\x18\x02 \x01(\tR\x04team\x12\x1c\n" +
+	"\tlifecycle\x18\x03 \x01(\tR\tlifecycle\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12'\n" +
+	"\rhas_open_debt\x18\x05 \x01(\bH\x00R\vhasOpenDebt\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\rupdated_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fupdatedAfter\x12A\n" +
+	"\x0eupdated_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rupdatedBeforeB\x10\n" +
+	"\x0e_has_open_debt\"L\n" +
+	"\x14ListServicesResponse\x124\n" +
+	"\bservices\x18\x01 \x03(\v2\x18.serviceatlas.v1.ServiceR\bservices\"#\n" +
+	"\x11GetServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x12GetServiceResponse\x122\n" +
+	"\aservice\x18\x01 \x01(\v2\x18.serviceatlas.v1.ServiceR\aservice\"\xa4\x01\n" +
+	"\x14CreateServiceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1c\n" +
+	"\tlifecycle\x18\x05 \x01(\tR\tlifecycle\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"'\n" +
+	"\x15CreateServiceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x14UpdateServiceRequest\x122\n" +
+	"\aservice\x18\x01 \x01(\v2\x18.serviceatlas.v1.ServiceR\aservice\"\x17\n" +
+	"\x15UpdateServiceResponse\"&\n" +
+	"\x14DeleteServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteServiceResponse\"-\n" +
+	"\x15SearchServicesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"N\n" +
+	"\x16SearchServicesResponse\x124\n" +
+	"\bservices\x18\x01 \x03(\v2\x18.serviceatlas.v1.ServiceR\bservices\"8\n" +
+	"\x17ListServiceTeamsRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\"G\n" +
+	"\x18ListServiceTeamsResponse\x12+\n" +
+	"\x05teams\x18\x01 \x03(\v2\x15.serviceatlas.v1.TeamR\x05teams2\xb1\x05\n" +
+	"\x0fServicesService\x12[\n" +
+	"\fListServices\x12$.serviceatlas.v1.ListServicesRequest\x1a%.serviceatlas.v1.ListServicesResponse\x12U\n" +
+	"\n" +
+	"GetService\x12\".serviceatlas.v1.GetServiceRequest\x1a#.serviceatlas.v1.GetServiceResponse\x12^\n" +
+	"\rCreateService\x12%.serviceatlas.v1.CreateServiceRequest\x1a&.serviceatlas.v1.CreateServiceResponse\x12^\n" +
+	"\rUpdateService\x12%.serviceatlas.v1.UpdateServiceRequest\x1a&.serviceatlas.v1.UpdateServiceResponse\x12^\n" +
+	"\rDeleteService\x12%.serviceatlas.v1.DeleteServiceRequest\x1a&.serviceatlas.v1.DeleteServiceResponse\x12a\n" +
+	"\x0eSearchServices\x12&.serviceatlas.v1.SearchServicesRequest\x1a'.serviceatlas.v1.SearchServicesResponse\x12g\n" +
+	"\x10ListServiceTeams\x12(.serviceatlas.v1.ListServiceTeamsRequest\x1a).serviceatlas.v1.ListServiceTeamsResponseB\xb3\x01\n" +
+	"\x13com.serviceatlas.v1B\rServicesProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_services_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_services_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_services_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_services_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_services_proto_rawDesc), len(file_serviceatlas_v1_services_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_services_proto_rawDescData
+}
+
+var file_serviceatlas_v1_services_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_serviceatlas_v1_services_proto_goTypes = []any{
+	(*ListServicesRequest)(nil),      // 0: serviceatlas.v1.ListServicesRequest
+	(*ServiceFilter)(nil),            // 1: serviceatlas.v1.ServiceFilter
+	(*ListServicesResponse)(nil),     // 2: serviceatlas.v1.ListServicesResponse
+	(*GetServiceRequest)(nil),        // 3: serviceatlas.v1.GetServiceRequest
+	(*GetServiceResponse)(nil),       // 4: serviceatlas.v1.GetServiceResponse
+	(*CreateServiceRequest)(nil),     // 5: serviceatlas.v1.CreateServiceRequest
+	(*CreateServiceResponse)(nil),    // 6: serviceatlas.v1.CreateServiceResponse
+	(*UpdateServiceRequest)(nil),     // 7: serviceatlas.v1.UpdateServiceRequest
+	(*UpdateServiceResponse)(nil),    // 8: serviceatlas.v1.UpdateServiceResponse
+	(*DeleteServiceRequest)(nil),     // 9: serviceatlas.v1.DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),    // 10: serviceatlas.v1.DeleteServiceResponse
+	(*SearchServicesRequest)(nil),    // 11: serviceatlas.v1.SearchServicesRequest
+	(*SearchServicesResponse)(nil),   // 12: serviceatlas.v1.SearchServicesResponse
+	(*ListServiceTeamsRequest)(nil),  // 13: serviceatlas.v1.ListServiceTeamsRequest
+	(*ListServiceTeamsResponse)(nil), // 14: serviceatlas.v1.ListServiceTeamsResponse
+	(*Page)(nil),                     // 15: serviceatlas.v1.Page
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*Service)(nil),                  // 17: serviceatlas.v1.Service
+	(*Team)(nil),                     // 18: serviceatlas.v1.Team
+}
+var file_serviceatlas_v1_services_proto_depIdxs = []int32{
+	15, // 0: serviceatlas.v1.ListServicesRequest.page:type_name -> serviceatlas.v1.Page
+	1,  // 1: serviceatlas.v1.ListServicesRequest.filter:type_name -> serviceatlas.v1.ServiceFilter
+	16, // 2: serviceatlas.v1.ServiceFilter.created_after:type_name -> google.protobuf.Timestamp
+	16, // 3: serviceatlas.v1.ServiceFilter.created_before:type_name -> google.protobuf.Timestamp
+	16, // 4: serviceatlas.v1.ServiceFilter.updated_after:type_name -> google.protobuf.Timestamp
+	16, // 5: serviceatlas.v1.ServiceFilter.updated_before:type_name -> google.protobuf.Timestamp
+	17, // 6: serviceatlas.v1.ListServicesResponse.services:type_name -> serviceatlas.v1.Service
+	17, // 7: serviceatlas.v1.GetServiceResponse.service:type_name -> serviceatlas.v1.Service
+	17, // 8: serviceatlas.v1.UpdateServiceRequest.service:type_name -> serviceatlas.v1.Service
+	17, // 9: serviceatlas.v1.SearchServicesResponse.services:type_name -> serviceatlas.v1.Service
+	18, // 10: serviceatlas.v1.ListServiceTeamsResponse.teams:type_name -> serviceatlas.v1.Team
+	0,  // 11: serviceatlas.v1.ServicesService.ListServices:input_type -> serviceatlas.v1.ListServicesRequest
+	3,  // 12: serviceatlas.v1.ServicesService.GetService:input_type -> serviceatlas.v1.GetServiceRequest
+	5,  // 13: serviceatlas.v1.ServicesService.CreateService:input_type -> serviceatlas.v1.CreateServiceRequest
+	7,  // 14: serviceatlas.v1.ServicesService.UpdateService:input_type -> serviceatlas.v1.UpdateServiceRequest
+	9,  // 15: serviceatlas.v1.ServicesService.DeleteService:input_type -> serviceatlas.v1.DeleteServiceRequest
+	11, // 16: serviceatlas.v1.ServicesService.SearchServices:input_type -> serviceatlas.v1.SearchServicesRequest
+	13, // 17: serviceatlas.v1.ServicesService.ListServiceTeams:input_type -> serviceatlas.v1.ListServiceTeamsRequest
+	2,  // 18: serviceatlas.v1.ServicesService.ListServices:output_type -> serviceatlas.v1.ListServicesResponse
+	4,  // 19: serviceatlas.v1.ServicesService.GetService:output_type -> serviceatlas.v1.GetServiceResponse
+	6,  // 20: serviceatlas.v1.ServicesService.CreateService:output_type -> serviceatlas.v1.CreateServiceResponse
+	8,  // 21: serviceatlas.v1.ServicesService.UpdateService:output_type -> serviceatlas.v1.UpdateServiceResponse
+	10, // 22: serviceatlas.v1.ServicesService.DeleteService:output_type -> serviceatlas.v1.DeleteServiceResponse
+	12, // 23: serviceatlas.v1.ServicesService.SearchServices:output_type -> serviceatlas.v1.SearchServicesResponse
+	14, // 24: serviceatlas.v1.ServicesService.ListServiceTeams:output_type -> serviceatlas.v1.ListServiceTeamsResponse
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_services_proto_init() }
+func file_serviceatlas_v1_services_proto_init() {
+	if File_serviceatlas_v1_services_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	file_serviceatlas_v1_services_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_services_proto_rawDesc), len(file_serviceatlas_v1_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_services_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_services_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_services_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_services_proto = out.File
+	file_serviceatlas_v1_services_proto_goTypes = nil
+	file_serviceatlas_v1_services_proto_depIdxs = nil
+}
@@ -0,0 +1,850 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: serviceatlas/v1/teams.proto
+
+package serviceatlasv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *Page                  `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListTeamsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTeamResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type UpdateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamResponse) Reset() {
+	*x = UpdateTeamResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamResponse) ProtoMessage() {}
+
+func (x *UpdateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{7}
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{9}
+}
+
+type AddTeamServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamServiceRequest) Reset() {
+	*x = AddTeamServiceRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamServiceRequest) ProtoMessage() {}
+
+func (x *AddTeamServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamServiceRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddTeamServiceRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *AddTeamServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type AddTeamServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamServiceResponse) Reset() {
+	*x = AddTeamServiceResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamServiceResponse) ProtoMessage() {}
+
+func (x *AddTeamServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamServiceResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{11}
+}
+
+type RemoveTeamServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ServiceId     string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamServiceRequest) Reset() {
+	*x = RemoveTeamServiceRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamServiceRequest) ProtoMessage() {}
+
+func (x *RemoveTeamServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamServiceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamServiceRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveTeamServiceRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *RemoveTeamServiceRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+type RemoveTeamServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamServiceResponse) Reset() {
+	*x = RemoveTeamServiceResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamServiceResponse) ProtoMessage() {}
+
+func (x *RemoveTeamServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamServiceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamServiceResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{13}
+}
+
+type ListTeamServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamServicesRequest) Reset() {
+	*x = ListTeamServicesRequest{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamServicesRequest) ProtoMessage() {}
+
+func (x *ListTeamServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamServicesRequest) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTeamServicesRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+type ListTeamServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamServicesResponse) Reset() {
+	*x = ListTeamServicesResponse{}
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamServicesResponse) ProtoMessage() {}
+
+func (x *ListTeamServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_serviceatlas_v1_teams_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamServicesResponse) Descriptor() ([]byte, []int) {
+	return file_serviceatlas_v1_teams_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTeamServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+var File_serviceatlas_v1_teams_proto protoreflect.FileDescriptor
+
+const file_serviceatlas_v1_teams_proto_rawDesc = "" +
+	"\n" +
+	"\x1bserviceatlas/v1/teams.proto\x12\x0fserviceatlas.v1\x1a\x1bserviceatlas/v1/types.proto\"=\n" +
+	"\x10ListTeamsRequest\x12)\n" +
+	"\x04page\x18\x01 \x01(\v2\x15.serviceatlas.v1.PageR\x04page\"@\n" +
+	"\x11ListTeamsResponse\x12+\n" +
+	"\x05teams\x18\x01 \x03(\v2\x15.serviceatlas.v1.TeamR\x05teams\" \n" +
+	"\x0eGetTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x0fGetTeamResponse\x12)\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.serviceatlas.v1.TeamR\x04team\"'\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"$\n" +
+	"\x12CreateTeamResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x11UpdateTeamRequest\x12)\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.serviceatlas.v1.TeamR\x04team\"\x14\n" +
+	"\x12UpdateTeamResponse\"#\n" +
+	"\x11DeleteTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteTeamResponse\"O\n" +
+	"\x15AddTeamServiceRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\"\x18\n" +
+	"\x16AddTeamServiceResponse\"R\n" +
+	"\x18RemoveTeamServiceRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\"\x1b\n" +
+	"\x19RemoveTeamServiceResponse\"2\n" +
+	"\x17ListTeamServicesRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\"P\n" +
+	"\x18ListTeamServicesResponse\x124\n" +
+	"\bservices\x18\x01 \x03(\v2\x18.serviceatlas.v1.ServiceR\bservices2\xed\x05\n" +
+	"\fTeamsService\x12R\n" +
+	"\tListTeams\x12!.serviceatlas.v1.ListTeamsRequest\x1a\".serviceatlas.v1.ListTeamsResponse\x12L\n" +
+	"\aGetTeam\x12\x1f.serviceatlas.v1.GetTeamRequest\x1a .serviceatlas.v1.GetTeamResponse\x12U\n" +
+	"\n" +
+	"CreateTeam\x12\".serviceatlas.v1.CreateTeamRequest\x1a#.serviceatlas.v1.CreateTeamResponse\x12U\n" +
+	"\n" +
+	"UpdateTeam\x12\".serviceatlas.v1.UpdateTeamRequest\x1a#.serviceatlas.v1.UpdateTeamResponse\x12U\n" +
+	"\n" +
+	"DeleteTeam\x12\".serviceatlas.v1.DeleteTeamRequest\x1a#.serviceatlas.v1.DeleteTeamResponse\x12a\n" +
+	"\x0eAddTeamService\x12&.serviceatlas.v1.AddTeamServiceRequest\x1a'.serviceatlas.v1.AddTeamServiceResponse\x12j\n" +
+	"\x11RemoveTeamService\x12).serviceatlas.v1.RemoveTeamServiceRequest\x1a*.serviceatlas.v1.RemoveTeamServiceResponse\x12g\n" +
+	"\x10ListTeamServices\x12(.serviceatlas.v1.ListTeamServicesRequest\x1a).serviceatlas.v1.ListTeamServicesResponseB\xb0\x01\n" +
+	"\x13com.serviceatlas.v1B\n" +
+	"TeamsProtoP\x01Z0service-atlas/gen/serviceatlas/v1;serviceatlasv1\xa2\x02\x03SXX\xaa\x02\x0fServiceatlas.V1\xca\x02\x0fServiceatlas\\V1\xe2\x02\x1bServiceatlas\\V1\\GPBMetadata\xea\x02\x10Serviceatlas::V1b\x06proto3"
+
+var (
+	file_serviceatlas_v1_teams_proto_rawDescOnce sync.Once
+	file_serviceatlas_v1_teams_proto_rawDescData []byte
+)
+
+func file_serviceatlas_v1_teams_proto_rawDescGZIP() []byte {
+	file_serviceatlas_v1_teams_proto_rawDescOnce.Do(func() {
+		file_serviceatlas_v1_teams_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_teams_proto_rawDesc), len(file_serviceatlas_v1_teams_proto_rawDesc)))
+	})
+	return file_serviceatlas_v1_teams_proto_rawDescData
+}
+
+var file_serviceatlas_v1_teams_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_serviceatlas_v1_teams_proto_goTypes = []any{
+	(*ListTeamsRequest)(nil),          // 0: serviceatlas.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),         // 1: serviceatlas.v1.ListTeamsResponse
+	(*GetTeamRequest)(nil),            // 2: serviceatlas.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),           // 3: serviceatlas.v1.GetTeamResponse
+	(*CreateTeamRequest)(nil),         // 4: serviceatlas.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),        // 5: serviceatlas.v1.CreateTeamResponse
+	(*UpdateTeamRequest)(nil),         // 6: serviceatlas.v1.UpdateTeamRequest
+	(*UpdateTeamResponse)(nil),        // 7: serviceatlas.v1.UpdateTeamResponse
+	(*DeleteTeamRequest)(nil),         // 8: serviceatlas.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),        // 9: serviceatlas.v1.DeleteTeamResponse
+	(*AddTeamServiceRequest)(nil),     // 10: serviceatlas.v1.AddTeamServiceRequest
+	(*AddTeamServiceResponse)(nil),    // 11: serviceatlas.v1.AddTeamServiceResponse
+	(*RemoveTeamServiceRequest)(nil),  // 12: serviceatlas.v1.RemoveTeamServiceRequest
+	(*RemoveTeamServiceResponse)(nil), // 13: serviceatlas.v1.RemoveTeamServiceResponse
+	(*ListTeamServicesRequest)(nil),   // 14: serviceatlas.v1.ListTeamServicesRequest
+	(*ListTeamServicesResponse)(nil),  // 15: serviceatlas.v1.ListTeamServicesResponse
+	(*Page)(nil),                      // 16: serviceatlas.v1.Page
+	(*Team)(nil),                      // 17: serviceatlas.v1.Team
+	(*Service)(nil),                   // 18: serviceatlas.v1.Service
+}
+var file_serviceatlas_v1_teams_proto_depIdxs = []int32{
+	16, // 0: serviceatlas.v1.ListTeamsRequest.page:type_name -> serviceatlas.v1.Page
+	17, // 1: serviceatlas.v1.ListTeamsResponse.teams:type_name -> serviceatlas.v1.Team
+	17, // 2: serviceatlas.v1.GetTeamResponse.team:type_name -> serviceatlas.v1.Team
+	17, // 3: serviceatlas.v1.UpdateTeamRequest.team:type_name -> serviceatlas.v1.Team
+	18, // 4: serviceatlas.v1.ListTeamServicesResponse.services:type_name -> serviceatlas.v1.Service
+	0,  // 5: serviceatlas.v1.TeamsService.ListTeams:input_type -> serviceatlas.v1.ListTeamsRequest
+	2,  // 6: serviceatlas.v1.TeamsService.GetTeam:input_type -> serviceatlas.v1.GetTeamRequest
+	4,  // 7: serviceatlas.v1.TeamsService.CreateTeam:input_type -> serviceatlas.v1.CreateTeamRequest
+	6,  // 8: serviceatlas.v1.TeamsService.UpdateTeam:input_type -> serviceatlas.v1.UpdateTeamRequest
+	8,  // 9: serviceatlas.v1.TeamsService.DeleteTeam:input_type -> serviceatlas.v1.DeleteTeamRequest
+	10, // 10: serviceatlas.v1.TeamsService.AddTeamService:input_type -> serviceatlas.v1.AddTeamServiceRequest
+	12, // 11: serviceatlas.v1.TeamsService.RemoveTeamService:input_type -> serviceatlas.v1.RemoveTeamServiceRequest
+	14, // 12: serviceatlas.v1.TeamsService.ListTeamServices:input_type -> serviceatlas.v1.ListTeamServicesRequest
+	1,  // 13: serviceatlas.v1.TeamsService.ListTeams:output_type -> serviceatlas.v1.ListTeamsResponse
+	3,  // 14: serviceatlas.v1.TeamsService.GetTeam:output_type -> serviceatlas.v1.GetTeamResponse
+	5,  // 15: serviceatlas.v1.TeamsService.CreateTeam:output_type -> serviceatlas.v1.CreateTeamResponse
+	7,  // 16: serviceatlas.v1.TeamsService.UpdateTeam:output_type -> serviceatlas.v1.UpdateTeamResponse
+	9,  // 17: serviceatlas.v1.TeamsService.DeleteTeam:output_type -> serviceatlas.v1.DeleteTeamResponse
+	11, // 18: serviceatlas.v1.TeamsService.AddTeamService:output_type -> serviceatlas.v1.AddTeamServiceResponse
+	13, // 19: serviceatlas.v1.TeamsService.RemoveTeamService:output_type -> serviceatlas.v1.RemoveTeamServiceResponse
+	15, // 20: serviceatlas.v1.TeamsService.ListTeamServices:output_type -> serviceatlas.v1.ListTeamServicesResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_serviceatlas_v1_teams_proto_init() }
+func file_serviceatlas_v1_teams_proto_init() {
+	if File_serviceatlas_v1_teams_proto != nil {
+		return
+	}
+	file_serviceatlas_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_serviceatlas_v1_teams_proto_rawDesc), len(file_serviceatlas_v1_teams_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_serviceatlas_v1_teams_proto_goTypes,
+		DependencyIndexes: file_serviceatlas_v1_teams_proto_depIdxs,
+		MessageInfos:      file_serviceatlas_v1_teams_proto_msgTypes,
+	}.Build()
+	File_serviceatlas_v1_teams_proto = out.File
+	file_serviceatlas_v1_teams_proto_goTypes = nil
+	file_serviceatlas_v1_teams_proto_depIdxs = nil
+}
@@ -31,3 +31,8 @@ var DebtTypes = StringEnum{
 var DebtStatus = StringEnum{
 	members: []string{"pending", "remediated", "in_progress"},
 }
+
+// ServiceLifecycles lists the stages a service moves through, from first build to shutdown.
+var ServiceLifecycles = StringEnum{
+	members: []string{"experimental", "active", "deprecated", "retired"},
+}
@@ -16,6 +16,8 @@ func TestEnumMembership(t *testing.T) {
 		{"InvalidDebtType", DebtTypes, "duck", false},
 		{"ValidDebtStatus", DebtStatus, "pending", true},
 		{"InvalidDebtStatus", DebtStatus, "duck", false},
+		{"ValidServiceLifecycle", ServiceLifecycles, "Retired", true},
+		{"InvalidServiceLifecycle", ServiceLifecycles, "duck", false},
 	}
 
 	for _, tc := range tests {
@@ -36,6 +38,7 @@ func TestEnumMembers(t *testing.T) {
 	}{
 		{"DebtTypes", DebtTypes, []string{"code", "documentation", "testing", "architecture", "infrastructure", "security"}},
 		{"DebtStatus", DebtStatus, []string{"pending", "remediated", "in_progress"}},
+		{"ServiceLifecycles", ServiceLifecycles, []string{"experimental", "active", "deprecated", "retired"}},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// keyPrefix marks API keys so they are recognisable in configuration and secret scanners.
+const keyPrefix = "sa_"
+
+// GenerateKey returns a new random API key and the hash to store for it.
+func GenerateKey() (key string, hash string, err error) {
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	key = keyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, HashKey(key), nil
+}
+
+// HashKey returns the hash an API key is stored and looked up by. Keys are random, so an unsalted fast hash is enough.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
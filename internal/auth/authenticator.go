@@ -0,0 +1,343 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/config"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"strings"
+)
+
+var (
+	// ErrUnauthenticated is returned when a scope is required and the caller sent no credentials.
+	ErrUnauthenticated = errors.New("authentication required")
+	// ErrInvalidCredentials is returned when the credentials sent do not match a known key or valid token.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// OwnerLookup finds the teams owning a service.
+type OwnerLookup interface {
+	GetTeamsByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error)
+}
+
+// DebtLookup finds debt items, to check who may change them.
+type DebtLookup interface {
+	GetDebtById(ctx context.Context, id string) (repositories.Debt, error)
+}
+
+// Options configure an Authenticator.
+type Options struct {
+	Keys   repositories.ApiKeyRepository
+	Owners OwnerLookup
+	Debt   DebtLookup
+	// Tokens, if set, validates JWT bearer tokens.
+	Tokens *TokenVerifier
+	// Required rejects anonymous callers. When false they are allowed everything but admin, so auth can be adopted
+	// gradually without leaving keys and configuration open.
+	Required bool
+	// BootstrapKey, if set, is accepted with admin scope without being stored, so the first keys can be created.
+	BootstrapKey string
+	// ClientCertScopes are granted to callers identified by a verified TLS client certificate.
+	ClientCertScopes []Scope
+}
+
+// Authenticator identifies callers from their API key or bearer token, and enforces scopes and team ownership.
+type Authenticator struct {
+	keys          repositories.ApiKeyRepository
+	owners        OwnerLookup
+	debt          DebtLookup
+	tokens        *TokenVerifier
+	required      bool
+	bootstrapHash string
+	certScopes    []Scope
+}
+
+func NewAuthenticator(opts Options) *Authenticator {
+	a := &Authenticator{keys: opts.Keys, owners: opts.Owners, debt: opts.Debt, tokens: opts.Tokens,
+		required: opts.Required, certScopes: opts.ClientCertScopes}
+	if opts.BootstrapKey != "" {
+		a.bootstrapHash = HashKey(opts.BootstrapKey)
+	}
+	return a
+}
+
+// FromConfig creates an Authenticator from the auth configuration.
+// A misconfigured OIDC provider is logged and leaves token validation disabled, so tokens are rejected.
+func FromConfig(cfg config.Auth, keys repositories.ApiKeyRepository, owners OwnerLookup, debt DebtLookup) *Authenticator {
+	opts := Options{Keys: keys, Owners: owners, Debt: debt, Required: cfg.Required, BootstrapKey: cfg.BootstrapKey.Value()}
+	for _, scope := range cfg.ClientCertScopes {
+		opts.ClientCertScopes = append(opts.ClientCertScopes, Scope(scope))
+	}
+	if cfg.OIDC.Issuer != "" {
+		tokens, err := NewTokenVerifier(OIDCConfig(cfg.OIDC))
+		if err != nil {
+			slog.Error("Invalid OIDC configuration, bearer tokens will be rejected", slog.String("error", err.Error()))
+		}
+		opts.Tokens = tokens
+	}
+	return NewAuthenticator(opts)
+}
+
+// Identify returns the identity a credential belongs to. JWTs are validated when a TokenVerifier is configured;
+// anything else is treated as an API key.
+func (a *Authenticator) Identify(ctx context.Context, credential string) (Identity, error) {
+	if a.tokens != nil && looksLikeJWT(credential) {
+		return a.tokens.Verify(ctx, credential)
+	}
+	hash := HashKey(credential)
+	if a.bootstrapHash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(a.bootstrapHash)) == 1 {
+		return Identity{Kind: "apikey", Id: "bootstrap", Name: "bootstrap", Scopes: []Scope{ScopeAdmin}}, nil
+	}
+	stored, err := a.keys.GetApiKeyByHash(ctx, hash)
+	if err != nil {
+		if isStatus(err, http.StatusNotFound) {
+			return Identity{}, ErrInvalidCredentials
+		}
+		return Identity{}, err
+	}
+	identity := Identity{Kind: "apikey", Id: stored.Id, Name: stored.Name}
+	for _, scope := range stored.Scopes {
+		identity.Scopes = append(identity.Scopes, Scope(scope))
+	}
+	return identity, nil
+}
+
+// Authorize checks the caller in ctx was granted scope. Admin always needs an identity, even when auth is not
+// required, as it mints keys and reads the configuration.
+func (a *Authenticator) Authorize(ctx context.Context, scope Scope) error {
+	identity, ok := IdentityFromContext(ctx)
+	switch {
+	case !ok && !a.required && scope != ScopeAdmin:
+		return nil
+	case !ok:
+		return ErrUnauthenticated
+	case !identity.Has(scope):
+		return &customerrors.HTTPError{Status: http.StatusForbidden, Msg: "Missing scope: " + string(scope)}
+	}
+	return nil
+}
+
+// restricted returns the caller if it is limited to its teams' services, that is a team scoped non-admin.
+func restricted(ctx context.Context) (Identity, bool) {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok || !identity.TeamScoped || identity.Has(ScopeAdmin) {
+		return Identity{}, false
+	}
+	return identity, true
+}
+
+// AuthorizeService checks a team scoped caller is a member of a team owning the service.
+// Other callers are governed by their scopes alone.
+func (a *Authenticator) AuthorizeService(ctx context.Context, serviceId string) error {
+	identity, ok := restricted(ctx)
+	if !ok {
+		return nil
+	}
+	owns, _, err := a.ownsService(ctx, identity, serviceId)
+	if err != nil {
+		return err
+	}
+	if !owns {
+		return &customerrors.HTTPError{Status: http.StatusForbidden, Msg: "Only members of a team owning the service can change it"}
+	}
+	return nil
+}
+
+// AuthorizeDebt checks a team scoped caller is a member of a team owning the service the debt item belongs to.
+func (a *Authenticator) AuthorizeDebt(ctx context.Context, debtId string) error {
+	if _, ok := restricted(ctx); !ok {
+		return nil
+	}
+	debt, err := a.debt.GetDebtById(ctx, debtId)
+	if err != nil {
+		return err
+	}
+	return a.AuthorizeService(ctx, debt.ServiceId)
+}
+
+// AuthorizeTeam checks a team scoped caller is a member of the team.
+func (a *Authenticator) AuthorizeTeam(ctx context.Context, teamId string) error {
+	identity, ok := restricted(ctx)
+	if ok && !identity.MemberOf(teamId) {
+		return &customerrors.HTTPError{Status: http.StatusForbidden, Msg: "Only members of the team can change it"}
+	}
+	return nil
+}
+
+// AuthorizeOwnership checks a team scoped caller may make a team an owner of a service, or remove it as one.
+// The caller must be a member of the team, and of a team already owning the service unless it has no owners yet.
+func (a *Authenticator) AuthorizeOwnership(ctx context.Context, teamId, serviceId string) error {
+	identity, ok := restricted(ctx)
+	if !ok {
+		return nil
+	}
+	if !identity.MemberOf(teamId) {
+		return &customerrors.HTTPError{Status: http.StatusForbidden, Msg: "Only members of the team can change what it owns"}
+	}
+	owns, owners, err := a.ownsService(ctx, identity, serviceId)
+	if err != nil {
+		return err
+	}
+	if !owns && owners > 0 {
+		return &customerrors.HTTPError{Status: http.StatusForbidden, Msg: "Only members of a team owning the service can change its owners"}
+	}
+	return nil
+}
+
+// ownsService reports whether the caller is in a team owning the service, and how many teams own it.
+func (a *Authenticator) ownsService(ctx context.Context, identity Identity, serviceId string) (bool, int, error) {
+	owners, err := a.owners.GetTeamsByServiceId(ctx, serviceId, pagination.First(pagination.Unlimited))
+	if err != nil {
+		return false, 0, err
+	}
+	teams := owners.Items
+	for _, team := range teams {
+		if identity.MemberOf(team.Id) {
+			return true, len(teams), nil
+		}
+	}
+	return false, len(teams), nil
+}
+
+// ClientCertIdentity identifies the caller by the TLS client certificate it presented on the connection in state, if
+// the certificate was verified against the configured client CAs. The identity is named by the certificate's common
+// name.
+func (a *Authenticator) ClientCertIdentity(state *tls.ConnectionState) (Identity, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return Identity{}, false
+	}
+	subject := state.VerifiedChains[0][0].Subject
+	id := subject.CommonName
+	if id == "" {
+		id = subject.String()
+	}
+	return Identity{Kind: "cert", Id: id, Name: subject.String(), Scopes: a.certScopes}, true
+}
+
+// Authenticate is middleware identifying callers from the Authorization: Bearer or X-Api-Key header, or failing
+// that from a verified TLS client certificate. Requests without credentials continue anonymously; Require decides
+// whether that is allowed.
+func (a *Authenticator) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		credential := credentials(r)
+		if credential == "" {
+			if identity, ok := a.ClientCertIdentity(r.TLS); ok {
+				internal.AddLogAttrs(r.Context(), slog.String("caller", identity.Subject()))
+				r = r.WithContext(WithIdentity(r.Context(), identity))
+			}
+			next.ServeHTTP(rw, r)
+			return
+		}
+		identity, err := a.Identify(r.Context(), credential)
+		if err != nil {
+			internal.LoggerFromContext(r.Context()).Warn("Rejected credentials", slog.String("error", err.Error()))
+			if errors.Is(err, ErrInvalidCredentials) {
+				unauthorized(rw, "Invalid credentials")
+				return
+			}
+			http.Error(rw, "Error authenticating request", http.StatusInternalServerError)
+			return
+		}
+		internal.AddLogAttrs(r.Context(), slog.String("caller", identity.Subject()))
+		next.ServeHTTP(rw, r.WithContext(WithIdentity(r.Context(), identity)))
+	})
+}
+
+// Require is middleware rejecting callers not granted scope, with a 401 if they are anonymous or a 403 otherwise.
+func (a *Authenticator) Require(scope Scope) func(http.Handler) http.Handler {
+	return a.check(func(r *http.Request) error {
+		return a.Authorize(r.Context(), scope)
+	})
+}
+
+// RequireServiceOwner is Require(scope), additionally limiting team scoped callers to services their teams own.
+// The service id is read from the path parameter named param.
+func (a *Authenticator) RequireServiceOwner(scope Scope, param string) func(http.Handler) http.Handler {
+	return a.check(func(r *http.Request) error {
+		if err := a.Authorize(r.Context(), scope); err != nil {
+			return err
+		}
+		return a.AuthorizeService(r.Context(), r.PathValue(param))
+	})
+}
+
+// RequireDebtOwner is Require(scope), additionally limiting team scoped callers to the debt of services their teams
+// own. The debt item id is read from the path parameter named param.
+func (a *Authenticator) RequireDebtOwner(scope Scope, param string) func(http.Handler) http.Handler {
+	return a.check(func(r *http.Request) error {
+		if err := a.Authorize(r.Context(), scope); err != nil {
+			return err
+		}
+		return a.AuthorizeDebt(r.Context(), r.PathValue(param))
+	})
+}
+
+// RequireTeamMember is Require(ScopeWriteServices), additionally limiting team scoped callers to their own teams.
+func (a *Authenticator) RequireTeamMember(param string) func(http.Handler) http.Handler {
+	return a.check(func(r *http.Request) error {
+		if err := a.Authorize(r.Context(), ScopeWriteServices); err != nil {
+			return err
+		}
+		return a.AuthorizeTeam(r.Context(), r.PathValue(param))
+	})
+}
+
+// RequireOwnershipChange guards adding and removing a team as an owner of a service. See AuthorizeOwnership.
+func (a *Authenticator) RequireOwnershipChange(teamParam, serviceParam string) func(http.Handler) http.Handler {
+	return a.check(func(r *http.Request) error {
+		if err := a.Authorize(r.Context(), ScopeWriteServices); err != nil {
+			return err
+		}
+		return a.AuthorizeOwnership(r.Context(), r.PathValue(teamParam), r.PathValue(serviceParam))
+	})
+}
+
+// check builds middleware rejecting requests for which authorize returns an error.
+func (a *Authenticator) check(authorize func(r *http.Request) error) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			err := authorize(r)
+			if errors.Is(err, ErrUnauthenticated) {
+				unauthorized(rw, "Authentication required")
+				return
+			}
+			if err != nil {
+				customerrors.HandleError(rw, err)
+				return
+			}
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+func credentials(r *http.Request) string {
+	if key := r.Header.Get("X-Api-Key"); key != "" {
+		return key
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func isStatus(err error, status int) bool {
+	var httpErr *customerrors.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Status == status
+	}
+	var httpErrValue customerrors.HTTPError
+	return errors.As(err, &httpErrValue) && httpErrValue.Status == status
+}
+
+func unauthorized(rw http.ResponseWriter, msg string) {
+	rw.Header().Set("WWW-Authenticate", `Bearer realm="service-atlas"`)
+	http.Error(rw, msg, http.StatusUnauthorized)
+}
@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/config"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"testing"
+)
+
+// fakeKeys is an in memory ApiKeyRepository holding keys by hash.
+type fakeKeys map[string]repositories.ApiKey
+
+func (f fakeKeys) CreateApiKey(context.Context, repositories.ApiKey) (string, error) { return "", nil }
+func (f fakeKeys) GetApiKeys(context.Context, pagination.Page) (pagination.Result[repositories.ApiKey], error) {
+	return pagination.Result[repositories.ApiKey]{}, nil
+}
+func (f fakeKeys) DeleteApiKey(context.Context, string) error { return nil }
+func (f fakeKeys) GetApiKeyByHash(_ context.Context, hash string) (*repositories.ApiKey, error) {
+	if key, ok := f[hash]; ok {
+		return &key, nil
+	}
+	return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+}
+
+// fakeOwners maps service ids to the ids of the teams owning them.
+type fakeOwners map[string][]string
+
+func (f fakeOwners) GetTeamsByServiceId(_ context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	teams := make([]repositories.Team, 0)
+	for _, id := range f[serviceId] {
+		teams = append(teams, repositories.Team{Id: id})
+	}
+	return pagination.Slice(teams, page), nil
+}
+
+// fakeDebt maps debt item ids to the ids of the services they belong to.
+type fakeDebt map[string]string
+
+func (f fakeDebt) GetDebtById(_ context.Context, id string) (repositories.Debt, error) {
+	serviceId, ok := f[id]
+	if !ok {
+		return repositories.Debt{}, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+	}
+	return repositories.Debt{Id: id, ServiceId: serviceId}, nil
+}
+
+func newTestAuthenticator(required bool) *Authenticator {
+	keys := fakeKeys{
+		HashKey("sa_reader"): {Id: "k-1", Name: "reader", Scopes: []string{"read"}},
+		HashKey("sa_admin"):  {Id: "k-2", Name: "admin", Scopes: []string{"admin"}},
+	}
+	return NewAuthenticator(Options{Keys: keys, Owners: fakeOwners{}, Required: required, BootstrapKey: "sa_bootstrap"})
+}
+
+func TestGenerateKey(t *testing.T) {
+	key, hash, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(key) < 40 || key[:3] != "sa_" || hash != HashKey(key) {
+		t.Errorf("unexpected key %q hash %q", key, hash)
+	}
+	other, _, _ := GenerateKey()
+	if other == key {
+		t.Error("expected keys to differ")
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	a := newTestAuthenticator(true)
+	identity, err := a.Identify(context.Background(), "sa_reader")
+	if err != nil || identity.Subject() != "apikey:k-1" || !identity.Has(ScopeRead) || identity.Has(ScopeWriteDebt) {
+		t.Errorf("unexpected identity %+v, %v", identity, err)
+	}
+	identity, err = a.Identify(context.Background(), "sa_bootstrap")
+	if err != nil || !identity.Has(ScopeWriteServices) {
+		t.Errorf("expected the bootstrap key to be an admin, got %+v, %v", identity, err)
+	}
+	if _, err = a.Identify(context.Background(), "sa_unknown"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials, got %v", err)
+	}
+}
+
+func TestAuthenticateAndRequire(t *testing.T) {
+	tests := []struct {
+		name     string
+		required bool
+		header   string
+		value    string
+		scope    Scope
+		status   int
+	}{
+		{"AnonymousAllowedWhenNotRequired", false, "", "", ScopeWriteDebt, http.StatusOK},
+		{"AnonymousNeverAdmin", false, "", "", ScopeAdmin, http.StatusUnauthorized},
+		{"AnonymousRejectedWhenRequired", true, "", "", ScopeRead, http.StatusUnauthorized},
+		{"Bearer", true, "Authorization", "Bearer sa_reader", ScopeRead, http.StatusOK},
+		{"ApiKeyHeader", true, "X-Api-Key", "sa_reader", ScopeRead, http.StatusOK},
+		{"MissingScope", true, "X-Api-Key", "sa_reader", ScopeWriteServices, http.StatusForbidden},
+		{"AdminHasEveryScope", true, "X-Api-Key", "sa_admin", ScopeWriteDebt, http.StatusOK},
+		{"InvalidKey", false, "X-Api-Key", "sa_unknown", ScopeRead, http.StatusUnauthorized},
+		{"ScopesCheckedWhenNotRequired", false, "X-Api-Key", "sa_reader", ScopeWriteDebt, http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := newTestAuthenticator(tc.required)
+			handler := a.Authenticate(a.Require(tc.scope)(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusOK)
+			})))
+			req := httptest.NewRequest(http.MethodGet, "/services", nil)
+			if tc.header != "" {
+				req.Header.Set(tc.header, tc.value)
+			}
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, rw.Code)
+			}
+			if rw.Code == http.StatusUnauthorized && rw.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected a WWW-Authenticate header")
+			}
+		})
+	}
+}
+
+func TestClientCertIdentity(t *testing.T) {
+	a := NewAuthenticator(Options{Keys: fakeKeys{}, Required: true, ClientCertScopes: []Scope{ScopeRead}})
+	var got Identity
+	handler := a.Authenticate(a.Require(ScopeRead)(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		got, _ = IdentityFromContext(r.Context())
+	})))
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "deployer", Organization: []string{"platform"}}}
+
+	req := httptest.NewRequest(http.MethodGet, "/services", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}, VerifiedChains: [][]*x509.Certificate{{cert}}}
+	rw := httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	if rw.Code != http.StatusOK || got.Subject() != "cert:deployer" || got.Name != "CN=deployer,O=platform" {
+		t.Errorf("expected the certificate subject as identity, got %d %+v", rw.Code, got)
+	}
+
+	// a certificate that was not verified against the client CAs identifies no one
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	rw = httptest.NewRecorder()
+	handler.ServeHTTP(rw, req)
+	if rw.Code != http.StatusUnauthorized {
+		t.Errorf("expected an unverified certificate to be anonymous, got %d", rw.Code)
+	}
+}
+
+func TestWithIdentity(t *testing.T) {
+	ctx := WithIdentity(context.Background(), Identity{Kind: "apikey", Id: "k-1"})
+	if Actor(ctx) != "apikey:k-1" {
+		t.Errorf("unexpected actor %q", Actor(ctx))
+	}
+	if Actor(context.Background()) != "" {
+		t.Error("expected no actor for anonymous requests")
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	a := FromConfig(config.Auth{Required: true, BootstrapKey: "sa_bootstrap"}, fakeKeys{}, fakeOwners{}, fakeDebt{})
+	if !a.required || a.bootstrapHash != HashKey("sa_bootstrap") || a.tokens != nil {
+		t.Errorf("unexpected authenticator %+v", a)
+	}
+	oidc := config.OIDC{Issuer: testIssuer, JWKSURL: "https://login.example.com/jwks"}
+	if FromConfig(config.Auth{OIDC: oidc}, fakeKeys{}, fakeOwners{}, fakeDebt{}).tokens == nil {
+		t.Error("expected bearer tokens to be validated when an issuer is configured")
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	a := NewAuthenticator(Options{Keys: fakeKeys{}, Owners: fakeOwners{
+		"cart":    {"payments"},
+		"orphan":  nil,
+		"billing": {"payments", "finance"},
+	}, Debt: fakeDebt{"cart-debt": "cart", "orphan-debt": "orphan"}})
+	member := Identity{Kind: "jwt", Id: "ada", Scopes: []Scope{ScopeRead, ScopeWriteServices}, Teams: []string{"payments"}, TeamScoped: true}
+	admin := Identity{Kind: "jwt", Id: "root", Scopes: []Scope{ScopeAdmin}, TeamScoped: true}
+	apiKey := Identity{Kind: "apikey", Id: "k-1", Scopes: []Scope{ScopeWriteServices}}
+
+	tests := []struct {
+		name      string
+		identity  *Identity
+		authorize func(ctx context.Context) error
+		allowed   bool
+	}{
+		{"OwnerChangesService", &member, func(ctx context.Context) error { return a.AuthorizeService(ctx, "cart") }, true},
+		{"CoOwnerChangesService", &member, func(ctx context.Context) error { return a.AuthorizeService(ctx, "billing") }, true},
+		{"NonOwnerChangesService", &member, func(ctx context.Context) error { return a.AuthorizeService(ctx, "orphan") }, false},
+		{"AdminChangesAnyService", &admin, func(ctx context.Context) error { return a.AuthorizeService(ctx, "orphan") }, true},
+		{"ApiKeyGovernedByScopes", &apiKey, func(ctx context.Context) error { return a.AuthorizeService(ctx, "cart") }, true},
+		{"AnonymousGovernedByScopes", nil, func(ctx context.Context) error { return a.AuthorizeService(ctx, "cart") }, true},
+		{"OwnerChangesDebt", &member, func(ctx context.Context) error { return a.AuthorizeDebt(ctx, "cart-debt") }, true},
+		{"NonOwnerChangesDebt", &member, func(ctx context.Context) error { return a.AuthorizeDebt(ctx, "orphan-debt") }, false},
+		{"ApiKeyChangesAnyDebt", &apiKey, func(ctx context.Context) error { return a.AuthorizeDebt(ctx, "orphan-debt") }, true},
+		{"MemberChangesTeam", &member, func(ctx context.Context) error { return a.AuthorizeTeam(ctx, "payments") }, true},
+		{"NonMemberChangesTeam", &member, func(ctx context.Context) error { return a.AuthorizeTeam(ctx, "finance") }, false},
+		{"ClaimUnownedService", &member, func(ctx context.Context) error { return a.AuthorizeOwnership(ctx, "payments", "orphan") }, true},
+		{"ClaimOwnedService", &member, func(ctx context.Context) error { return a.AuthorizeOwnership(ctx, "payments", "billing") }, true},
+		{"ClaimForAnotherTeam", &member, func(ctx context.Context) error { return a.AuthorizeOwnership(ctx, "finance", "orphan") }, false},
+		{"TakeOverService", &Identity{Kind: "jwt", Id: "eve", Scopes: member.Scopes, Teams: []string{"finance"}, TeamScoped: true},
+			func(ctx context.Context) error { return a.AuthorizeOwnership(ctx, "finance", "cart") }, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.identity != nil {
+				ctx = WithIdentity(ctx, *tc.identity)
+			}
+			err := tc.authorize(ctx)
+			if tc.allowed && err != nil {
+				t.Errorf("expected to be allowed, got %v", err)
+			}
+			if !tc.allowed && !isStatus(err, http.StatusForbidden) {
+				t.Errorf("expected forbidden, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRequireServiceOwner(t *testing.T) {
+	a := NewAuthenticator(Options{Keys: fakeKeys{}, Owners: fakeOwners{"cart": {"payments"}}, Required: true})
+	handler := a.RequireServiceOwner(ScopeWriteServices, "id")(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	tests := []struct {
+		name     string
+		identity *Identity
+		service  string
+		status   int
+	}{
+		{"Anonymous", nil, "cart", http.StatusUnauthorized},
+		{"Owner", &Identity{Kind: "jwt", Scopes: []Scope{ScopeWriteServices}, Teams: []string{"payments"}, TeamScoped: true}, "cart", http.StatusNoContent},
+		{"NotOwner", &Identity{Kind: "jwt", Scopes: []Scope{ScopeWriteServices}, Teams: []string{"finance"}, TeamScoped: true}, "cart", http.StatusForbidden},
+		{"MissingScope", &Identity{Kind: "jwt", Scopes: []Scope{ScopeRead}, Teams: []string{"payments"}, TeamScoped: true}, "cart", http.StatusForbidden},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/services/"+tc.service, nil)
+			req.SetPathValue("id", tc.service)
+			if tc.identity != nil {
+				req = req.WithContext(WithIdentity(req.Context(), *tc.identity))
+			}
+			rw := httptest.NewRecorder()
+			handler.ServeHTTP(rw, req)
+			if rw.Code != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, rw.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Package auth authenticates API callers and checks the scopes they were granted.
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"service-atlas/internal"
+	"slices"
+)
+
+// Scope is a permission granted to a caller.
+type Scope string
+
+const (
+	// ScopeRead allows reading the catalog.
+	ScopeRead Scope = "read"
+	// ScopeWriteServices allows changing services, teams, dependencies and releases.
+	ScopeWriteServices Scope = "write:services"
+	// ScopeWriteDebt allows recording and updating debt.
+	ScopeWriteDebt Scope = "write:debt"
+	// ScopeAdmin allows everything, including managing API keys.
+	ScopeAdmin Scope = "admin"
+)
+
+// Identity is an authenticated caller.
+type Identity struct {
+	// Kind is how the caller authenticated, such as "apikey" or "jwt".
+	Kind   string
+	Id     string
+	Name   string
+	Scopes []Scope
+	// Teams are the ids of the teams the caller is a member of.
+	Teams []string
+	// TeamScoped callers can only change services their teams own, unless they are admins.
+	TeamScoped bool
+}
+
+// MemberOf reports whether the identity is a member of the team.
+func (i Identity) MemberOf(teamId string) bool {
+	return slices.Contains(i.Teams, teamId)
+}
+
+// Subject identifies the caller in logs and the audit trail.
+func (i Identity) Subject() string {
+	return i.Kind + ":" + i.Id
+}
+
+// Has reports whether the identity was granted scope. Admins are granted every scope.
+func (i Identity) Has(scope Scope) bool {
+	return slices.Contains(i.Scopes, scope) || slices.Contains(i.Scopes, ScopeAdmin)
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity, with the context logger tagged with its subject.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	logger := internal.LoggerFromContext(ctx).With(slog.String("identity", identity.Subject()))
+	ctx = context.WithValue(ctx, internal.LoggerKey{}, logger)
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the identity of the caller, if the request was authenticated.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// Actor returns the subject of the caller for the audit trail, or an empty string for anonymous requests.
+func Actor(ctx context.Context) string {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity.Subject()
+	}
+	return ""
+}
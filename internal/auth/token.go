@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// OIDCConfig configures validation of bearer tokens issued by an OpenID Connect provider.
+type OIDCConfig struct {
+	Issuer string
+	// Audience, if set, must be in the token's aud claim.
+	Audience string
+	// JWKSURL or JWKSFile is where the provider's signing keys are read from.
+	JWKSURL  string
+	JWKSFile string
+	// GroupsClaim names the claim listing the caller's groups.
+	GroupsClaim string
+	// AdminGroup is the group whose members are admins.
+	AdminGroup string
+	// GroupTeams maps group names to team ids. Groups that are themselves team ids need no mapping.
+	GroupTeams map[string]string
+}
+
+// TokenVerifier validates JWT bearer tokens and maps their claims to an Identity.
+type TokenVerifier struct {
+	cfg    OIDCConfig
+	keys   keySource
+	parser *jwt.Parser
+}
+
+func NewTokenVerifier(cfg OIDCConfig) (*TokenVerifier, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("an issuer is required")
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	v := &TokenVerifier{cfg: cfg}
+	switch {
+	case cfg.JWKSFile != "":
+		keys, err := loadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = keys
+	case cfg.JWKSURL != "":
+		v.keys = newRemoteKeys(cfg.JWKSURL)
+	default:
+		return nil, errors.New("a JWKS URL or file is required")
+	}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(cfg.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30 * time.Second),
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(options...)
+	return v, nil
+}
+
+// Verify validates a token and returns the identity of its subject. Token holders can read and write, but only
+// change services owned by the teams their groups map to, unless they are in the admin group.
+func (v *TokenVerifier) Verify(ctx context.Context, raw string) (Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return Identity{}, fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+
+	identity := Identity{
+		Kind:       "jwt",
+		Id:         subject,
+		Name:       firstClaim(claims, "email", "preferred_username", "name"),
+		Scopes:     []Scope{ScopeRead, ScopeWriteServices, ScopeWriteDebt},
+		TeamScoped: true,
+	}
+	for _, group := range stringsClaim(claims[v.cfg.GroupsClaim]) {
+		if v.cfg.AdminGroup != "" && group == v.cfg.AdminGroup {
+			identity.Scopes = append(identity.Scopes, ScopeAdmin)
+		}
+		if team, ok := v.cfg.GroupTeams[group]; ok {
+			identity.Teams = append(identity.Teams, team)
+		} else if uuid.Validate(group) == nil {
+			identity.Teams = append(identity.Teams, group)
+		}
+	}
+	slices.Sort(identity.Teams)
+	identity.Teams = slices.Compact(identity.Teams)
+	return identity, nil
+}
+
+func firstClaim(claims jwt.MapClaims, names ...string) string {
+	for _, name := range names {
+		if value, ok := claims[name].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// stringsClaim reads a claim that is a list of strings, or a single string.
+func stringsClaim(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// looksLikeJWT reports whether a credential has the three dot separated parts of a JWT, rather than being an API key.
+func looksLikeJWT(credential string) bool {
+	return strings.Count(credential, ".") == 2
+}
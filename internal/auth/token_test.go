@@ -0,0 +1,216 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer = "https://login.example.com"
+	teamId     = "3f1c2a8e-5b7d-4e6f-9a0b-1c2d3e4f5a6b"
+)
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) map[string]string {
+	return map[string]string{"kid": kid, "kty": "RSA", "use": "sig",
+		"n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes())}
+}
+
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    testIssuer,
+		"sub":    "ada",
+		"aud":    "service-atlas",
+		"email":  "ada@example.com",
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"groups": []string{"payments-devs", teamId, "everyone"},
+	}
+}
+
+func TestTokenVerifier(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	verifier, err := NewTokenVerifier(OIDCConfig{
+		Issuer:     testIssuer,
+		Audience:   "service-atlas",
+		JWKSFile:   writeJWKS(t, rsaJWK("k1", key)),
+		AdminGroup: "atlas-admins",
+		GroupTeams: map[string]string{"payments-devs": "8a7b6c5d-4e3f-4a1b-9c8d-7e6f5a4b3c2d"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, err := verifier.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "k1", key, validClaims()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Subject() != "jwt:ada" || identity.Name != "ada@example.com" || !identity.TeamScoped {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+	if !slices.Equal(identity.Teams, []string{teamId, "8a7b6c5d-4e3f-4a1b-9c8d-7e6f5a4b3c2d"}) {
+		t.Errorf("unexpected teams %v", identity.Teams)
+	}
+	if !identity.Has(ScopeWriteDebt) || identity.Has(ScopeAdmin) {
+		t.Errorf("unexpected scopes %v", identity.Scopes)
+	}
+
+	adminClaims := validClaims()
+	adminClaims["groups"] = "atlas-admins"
+	if identity, err = verifier.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "k1", key, adminClaims)); err != nil || !identity.Has(ScopeAdmin) {
+		t.Errorf("expected an admin, got %+v, %v", identity, err)
+	}
+
+	invalid := map[string]func() string{
+		"Expired": func() string {
+			claims := validClaims()
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+			return sign(t, jwt.SigningMethodRS256, "k1", key, claims)
+		},
+		"NoExpiry": func() string {
+			claims := validClaims()
+			delete(claims, "exp")
+			return sign(t, jwt.SigningMethodRS256, "k1", key, claims)
+		},
+		"WrongIssuer": func() string {
+			claims := validClaims()
+			claims["iss"] = "https://evil.example.com"
+			return sign(t, jwt.SigningMethodRS256, "k1", key, claims)
+		},
+		"WrongAudience": func() string {
+			claims := validClaims()
+			claims["aud"] = "another-api"
+			return sign(t, jwt.SigningMethodRS256, "k1", key, claims)
+		},
+		"NoSubject": func() string {
+			claims := validClaims()
+			delete(claims, "sub")
+			return sign(t, jwt.SigningMethodRS256, "k1", key, claims)
+		},
+		"WrongKey":   func() string { return sign(t, jwt.SigningMethodRS256, "k1", other, validClaims()) },
+		"UnknownKid": func() string { return sign(t, jwt.SigningMethodRS256, "k2", key, validClaims()) },
+		"HMAC":       func() string { return sign(t, jwt.SigningMethodHS256, "k1", []byte("secret"), validClaims()) },
+		"Garbage":    func() string { return "not.a.token" },
+	}
+	for name, token := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := verifier.Verify(context.Background(), token()); !errors.Is(err, ErrInvalidCredentials) {
+				t.Errorf("expected ErrInvalidCredentials, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTokenVerifier_RemoteJWKS(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	var fetches atomic.Int32
+	rotated := atomic.Bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		keys := []map[string]string{rsaJWK("k1", rsaKey)}
+		if rotated.Load() {
+			keys = append(keys, map[string]string{"kid": "k2", "kty": "EC", "crv": "P-256",
+				"x": encode(ecKey.X.FillBytes(make([]byte, 32))), "y": encode(ecKey.Y.FillBytes(make([]byte, 32)))})
+		}
+		_ = json.NewEncoder(rw).Encode(map[string]any{"keys": keys})
+	}))
+	defer server.Close()
+
+	verifier, err := NewTokenVerifier(OIDCConfig{Issuer: testIssuer, JWKSURL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if _, err = verifier.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, "k1", rsaKey, validClaims())); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected the key set to be cached, fetched %d times", fetches.Load())
+	}
+
+	// a new key id triggers a refetch, but not more often than minRefreshInterval
+	rotated.Store(true)
+	if _, err = verifier.Verify(context.Background(), sign(t, jwt.SigningMethodES256, "k2", ecKey, validClaims())); err == nil {
+		t.Error("expected the refetch to be rate limited")
+	}
+	verifier.keys.(*remoteKeys).fetched = time.Time{}
+	if _, err = verifier.Verify(context.Background(), sign(t, jwt.SigningMethodES256, "k2", ecKey, validClaims())); err != nil {
+		t.Errorf("expected the rotated key to be fetched, got %v", err)
+	}
+}
+
+func TestNewTokenVerifier_Invalid(t *testing.T) {
+	if _, err := NewTokenVerifier(OIDCConfig{JWKSURL: "https://login.example.com/jwks"}); err == nil {
+		t.Error("expected an issuer to be required")
+	}
+	if _, err := NewTokenVerifier(OIDCConfig{Issuer: testIssuer}); err == nil {
+		t.Error("expected a key source to be required")
+	}
+	if _, err := NewTokenVerifier(OIDCConfig{Issuer: testIssuer, JWKSFile: writeJWKS(t)}); err == nil {
+		t.Error("expected an empty key set to be rejected")
+	}
+}
+
+func TestIdentify_PrefersTokens(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	verifier, err := NewTokenVerifier(OIDCConfig{Issuer: testIssuer, JWKSFile: writeJWKS(t, rsaJWK("k1", key))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAuthenticator(Options{Keys: fakeKeys{HashKey("sa_reader"): {Id: "k-1", Scopes: []string{"read"}}}, Tokens: verifier})
+	if identity, err := a.Identify(context.Background(), sign(t, jwt.SigningMethodRS256, "k1", key, validClaims())); err != nil || identity.Kind != "jwt" {
+		t.Errorf("expected a token identity, got %+v, %v", identity, err)
+	}
+	if identity, err := a.Identify(context.Background(), "sa_reader"); err != nil || identity.Kind != "apikey" {
+		t.Errorf("expected an api key identity, got %+v, %v", identity, err)
+	}
+}
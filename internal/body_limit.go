@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+)
+
+// MaxBodySize rejects requests whose body is larger than n bytes with a 413. Bodies that declare their length
+// are rejected before they are read; others fail with an *http.MaxBytesError once n bytes have been read,
+// which handlers report with BodyErrorStatus. Nested limits apply the smallest.
+func MaxBodySize(n int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > n {
+				http.Error(rw, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = http.MaxBytesReader(rw, r.Body, n)
+			}
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// BodyErrorStatus is the status for an error reading a request body: 413 if it was over its limit,
+// otherwise fallback.
+func BodyErrorStatus(err error, fallback int) int {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return fallback
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	decode := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var v map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+			http.Error(rw, err.Error(), BodyErrorStatus(err, http.StatusBadRequest))
+		}
+	})
+	h := MaxBodySize(16)(decode)
+	tests := []struct {
+		name   string
+		body   io.Reader
+		status int
+	}{
+		{"WithinLimit", strings.NewReader(`{"a":"b"}`), http.StatusOK},
+		{"DeclaredTooLarge", strings.NewReader(`{"a":"` + strings.Repeat("b", 32) + `"}`), http.StatusRequestEntityTooLarge},
+		// io.MultiReader hides the length, so the limit is only hit while decoding
+		{"StreamedTooLarge", io.MultiReader(strings.NewReader(`{"a":"` + strings.Repeat("b", 32) + `"}`)), http.StatusRequestEntityTooLarge},
+		{"Malformed", strings.NewReader(`{"a":`), http.StatusBadRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			h.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/", tc.body))
+			if rw.Code != tc.status {
+				t.Errorf("expected %d, got %d: %s", tc.status, rw.Code, rw.Body.String())
+			}
+		})
+	}
+}
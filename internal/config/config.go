@@ -1,26 +1,201 @@
+// Package config holds the typed configuration of the service. It is loaded from defaults, an optional YAML file,
+// environment variables and command line flags, each overriding the one before.
 package config
 
 import (
-	"log"
-	"os"
-	"strings"
+	"time"
 )
 
-var address string = ":8080"
+// Config is the configuration of the service.
+type Config struct {
+	Server   Server   `yaml:"server"`
+	Storage  Storage  `yaml:"storage"`
+	Database Database `yaml:"database"`
+	Auth     Auth     `yaml:"auth"`
+	Limits   Limits   `yaml:"limits"`
+	API      API      `yaml:"api"`
+	Webhooks Webhooks `yaml:"webhooks"`
+	Events   Events   `yaml:"events"`
+	Features Features `yaml:"features"`
+	Log      Log      `yaml:"log"`
+}
 
-func GetConfigValue(key string) string {
-	switch strings.ToLower(key) {
-	case "address":
-		return address
-	default:
-		return getEnvVarValue(strings.ToUpper(key))
-	}
+// Server configures the HTTP and gRPC listeners.
+type Server struct {
+	Address string `yaml:"address" env:"HTTP_ADDRESS"`
+	// GRPCAddress is where the gRPC API is served; "off" disables it like features.grpc.
+	GRPCAddress string `yaml:"grpcAddress" env:"GRPC_ADDRESS"`
+	// ReadHeaderTimeout, ReadTimeout, WriteTimeout and IdleTimeout bound each phase of an HTTP connection.
+	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	ReadTimeout       time.Duration `yaml:"readTimeout" env:"SERVER_READ_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout" env:"SERVER_IDLE_TIMEOUT"`
+	// DrainDelay is how long the server keeps serving after readiness fails on shutdown.
+	DrainDelay time.Duration `yaml:"drainDelay" env:"SHUTDOWN_DRAIN_DELAY"`
+	// ShutdownTimeout is how long in-flight requests get to finish on shutdown.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" env:"SHUTDOWN_TIMEOUT"`
+	TLS             TLS           `yaml:"tls"`
+}
+
+// TLS configures HTTPS, which is served instead of plain HTTP when a certificate is set.
+type TLS struct {
+	CertFile string `yaml:"certFile" env:"TLS_CERT_FILE"`
+	KeyFile  string `yaml:"keyFile" env:"TLS_KEY_FILE"`
+	// ClientAuth is whether clients present certificates: none, optional or require.
+	ClientAuth string `yaml:"clientAuth" env:"TLS_CLIENT_AUTH"`
+	// ClientCAFile is the bundle of CAs client certificates are verified against.
+	ClientCAFile string `yaml:"clientCaFile" env:"TLS_CLIENT_CA_FILE"`
+	// ReloadInterval is how often the files are checked for changes, so renewed certificates are served
+	// without a restart.
+	ReloadInterval time.Duration `yaml:"reloadInterval" env:"TLS_RELOAD_INTERVAL"`
+}
+
+// Storage selects where the catalog is kept.
+type Storage struct {
+	// Backend is neo4j, memory to keep the catalog in the process for local development and demos, or file to
+	// also persist it to Path for small teams and preview environments.
+	Backend string `yaml:"backend" env:"STORAGE"`
+	// Path is the snapshot file of the file backend.
+	Path string `yaml:"path" env:"STORAGE_PATH"`
+}
+
+// Database configures the connection to Neo4j.
+type Database struct {
+	// URL is read from NEO4J_URL when DB_URL is unset, for deployments predating it.
+	URL      string `yaml:"url" env:"DB_URL,NEO4J_URL"`
+	Username string `yaml:"username" env:"DB_USERNAME"`
+	Password Secret `yaml:"password" env:"DB_PASSWORD"`
+	// Name is the database the catalog is kept in, so several catalogs can share a cluster.
+	// The server's default database is used when it is empty.
+	Name string `yaml:"name" env:"DB_NAME"`
+	// MaxConnectionPoolSize is the most connections the driver opens to each server.
+	MaxConnectionPoolSize int `yaml:"maxConnectionPoolSize" env:"DB_MAX_CONNECTION_POOL_SIZE"`
+	// ConnectionAcquisitionTimeout is how long a session waits for a connection from a full pool.
+	ConnectionAcquisitionTimeout time.Duration `yaml:"connectionAcquisitionTimeout" env:"DB_CONNECTION_ACQUISITION_TIMEOUT"`
+	// MaxTransactionRetryTime is how long a failed transaction is retried for.
+	MaxTransactionRetryTime time.Duration `yaml:"maxTransactionRetryTime" env:"DB_MAX_TRANSACTION_RETRY_TIME"`
+	// TransactionTimeout aborts transactions running longer; zero leaves it to the server.
+	TransactionTimeout time.Duration `yaml:"transactionTimeout" env:"DB_TRANSACTION_TIMEOUT"`
+	// CausalConsistency chains sessions with bookmarks, so reads routed to cluster replicas see earlier writes.
+	CausalConsistency bool `yaml:"causalConsistency" env:"DB_CAUSAL_CONSISTENCY"`
+	// Migrate applies pending schema migrations at startup. Turn it off to run `service-atlas migrate` before
+	// deploying instead; the service then reports not ready until they are applied.
+	Migrate bool `yaml:"migrate" env:"DB_MIGRATE"`
+}
+
+// Auth configures how callers authenticate.
+type Auth struct {
+	// Required rejects requests without a valid credential.
+	Required bool `yaml:"required" env:"AUTH_REQUIRED"`
+	// BootstrapKey is an admin key accepted without being stored, used to create the first keys.
+	BootstrapKey Secret `yaml:"bootstrapKey" env:"AUTH_BOOTSTRAP_KEY"`
+	// ClientCertScopes are granted to callers identified by a verified TLS client certificate.
+	ClientCertScopes []string `yaml:"clientCertScopes" env:"AUTH_CLIENT_CERT_SCOPES"`
+	OIDC             OIDC     `yaml:"oidc"`
+}
+
+// OIDC configures validation of JWT bearer tokens. It is disabled when Issuer is empty.
+type OIDC struct {
+	Issuer      string `yaml:"issuer" env:"OIDC_ISSUER"`
+	Audience    string `yaml:"audience" env:"OIDC_AUDIENCE"`
+	JWKSURL     string `yaml:"jwksUrl" env:"OIDC_JWKS_URL"`
+	JWKSFile    string `yaml:"jwksFile" env:"OIDC_JWKS_FILE"`
+	GroupsClaim string `yaml:"groupsClaim" env:"OIDC_GROUPS_CLAIM"`
+	AdminGroup  string `yaml:"adminGroup" env:"OIDC_ADMIN_GROUP"`
+	// GroupTeams maps group names to team ids; in the environment it is written "group=teamId,...".
+	GroupTeams map[string]string `yaml:"groupTeams" env:"OIDC_GROUP_TEAMS"`
+}
+
+// Limits protects the service from clients sending too much.
+type Limits struct {
+	RateLimit RateLimit `yaml:"rateLimit"`
+	// MaxBodyBytes is the largest request body accepted by any route.
+	MaxBodyBytes int64 `yaml:"maxBodyBytes" env:"MAX_BODY_BYTES"`
+}
+
+// RateLimit configures the per-client token buckets. A rate of zero disables the limit,
+// and a burst of zero defaults to twice the rate.
+type RateLimit struct {
+	RPS        float64 `yaml:"rps" env:"RATE_LIMIT_RPS"`
+	Burst      int     `yaml:"burst" env:"RATE_LIMIT_BURST"`
+	WriteRPS   float64 `yaml:"writeRps" env:"RATE_LIMIT_WRITE_RPS"`
+	WriteBurst int     `yaml:"writeBurst" env:"RATE_LIMIT_WRITE_BURST"`
+	// AddressRPS limits each client address before requests are authenticated; zero disables it. Every client behind
+	// one gateway or NAT shares the address, so it is sized for all of them rather than for one.
+	AddressRPS   float64 `yaml:"addressRps" env:"RATE_LIMIT_ADDRESS_RPS"`
+	AddressBurst int     `yaml:"addressBurst" env:"RATE_LIMIT_ADDRESS_BURST"`
+	// TrustForwarded identifies anonymous clients by the last X-Forwarded-For entry, the address seen by the proxy
+	// in front of the service, which is only safe behind a proxy setting it.
+	TrustForwarded bool `yaml:"trustForwarded" env:"RATE_LIMIT_TRUST_FORWARDED"`
+}
+
+// API configures the REST API.
+type API struct {
+	// Validation is the OpenAPI validation mode: off, requests or all.
+	Validation string `yaml:"validation" env:"OPENAPI_VALIDATION"`
+	// LegacySunset is the date the unversioned paths will be removed, as YYYY-MM-DD or RFC 3339.
+	LegacySunset string `yaml:"legacySunset" env:"LEGACY_API_SUNSET"`
+}
+
+// Webhooks holds the secrets release webhooks are verified with. A webhook is disabled while its secret is empty.
+type Webhooks struct {
+	GitHubSecret Secret `yaml:"githubSecret" env:"GITHUB_WEBHOOK_SECRET"`
+	CISecret     Secret `yaml:"ciSecret" env:"CI_WEBHOOK_SECRET"`
+	// ReleaseBranches are the branches whose successful GitLab pipelines are releases, alongside tag pipelines.
+	ReleaseBranches []string `yaml:"releaseBranches" env:"CI_RELEASE_BRANCHES"`
+}
+
+// Events configures delivery of domain events from the outbox.
+type Events struct {
+	// Sink is where events are delivered: stdout, file, http or none.
+	Sink string `yaml:"sink" env:"EVENT_SINK"`
+	// Target is the file path of the file sink or the URL of the http sink.
+	Target        string        `yaml:"target" env:"EVENT_SINK_TARGET"`
+	RelayInterval time.Duration `yaml:"relayInterval" env:"EVENT_RELAY_INTERVAL"`
+	// Retention is how long published events are kept in the outbox.
+	Retention time.Duration `yaml:"retention" env:"EVENT_RETENTION"`
+}
+
+// Features turns optional parts of the service on and off.
+type Features struct {
+	GraphQL bool `yaml:"graphql" env:"FEATURE_GRAPHQL"`
+	GRPC    bool `yaml:"grpc" env:"FEATURE_GRPC"`
+	Metrics bool `yaml:"metrics" env:"FEATURE_METRICS"`
+}
+
+// Log configures logging.
+type Log struct {
+	// Level is debug, info, warn or error.
+	Level string `yaml:"level" env:"LOG_LEVEL"`
 }
 
-func getEnvVarValue(key string) string {
-	val, found := os.LookupEnv(key)
-	if !found {
-		log.Println("Environment variable " + key + " not found")
+// Default returns the configuration used where nothing else is set.
+func Default() Config {
+	return Config{
+		Server: Server{
+			Address:           ":8080",
+			GRPCAddress:       ":9090",
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      60 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			DrainDelay:        5 * time.Second,
+			ShutdownTimeout:   10 * time.Second,
+			TLS:               TLS{ClientAuth: "none", ReloadInterval: 10 * time.Second},
+		},
+		Storage: Storage{Backend: "neo4j"},
+		Database: Database{
+			MaxConnectionPoolSize:        100,
+			ConnectionAcquisitionTimeout: time.Minute,
+			MaxTransactionRetryTime:      30 * time.Second,
+			CausalConsistency:            true,
+			Migrate:                      true,
+		},
+		Auth:     Auth{ClientCertScopes: []string{"read"}},
+		Limits:   Limits{MaxBodyBytes: 1 << 20},
+		API:      API{Validation: "off"},
+		Events:   Events{Sink: "none", RelayInterval: 5 * time.Second, Retention: 7 * 24 * time.Hour},
+		Features: Features{GraphQL: true, GRPC: true, Metrics: true},
+		Log:      Log{Level: "info"},
 	}
-	return val
 }
@@ -1,95 +1,220 @@
 package config
 
 import (
-	"bytes"
-	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
-func TestGetConfigValue_AddressDefaultAndCaseInsensitive(t *testing.T) {
-	got := GetConfigValue("address")
-	if got != ":8080" {
-		t.Fatalf("expected :8080 for key 'address', got %q", got)
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
 	}
+	return path
+}
 
-	// Case-insensitive key
-	got = GetConfigValue("ADDRESS")
-	if got != ":8080" {
-		t.Fatalf("expected :8080 for key 'ADDRESS', got %q", got)
+func TestLoadDefaults(t *testing.T) {
+	t.Setenv("DB_URL", "neo4j://localhost:7687")
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Server.Address != ":8080" || cfg.Database.MaxConnectionPoolSize != 100 || !cfg.Features.GraphQL {
+		t.Errorf("expected defaults, got %+v", cfg)
+	}
+	if cfg.Database.URL != "neo4j://localhost:7687" {
+		t.Errorf("expected DB_URL, got %q", cfg.Database.URL)
 	}
 }
 
-func TestGetConfigValue_ReturnsEnvVarsWhenSet(t *testing.T) {
-	// Use t.Setenv to isolate environment for each variable
-	t.Setenv("NEO4J_URL", "neo4j://localhost:7687")
-	t.Setenv("NEO4J_USERNAME", "neo4j")
-	t.Setenv("NEO4J_PASSWORD", "pass")
+func TestLoadRequiresDatabaseURL(t *testing.T) {
+	t.Setenv("DB_URL", "")
+	_, err := Load(nil)
+	if err == nil || !strings.Contains(err.Error(), "database.url is required") {
+		t.Errorf("expected a missing database.url error, got %v", err)
+	}
+}
 
-	if got := GetConfigValue("neo4j_url"); got != "neo4j://localhost:7687" {
-		t.Fatalf("unexpected neo4j_url: got %q", got)
+func TestLoadMemoryStorageNeedsNoDatabase(t *testing.T) {
+	t.Setenv("DB_URL", "")
+	t.Setenv("STORAGE", "memory")
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
 	}
-	// Mixed case to ensure case-insensitive behavior
-	if got := GetConfigValue("Neo4j_Username"); got != "neo4j" {
-		t.Fatalf("unexpected neo4j_username: got %q", got)
+	if cfg.Storage.Backend != "memory" {
+		t.Errorf("expected the memory backend, got %q", cfg.Storage.Backend)
 	}
-	if got := GetConfigValue("NEO4J_PASSWORD"); got != "pass" {
-		t.Fatalf("unexpected neo4j_password: got %q", got)
+}
+
+func TestLoadFallsBackToNeo4jURL(t *testing.T) {
+	t.Setenv("DB_URL", "")
+	_ = os.Unsetenv("DB_URL")
+	t.Setenv("NEO4J_URL", "neo4j://legacy:7687")
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.URL != "neo4j://legacy:7687" {
+		t.Errorf("expected NEO4J_URL, got %q", cfg.Database.URL)
 	}
 }
 
-func TestGetConfigValue_MissingEnvVar_LogsAndReturnsEmpty(t *testing.T) {
-	vars := []string{"NEO4J_URL", "NEO4J_USERNAME", "NEO4J_PASSWORD"}
-	keys := []string{"neo4j_url", "neo4j_username", "neo4j_password"}
-
-	for i, env := range vars {
-		env := env       // capture loop variable
-		key := keys[i]   // capture matching key
-
-		t.Run(env, func(t *testing.T) {
-			// Snapshot current state and ensure variable is truly unset for this subtest
-			if prev, hadPrev := os.LookupEnv(env); hadPrev {
-				// Ensure original value is restored automatically after subtest
-				t.Setenv(env, prev)
-				_ = os.Unsetenv(env)
-			} else {
-				// No previous value: ensure it's unset now and at cleanup
-				_ = os.Unsetenv(env)
-				t.Cleanup(func() { _ = os.Unsetenv(env) })
-			}
+func TestLoadPrecedence(t *testing.T) {
+	file := writeFile(t, "config.yaml", `
+server:
+  address: ":7000"
+  shutdownTimeout: 20s
+database:
+  url: neo4j://file:7687
+  maxConnectionPoolSize: 10
+log:
+  level: debug
+`)
+	t.Setenv("CONFIG_FILE", file)
+	t.Setenv("SHUTDOWN_TIMEOUT", "30s")
+	t.Setenv("DB_MAX_CONNECTION_POOL_SIZE", "20")
 
-			// Capture logs without leaking global state beyond this subtest
-			buf := &bytes.Buffer{}
-			prevOut := log.Writer()
-			prevFlags := log.Flags()
-			prevPrefix := log.Prefix()
-			log.SetOutput(buf)
-			log.SetFlags(0)
-			log.SetPrefix("")
-			t.Cleanup(func() {
-				log.SetOutput(prevOut)
-				log.SetFlags(prevFlags)
-				log.SetPrefix(prevPrefix)
-			})
-
-			// Call the function and assert empty string
-			if got := GetConfigValue(key); got != "" {
-				t.Fatalf("expected empty string for %s when %s is missing, got %q", key, env, got)
+	cfg, err := Load([]string{"-database.maxConnectionPoolSize", "30", "-features.graphql=false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"FileOverridesDefault", cfg.Server.Address, ":7000"},
+		{"EnvOverridesFile", cfg.Server.ShutdownTimeout, 30 * time.Second},
+		{"FlagOverridesEnv", cfg.Database.MaxConnectionPoolSize, 30},
+		{"BoolFlag", cfg.Features.GraphQL, false},
+		{"FileOnly", cfg.Log.Level, "debug"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, tc.got)
 			}
+		})
+	}
+}
+
+func TestLoadConfigFlag(t *testing.T) {
+	file := writeFile(t, "config.yaml", "database:\n  url: neo4j://flag:7687\n")
+	cfg, err := Load([]string{"-config", file})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.URL != "neo4j://flag:7687" {
+		t.Errorf("expected the url from the file, got %q", cfg.Database.URL)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	file := writeFile(t, "config.yaml", "database:\n  uri: neo4j://typo:7687\n")
+	if _, err := Load([]string{"-config", file}); err == nil {
+		t.Error("expected an error for an unknown key")
+	}
+}
+
+func TestSecretsFromFiles(t *testing.T) {
+	password := writeFile(t, "password", "from-env-file\n")
+	bootstrap := writeFile(t, "bootstrap", "sa_from_yaml\n")
+	file := writeFile(t, "config.yaml", `
+database:
+  url: neo4j://localhost:7687
+auth:
+  bootstrapKey:
+    file: `+bootstrap+`
+`)
+	t.Setenv("DB_PASSWORD_FILE", password)
+
+	cfg, err := Load([]string{"-config", file})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Database.Password.Value() != "from-env-file" {
+		t.Errorf("expected the password from DB_PASSWORD_FILE, got %q", cfg.Database.Password.Value())
+	}
+	if cfg.Auth.BootstrapKey.Value() != "sa_from_yaml" {
+		t.Errorf("expected the bootstrap key from its file, got %q", cfg.Auth.BootstrapKey.Value())
+	}
+}
 
-			// Ensure the log contains the expected message substring
-			logged := buf.String()
-			expectedSub := "Environment variable " + env + " not found"
-			if !strings.Contains(logged, expectedSub) {
-				t.Fatalf("expected log to contain %q, got %q", expectedSub, logged)
+func TestEnvParsing(t *testing.T) {
+	t.Setenv("DB_URL", "neo4j://localhost:7687")
+	t.Setenv("OIDC_GROUP_TEAMS", "platform=team-1, payments = team-2")
+	t.Setenv("RATE_LIMIT_RPS", "2.5")
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Auth.OIDC.GroupTeams["payments"] != "team-2" || len(cfg.Auth.OIDC.GroupTeams) != 2 {
+		t.Errorf("unexpected group teams %v", cfg.Auth.OIDC.GroupTeams)
+	}
+	if cfg.Limits.RateLimit.RPS != 2.5 {
+		t.Errorf("expected rate 2.5, got %v", cfg.Limits.RateLimit.RPS)
+	}
+
+	t.Setenv("RATE_LIMIT_RPS", "fast")
+	if _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "RATE_LIMIT_RPS") {
+		t.Errorf("expected an error naming RATE_LIMIT_RPS, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		want   string
+	}{
+		{"ValidationMode", func(c *Config) { c.API.Validation = "strict" }, "api.validation"},
+		{"Sunset", func(c *Config) { c.API.LegacySunset = "soon" }, "api.legacySunset"},
+		{"SinkTarget", func(c *Config) { c.Events.Sink = "http" }, "events.target"},
+		{"Retention", func(c *Config) { c.Events.Retention = 0 }, "events.retention"},
+		{"LogLevel", func(c *Config) { c.Log.Level = "verbose" }, "log.level"},
+		{"PoolSize", func(c *Config) { c.Database.MaxConnectionPoolSize = 0 }, "maxConnectionPoolSize"},
+		{"OIDCKeys", func(c *Config) { c.Auth.OIDC.Issuer = "https://issuer" }, "jwksUrl"},
+		{"TLSKey", func(c *Config) { c.Server.TLS.CertFile = "tls.crt" }, "server.tls.keyFile"},
+		{"ClientCAs", func(c *Config) { c.Server.TLS.ClientAuth = "require" }, "server.tls.clientCaFile"},
+		{"CertScopes", func(c *Config) { c.Auth.ClientCertScopes = []string{"root"} }, "auth.clientCertScopes"},
+		{"StorageBackend", func(c *Config) { c.Storage.Backend = "sqlite" }, "storage.backend"},
+		{"FileWithoutPath", func(c *Config) { c.Storage.Backend = "file" }, "storage.path"},
+		{"FileOutbox", func(c *Config) { c.Storage.Backend, c.Storage.Path, c.Events.Sink = "file", "atlas.json", "stdout" }, "neo4j storage backend"},
+		{"MemoryOutbox", func(c *Config) { c.Storage.Backend, c.Events.Sink = "memory", "stdout" }, "neo4j storage backend"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Database.URL = "neo4j://localhost:7687"
+			tc.modify(&cfg)
+			err := cfg.Validate()
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("expected an error mentioning %s, got %v", tc.want, err)
 			}
 		})
 	}
 }
 
-func TestGetConfigValue_UnknownKey_ReturnsEmpty(t *testing.T) {
-	if got := GetConfigValue("does_not_exist"); got != "" {
-		t.Fatalf("expected empty string for unknown key, got %q", got)
+func TestRedacted(t *testing.T) {
+	cfg := Default()
+	cfg.Database.Password = "hunter2"
+	redacted := cfg.Redacted()
+	database := redacted["database"].(map[string]any)
+	if database["password"] != "[redacted]" {
+		t.Errorf("expected the password to be redacted, got %v", database["password"])
+	}
+	if webhooks := redacted["webhooks"].(map[string]any); webhooks["githubSecret"] != "" {
+		t.Errorf("expected an unset secret to be empty, got %v", webhooks["githubSecret"])
+	}
+	if server := redacted["server"].(map[string]any); server["shutdownTimeout"] != "10s" {
+		t.Errorf("expected a readable duration, got %v", server["shutdownTimeout"])
+	}
+	if limits := redacted["limits"].(map[string]any)["rateLimit"].(map[string]any); limits["rps"] != 0.0 {
+		t.Errorf("expected nested sections, got %v", limits)
 	}
 }
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// reloadable are the settings applied by a reload, by path prefix. Everything else needs a restart.
+var reloadable = []string{"log.", "limits.rateLimit.", "webhooks."}
+
+// Live holds the configuration in effect. Reloading it notifies the parts of the service that can change their
+// settings without a restart, such as the log level and rate limits.
+type Live struct {
+	mu        sync.Mutex
+	current   atomic.Pointer[Config]
+	listeners []func(*Config)
+}
+
+func NewLive(cfg *Config) *Live {
+	l := &Live{}
+	l.current.Store(cfg)
+	return l
+}
+
+// Current returns the configuration in effect.
+func (l *Live) Current() *Config {
+	return l.current.Load()
+}
+
+// OnReload registers fn to be called with each configuration reloaded.
+func (l *Live) OnReload(fn func(*Config)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.listeners = append(l.listeners, fn)
+}
+
+// Reload applies the reloadable settings of cfg, which must already be valid, and notifies the listeners.
+// Other settings keep their values until a restart, so Current only reports what is in effect.
+// It returns the changed settings that only take effect after a restart.
+func (l *Live) Reload(cfg *Config) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previous := l.current.Load()
+	applied := apply(previous, cfg)
+	l.current.Store(applied)
+	for _, fn := range l.listeners {
+		fn(applied)
+	}
+	return restartRequired(previous, cfg)
+}
+
+// apply returns a copy of previous with the reloadable settings taken from next.
+func apply(previous, next *Config) *Config {
+	updates := make(map[string]reflect.Value)
+	visit(reflect.ValueOf(next).Elem(), "", func(path string, _ reflect.StructField, value reflect.Value) {
+		if isReloadable(path) {
+			updates[path] = value
+		}
+	})
+	applied := *previous
+	visit(reflect.ValueOf(&applied).Elem(), "", func(path string, _ reflect.StructField, value reflect.Value) {
+		if update, ok := updates[path]; ok {
+			value.Set(update)
+		}
+	})
+	return &applied
+}
+
+func isReloadable(path string) bool {
+	for _, prefix := range reloadable {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func restartRequired(previous, next *Config) []string {
+	before := make(map[string]reflect.Value)
+	visit(reflect.ValueOf(previous).Elem(), "", func(path string, _ reflect.StructField, value reflect.Value) {
+		before[path] = value
+	})
+	var changed []string
+	visit(reflect.ValueOf(next).Elem(), "", func(path string, _ reflect.StructField, value reflect.Value) {
+		if !isReloadable(path) && !reflect.DeepEqual(before[path].Interface(), value.Interface()) {
+			changed = append(changed, path)
+		}
+	})
+	return changed
+}
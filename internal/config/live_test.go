@@ -0,0 +1,34 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLiveReload(t *testing.T) {
+	initial := Default()
+	live := NewLive(&initial)
+	var notified *Config
+	live.OnReload(func(cfg *Config) { notified = cfg })
+
+	next := Default()
+	next.Log.Level = "debug"
+	next.Limits.RateLimit.RPS = 10
+	next.Database.MaxConnectionPoolSize = 5
+	next.Server.Address = ":7000"
+	changed := live.Reload(&next)
+
+	current := live.Current()
+	if notified != current {
+		t.Error("expected the configuration in effect to be notified")
+	}
+	if current.Log.Level != "debug" || current.Limits.RateLimit.RPS != 10 {
+		t.Errorf("expected the reloadable settings to be applied, got %+v", current)
+	}
+	if current.Server.Address != initial.Server.Address || current.Database.MaxConnectionPoolSize != initial.Database.MaxConnectionPoolSize {
+		t.Errorf("expected settings needing a restart to keep their values, got %+v", current)
+	}
+	if want := []string{"server.address", "database.maxConnectionPoolSize"}; !slices.Equal(changed, want) {
+		t.Errorf("expected %v to need a restart, got %v", want, changed)
+	}
+}
@@ -0,0 +1,262 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Secret is a configuration value that is never shown, such as a password. In YAML it is either the value itself
+// or {file: path}; in the environment NAME_FILE names a file holding the value of NAME. Files are read whole,
+// without the trailing newline, so mounted Docker and Kubernetes secrets can be used as they are.
+type Secret string
+
+// Value returns the secret itself.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// String hides the secret, so it cannot end up in logs by accident.
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return "[redacted]"
+}
+
+func (s *Secret) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*s = Secret(node.Value)
+		return nil
+	}
+	var ref struct {
+		File string `yaml:"file"`
+	}
+	if err := node.Decode(&ref); err != nil {
+		return err
+	}
+	if ref.File == "" {
+		return fmt.Errorf("line %d: a secret is a value or {file: path}", node.Line)
+	}
+	value, err := readSecretFile(ref.File)
+	if err != nil {
+		return err
+	}
+	*s = Secret(value)
+	return nil
+}
+
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading secret: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+var (
+	secretType   = reflect.TypeFor[Secret]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// Load returns the configuration from, in increasing precedence, the defaults, the YAML file named by the
+// -config flag or CONFIG_FILE, the environment and the command line flags in args. Every setting has a flag
+// named by its path in the file, such as -database.url. The configuration is validated before it is returned.
+func Load(args []string) (*Config, error) {
+	flags := flag.NewFlagSet("service-atlas", flag.ContinueOnError)
+	file := flags.String("config", os.Getenv("CONFIG_FILE"), "YAML configuration `file`")
+	var overrides []func() error
+	cfg := Default()
+	visit(reflect.ValueOf(&cfg).Elem(), "", func(path string, field reflect.StructField, value reflect.Value) {
+		usage := "sets " + path
+		if env := field.Tag.Get("env"); env != "" {
+			usage += " (env " + strings.Split(env, ",")[0] + ")"
+		}
+		override := func(s string) error {
+			overrides = append(overrides, func() error { return set(value, s) })
+			return nil
+		}
+		if value.Kind() == reflect.Bool {
+			flags.BoolFunc(path, usage, override)
+		} else {
+			flags.Func(path, usage, override)
+		}
+	})
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *file != "" {
+		if err := loadFile(&cfg, *file); err != nil {
+			return nil, err
+		}
+	}
+	if err := loadEnv(&cfg); err != nil {
+		return nil, err
+	}
+	for _, override := range overrides {
+		if err := override(); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// loadFile decodes a YAML file over cfg. Unknown keys are rejected, so a typo does not silently fall back
+// to a default.
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading configuration: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv sets every field whose env variable is set. A field can list several variables, the first set wins.
+func loadEnv(cfg *Config) error {
+	var err error
+	visit(reflect.ValueOf(cfg).Elem(), "", func(path string, field reflect.StructField, value reflect.Value) {
+		if err != nil {
+			return
+		}
+		for _, name := range strings.Split(field.Tag.Get("env"), ",") {
+			if name == "" {
+				continue
+			}
+			s, ok := os.LookupEnv(name)
+			if !ok && value.Type() == secretType {
+				if file, found := os.LookupEnv(name + "_FILE"); found {
+					if s, err = readSecretFile(file); err != nil {
+						return
+					}
+					ok = true
+				}
+			}
+			if ok {
+				if setErr := set(value, s); setErr != nil {
+					err = fmt.Errorf("%s: %w", name, setErr)
+				}
+				return
+			}
+		}
+	})
+	return err
+}
+
+// visit calls fn for every setting in v, with its dotted YAML path.
+func visit(v reflect.Value, prefix string, fn func(path string, field reflect.StructField, value reflect.Value)) {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		path := prefix + strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if field.Type.Kind() == reflect.Struct {
+			visit(v.Field(i), path+".", fn)
+			continue
+		}
+		fn(path, field, v.Field(i))
+	}
+}
+
+// set parses s into a setting. Lists are written "a,b" and maps "key=value,...".
+func set(v reflect.Value, s string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	case v.Kind() == reflect.String:
+		v.SetString(s)
+		return nil
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case v.Kind() == reflect.Int || v.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case v.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	case v.Kind() == reflect.Slice:
+		var values []string
+		for _, value := range strings.Split(s, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		v.Set(reflect.ValueOf(values))
+		return nil
+	case v.Kind() == reflect.Map:
+		m := make(map[string]string)
+		for _, pair := range strings.Split(s, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("invalid entry %q, expected key=value", pair)
+			}
+			m[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+		v.Set(reflect.ValueOf(m))
+		return nil
+	}
+	return fmt.Errorf("unsupported setting type %s", v.Type())
+}
+
+// Redacted returns the effective configuration keyed by YAML path, for display. Secrets that are set are
+// replaced by "[redacted]" and durations are written like "10s".
+func (c Config) Redacted() map[string]any {
+	out := make(map[string]any)
+	visit(reflect.ValueOf(&c).Elem(), "", func(path string, _ reflect.StructField, value reflect.Value) {
+		section := out
+		keys := strings.Split(path, ".")
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := section[key].(map[string]any)
+			if !ok {
+				next = make(map[string]any)
+				section[key] = next
+			}
+			section = next
+		}
+		switch value.Type() {
+		case secretType:
+			section[keys[len(keys)-1]] = value.Interface().(Secret).String()
+		case durationType:
+			section[keys[len(keys)-1]] = value.Interface().(time.Duration).String()
+		default:
+			section[keys[len(keys)-1]] = value.Interface()
+		}
+	})
+	return out
+}
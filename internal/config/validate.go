@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"service-atlas/internal/versioning"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Validate reports every invalid setting at once, so a deployment can be fixed in one go.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	oneOf := func(path, value string, allowed ...string) {
+		if !slices.Contains(allowed, strings.ToLower(value)) {
+			fail("%s must be one of %s, got %q", path, strings.Join(allowed, ", "), value)
+		}
+	}
+	notNegative := func(path string, d time.Duration) {
+		if d < 0 {
+			fail("%s must not be negative", path)
+		}
+	}
+
+	oneOf("storage.backend", c.Storage.Backend, "neo4j", "memory", "file")
+	if c.Storage.Path == "" && strings.EqualFold(c.Storage.Backend, "file") {
+		fail("storage.path is required with the file storage backend")
+	}
+	if c.Database.URL == "" && c.usesNeo4j() {
+		fail("database.url is required (DB_URL)")
+	}
+	if c.Database.MaxConnectionPoolSize <= 0 {
+		fail("database.maxConnectionPoolSize must be positive")
+	}
+	notNegative("database.connectionAcquisitionTimeout", c.Database.ConnectionAcquisitionTimeout)
+	notNegative("database.maxTransactionRetryTime", c.Database.MaxTransactionRetryTime)
+	notNegative("database.transactionTimeout", c.Database.TransactionTimeout)
+
+	if c.Server.Address == "" {
+		fail("server.address is required")
+	}
+	notNegative("server.readHeaderTimeout", c.Server.ReadHeaderTimeout)
+	notNegative("server.readTimeout", c.Server.ReadTimeout)
+	notNegative("server.writeTimeout", c.Server.WriteTimeout)
+	notNegative("server.idleTimeout", c.Server.IdleTimeout)
+	notNegative("server.drainDelay", c.Server.DrainDelay)
+	notNegative("server.shutdownTimeout", c.Server.ShutdownTimeout)
+
+	tls := c.Server.TLS
+	if (tls.CertFile == "") != (tls.KeyFile == "") {
+		fail("server.tls.certFile and server.tls.keyFile must be set together")
+	}
+	oneOf("server.tls.clientAuth", tls.ClientAuth, "none", "optional", "require")
+	if mode := strings.ToLower(tls.ClientAuth); mode == "optional" || mode == "require" {
+		if tls.CertFile == "" {
+			fail("server.tls.clientAuth %s requires a certificate", mode)
+		}
+		if tls.ClientCAFile == "" {
+			fail("server.tls.clientCaFile is required to verify client certificates")
+		}
+	}
+	if tls.ReloadInterval <= 0 {
+		fail("server.tls.reloadInterval must be positive")
+	}
+	for _, scope := range c.Auth.ClientCertScopes {
+		oneOf("auth.clientCertScopes", scope, "read", "write:services", "write:debt", "admin")
+	}
+
+	if oidc := c.Auth.OIDC; oidc.Issuer != "" && oidc.JWKSURL == "" && oidc.JWKSFile == "" {
+		fail("auth.oidc.jwksUrl or auth.oidc.jwksFile is required with an issuer")
+	}
+
+	rate := c.Limits.RateLimit
+	if rate.RPS < 0 || rate.WriteRPS < 0 || rate.AddressRPS < 0 {
+		fail("limits.rateLimit rates must not be negative")
+	}
+	if rate.Burst < 0 || rate.WriteBurst < 0 || rate.AddressBurst < 0 {
+		fail("limits.rateLimit bursts must not be negative")
+	}
+	if c.Limits.MaxBodyBytes <= 0 {
+		fail("limits.maxBodyBytes must be positive")
+	}
+
+	oneOf("api.validation", c.API.Validation, "off", "requests", "all")
+	if c.API.LegacySunset != "" {
+		if _, err := versioning.ParseSunset(c.API.LegacySunset); err != nil {
+			fail("api.legacySunset: %v", err)
+		}
+	}
+
+	oneOf("events.sink", c.Events.Sink, "none", "stdout", "file", "http")
+	if kind := strings.ToLower(c.Events.Sink); (kind == "file" || kind == "http") && c.Events.Target == "" {
+		fail("events.target is required for the %s sink", kind)
+	}
+	if kind := strings.ToLower(c.Events.Sink); kind != "none" && !c.usesNeo4j() {
+		fail("events.sink %s needs the neo4j storage backend, which keeps the outbox", kind)
+	}
+	if c.Events.RelayInterval <= 0 {
+		fail("events.relayInterval must be positive")
+	}
+	if c.Events.Retention <= 0 {
+		fail("events.retention must be positive")
+	}
+
+	oneOf("log.level", c.Log.Level, "debug", "info", "warn", "warning", "error")
+	return errors.Join(errs...)
+}
+
+// usesNeo4j reports whether the catalog is kept in Neo4j, which is the default.
+func (c *Config) usesNeo4j() bool {
+	return !strings.EqualFold(c.Storage.Backend, "memory") && !strings.EqualFold(c.Storage.Backend, "file")
+}
@@ -1,6 +1,7 @@
 package customerrors
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 )
@@ -14,11 +15,39 @@ func (e HTTPError) Error() string {
 	return e.Msg
 }
 
+// ConflictError is a 409 for a change that would duplicate Existing, which is sent back so the caller can find it.
+type ConflictError struct {
+	HTTPError
+	Existing any
+}
+
+// NewConflictError returns a 409 with msg for a change conflicting with existing.
+func NewConflictError(msg string, existing any) *ConflictError {
+	return &ConflictError{HTTPError: HTTPError{Status: http.StatusConflict, Msg: msg}, Existing: existing}
+}
+
+// Unwrap exposes the status to callers matching *HTTPError.
+func (e *ConflictError) Unwrap() error {
+	return &e.HTTPError
+}
+
+// conflictBody is the body of a ConflictError response.
+type conflictBody struct {
+	Error    string `json:"error"`
+	Existing any    `json:"existing"`
+}
+
 func HandleError(rw http.ResponseWriter, err error) {
+	var conflictErr *ConflictError
 	var httpErr *HTTPError
-	if errors.As(err, &httpErr) {
+	switch {
+	case errors.As(err, &conflictErr):
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(conflictErr.Status)
+		_ = json.NewEncoder(rw).Encode(conflictBody{Error: conflictErr.Msg, Existing: conflictErr.Existing})
+	case errors.As(err, &httpErr):
 		http.Error(rw, httpErr.Error(), httpErr.Status)
-	} else {
+	default:
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
 	}
 }
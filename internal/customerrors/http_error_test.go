@@ -47,3 +47,22 @@ func TestHandleError_GenericError(t *testing.T) {
         t.Fatalf("unexpected body: got %q want %q", body, expectedBody)
     }
 }
+
+func TestHandleError_ConflictError(t *testing.T) {
+    rr := httptest.NewRecorder()
+    err := NewConflictError("name already used", map[string]string{"id": "abc"})
+
+    var httpErr *HTTPError
+    if !errors.As(err, &httpErr) || httpErr.Status != http.StatusConflict {
+        t.Fatalf("expected the conflict to be an HTTPError with status 409, got %v", httpErr)
+    }
+    HandleError(rr, err)
+
+    if rr.Code != http.StatusConflict {
+        t.Fatalf("expected status %d, got %d", http.StatusConflict, rr.Code)
+    }
+    expectedBody := `{"error":"name already used","existing":{"id":"abc"}}` + "\n"
+    if body := rr.Body.String(); body != expectedBody {
+        t.Fatalf("unexpected body: got %q want %q", body, expectedBody)
+    }
+}
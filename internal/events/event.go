@@ -0,0 +1,73 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Event types emitted when the catalog changes.
+const (
+	ServiceCreated         = "service.created"
+	ServiceUpdated         = "service.updated"
+	ServiceDeleted         = "service.deleted"
+	DebtCreated            = "debt.created"
+	DebtStatusUpdated      = "debt.status_updated"
+	ReleaseCreated         = "release.created"
+	DependencyCreated      = "dependency.created"
+	DependencyDeleted      = "dependency.deleted"
+	TeamCreated            = "team.created"
+	TeamUpdated            = "team.updated"
+	TeamDeleted            = "team.deleted"
+	TeamAssociationCreated = "team.association_created"
+	TeamAssociationDeleted = "team.association_deleted"
+	ApiKeyCreated          = "apikey.created"
+	ApiKeyRevoked          = "apikey.revoked"
+)
+
+// Aggregate types an event can describe.
+const (
+	AggregateService = "service"
+	AggregateDebt    = "debt"
+	AggregateTeam    = "team"
+	AggregateApiKey  = "apikey"
+)
+
+// Event is a domain event describing a single change to the catalog.
+// Events are written to the outbox in the same transaction as the change they describe
+// and later delivered to a Sink by the relay.
+type Event struct {
+	Id            string `json:"id"`
+	Type          string `json:"type"`
+	AggregateType string `json:"aggregateType"`
+	AggregateId   string `json:"aggregateId"`
+	RequestId     string `json:"requestId,omitempty"`
+	// Actor identifies the caller that made the change, such as apikey:<id>, when the request was authenticated.
+	Actor   string          `json:"actor,omitempty"`
+	Created time.Time       `json:"created"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// New builds an event of the given type for an aggregate, encoding payload as JSON.
+// The id and created time are assigned when the event is written to the outbox.
+func New(eventType, aggregateType, aggregateId string, payload any) (Event, error) {
+	event := Event{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateId:   aggregateId,
+	}
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Payload = body
+	}
+	return event, nil
+}
+
+// Sink receives events drained from the outbox.
+// Publish must return an error if the event was not durably accepted so the relay can retry it.
+type Sink interface {
+	Publish(ctx context.Context, event Event) error
+}
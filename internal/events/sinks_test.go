@@ -0,0 +1,156 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNew_EncodesPayload(t *testing.T) {
+	event, err := New(ServiceCreated, AggregateService, "svc-1", map[string]string{"name": "cart"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Type != ServiceCreated || event.AggregateType != AggregateService || event.AggregateId != "svc-1" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if string(event.Payload) != `{"name":"cart"}` {
+		t.Errorf("unexpected payload: %s", event.Payload)
+	}
+}
+
+func TestNew_NilPayload(t *testing.T) {
+	event, err := New(ServiceDeleted, AggregateService, "svc-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Payload != nil {
+		t.Errorf("expected empty payload, got %s", event.Payload)
+	}
+}
+
+func TestWriterSink_WritesJSONLines(t *testing.T) {
+	buf := &bytes.Buffer{}
+	sink := NewWriterSink(buf)
+	for _, id := range []string{"1", "2"} {
+		if err := sink.Publish(context.Background(), Event{Id: id, Type: ServiceCreated}); err != nil {
+			t.Fatalf("publish failed: %v", err)
+		}
+	}
+	scanner := bufio.NewScanner(buf)
+	var got []string
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("invalid json line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, e.Id)
+	}
+	if len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Errorf("unexpected events written: %v", got)
+	}
+}
+
+func TestFileSink_AppendsEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatalf("failed to create sink: %v", err)
+	}
+	if err = sink.Publish(context.Background(), Event{Id: "1", Type: TeamCreated}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if err = sink.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	// Reopening must append rather than truncate
+	sink, err = NewFileSink(path)
+	if err != nil {
+		t.Fatalf("failed to reopen sink: %v", err)
+	}
+	if err = sink.Publish(context.Background(), Event{Id: "2", Type: TeamDeleted}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	_ = sink.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if lines := bytes.Count(data, []byte("\n")); lines != 2 {
+		t.Errorf("expected 2 lines, got %d: %s", lines, data)
+	}
+}
+
+func TestHTTPSink_PostsEvent(t *testing.T) {
+	var received Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.Header.Get("Event-Type") != DebtCreated {
+			t.Errorf("expected Event-Type header %q, got %q", DebtCreated, r.Header.Get("Event-Type"))
+		}
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, 0)
+	if err := sink.Publish(context.Background(), Event{Id: "abc", Type: DebtCreated}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if received.Id != "abc" {
+		t.Errorf("expected event id abc, got %q", received.Id)
+	}
+}
+
+func TestHTTPSink_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := NewHTTPSink(server.URL, 0)
+	if err := sink.Publish(context.Background(), Event{Id: "abc"}); err == nil {
+		t.Error("expected error for non-2xx response")
+	}
+}
+
+func TestNewSink(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		target  string
+		wantErr bool
+	}{
+		{"Stdout", "stdout", "", false},
+		{"StdoutCaseInsensitive", "STDOUT", "", false},
+		{"File", "file", filepath.Join(t.TempDir(), "out.jsonl"), false},
+		{"FileMissingPath", "file", "", true},
+		{"HTTP", "http", "http://localhost:9999/events", false},
+		{"HTTPMissingURL", "http", "", true},
+		{"Unknown", "kafka", "", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, err := NewSink(tc.kind, tc.target)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewSink(%q, %q) error = %v, wantErr %v", tc.kind, tc.target, err, tc.wantErr)
+			}
+			if !tc.wantErr && sink == nil {
+				t.Fatal("expected sink, got nil")
+			}
+			if f, ok := sink.(*FileSink); ok {
+				_ = f.Close()
+			}
+		})
+	}
+}
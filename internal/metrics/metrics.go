@@ -0,0 +1,87 @@
+// Package metrics exposes Prometheus metrics about the API and its use of Neo4j.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "service_atlas"
+
+// registry holds the metrics recorded by the process, shared by every handler serving them.
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, route pattern and status.",
+	}, []string{"method", "route", "status"})
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method, route pattern and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	transactionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "neo4j",
+		Name:      "transaction_duration_seconds",
+		Help:      "Neo4j transaction latency by access mode, including retries.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"mode"})
+	transactionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "neo4j",
+		Name:      "transaction_errors_total",
+		Help:      "Neo4j transactions that failed, by access mode.",
+	}, []string{"mode"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration, transactionDuration, transactionErrors,
+	)
+}
+
+// UnmatchedRoute labels requests that matched no route, so unknown paths cannot inflate the number of series.
+const UnmatchedRoute = "unmatched"
+
+// ObserveRequest records an HTTP request. route is the pattern the request matched, such as /v1/services/{id}.
+func ObserveRequest(method, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = UnmatchedRoute
+	}
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// ObserveTransaction records a Neo4j transaction in mode "read" or "write", counting it as an error if failed.
+func ObserveTransaction(mode string, duration time.Duration, failed bool) {
+	transactionDuration.WithLabelValues(mode).Observe(duration.Seconds())
+	if failed {
+		transactionErrors.WithLabelValues(mode).Inc()
+	}
+}
+
+// Handler serves the process metrics along with extra, collectors that belong to the handler rather than the
+// process, in the Prometheus exposition format.
+func Handler(extra ...prometheus.Collector) http.Handler {
+	gatherers := prometheus.Gatherers{registry}
+	if len(extra) > 0 {
+		local := prometheus.NewRegistry()
+		local.MustRegister(extra...)
+		gatherers = append(gatherers, local)
+	}
+	return promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})
+}
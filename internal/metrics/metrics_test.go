@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func scrape(t *testing.T, h http.Handler) string {
+	t.Helper()
+	rw := httptest.NewRecorder()
+	h.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rw.Code)
+	}
+	return rw.Body.String()
+}
+
+func TestHandler_ExposesRecordedMetrics(t *testing.T) {
+	ObserveRequest(http.MethodGet, "/v1/services/{id}/", http.StatusNotFound, 20*time.Millisecond)
+	ObserveRequest(http.MethodGet, "", http.StatusNotFound, time.Millisecond)
+	ObserveTransaction("write", 5*time.Millisecond, true)
+
+	body := scrape(t, Handler())
+	for _, want := range []string{
+		`service_atlas_http_requests_total{method="GET",route="/v1/services/{id}/",status="404"} 1`,
+		`service_atlas_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`service_atlas_http_request_duration_seconds_bucket{method="GET",route="/v1/services/{id}/",status="404",le="0.025"} 1`,
+		`service_atlas_neo4j_transaction_duration_seconds_count{mode="write"} 1`,
+		`service_atlas_neo4j_transaction_errors_total{mode="write"} 1`,
+		"go_goroutines",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in metrics", want)
+		}
+	}
+}
+
+func TestHandler_ExtraCollectorsAreLocal(t *testing.T) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_extra", Help: "An extra gauge."})
+	gauge.Set(3)
+	if body := scrape(t, Handler(gauge)); !strings.Contains(body, "test_extra 3") {
+		t.Error("expected the extra gauge in metrics")
+	}
+	// a second handler can register the same collector without conflicting
+	if body := scrape(t, Handler(gauge)); !strings.Contains(body, "test_extra 3") {
+		t.Error("expected the extra gauge in metrics of a second handler")
+	}
+	if body := scrape(t, Handler()); strings.Contains(body, "test_extra") {
+		t.Error("expected the extra gauge only in the handlers it was given to")
+	}
+}
@@ -0,0 +1,199 @@
+// Package pagination pages list endpoints with opaque cursors.
+//
+// A cursor marks where the next page starts. Clients get it from the nextCursor field of a v2 response, or from the
+// Link header of any version, and send it back unchanged as the cursor query parameter.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/versioning"
+)
+
+const (
+	// DefaultLimit is the page size when the request does not give one.
+	DefaultLimit = 25
+	// MaxLimit is the largest page size a request may ask for.
+	MaxLimit = 100
+	// Unlimited is the limit of a page holding the whole list, as v1 returns the lists it never paged.
+	Unlimited = math.MaxInt32
+	// SearchLimit is the number of matches a service search returned before searches were paged, which v1 keeps.
+	SearchLimit = 50
+)
+
+// Page selects the items of a list to return.
+type Page struct {
+	// Offset is the number of items before the page.
+	Offset int
+	// Limit is the most items the page holds.
+	Limit int
+	// WithTotal asks for the length of the whole list as well.
+	WithTotal bool
+}
+
+// First returns the first page of up to limit items.
+func First(limit int) Page {
+	return Page{Limit: limit}
+}
+
+// Fetch is the number of items to read for the page. It is one more than Limit, so NewResult can tell whether another
+// page follows without a separate query.
+func (p Page) Fetch() int {
+	return p.Limit + 1
+}
+
+// Next returns the page following p.
+func (p Page) Next() Page {
+	return Page{Offset: p.Offset + p.Limit, Limit: p.Limit, WithTotal: p.WithTotal}
+}
+
+// Result is a page of a list.
+type Result[T any] struct {
+	Items []T `json:"items"`
+	// NextCursor fetches the following page, and is empty on the last one.
+	NextCursor string `json:"nextCursor,omitempty"`
+	// TotalCount is the length of the whole list, set when the page asked for it.
+	TotalCount *int64 `json:"totalCount,omitempty"`
+}
+
+// NewResult builds the result for page from up to page.Fetch() items read from its offset.
+func NewResult[T any](items []T, page Page) Result[T] {
+	result := Result[T]{Items: items}
+	if result.Items == nil {
+		result.Items = []T{}
+	}
+	if len(result.Items) > page.Limit {
+		result.Items = result.Items[:page.Limit]
+		result.NextCursor = EncodeCursor(page.Next().Offset)
+	}
+	return result
+}
+
+// Slice pages a list held in memory, counting it when the page asks for the total.
+func Slice[T any](all []T, page Page) Result[T] {
+	start := min(page.Offset, len(all))
+	end := min(start+page.Fetch(), len(all))
+	result := NewResult(all[start:end], page)
+	if page.WithTotal {
+		result.SetTotal(int64(len(all)))
+	}
+	return result
+}
+
+// SetTotal records the length of the whole list.
+func (r *Result[T]) SetTotal(total int64) {
+	r.TotalCount = &total
+}
+
+// Map converts the items of a result, keeping its cursor and total.
+func Map[T, U any](result Result[T], convert func(T) U) Result[U] {
+	items := make([]U, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = convert(item)
+	}
+	return Result[U]{Items: items, NextCursor: result.NextCursor, TotalCount: result.TotalCount}
+}
+
+// All reads every page of a list from fetch, limit items at a time, for callers that need the whole list.
+func All[T any](limit int, fetch func(Page) (Result[T], error)) ([]T, error) {
+	items := make([]T, 0)
+	page := First(limit)
+	for {
+		result, err := fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, result.Items...)
+		if result.NextCursor == "" {
+			return items, nil
+		}
+		page = page.Next()
+	}
+}
+
+// cursor is what a cursor encodes. It is only ever read back by DecodeCursor, so it may change between releases.
+type cursor struct {
+	Offset int `json:"o"`
+}
+
+// EncodeCursor returns the cursor of the page starting at offset.
+func EncodeCursor(offset int) string {
+	data, _ := json.Marshal(cursor{Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor returns the offset a cursor starts at.
+func DecodeCursor(value string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return 0, invalid("cursor")
+	}
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || c.Offset < 0 {
+		return 0, invalid("cursor")
+	}
+	return c.Offset, nil
+}
+
+// FromRequest reads the page from the cursor, limit and totalCount query parameters. The page and pageSize
+// parameters from before cursors are still understood, pageSize standing in for limit. v1 requests without a limit
+// get pages of v1Limit, so lists keep the size they had before cursors; it is Unlimited for lists v1 never paged.
+func FromRequest(r *http.Request, v1Limit int) (Page, error) {
+	query := r.URL.Query()
+	page := Page{Limit: DefaultLimit, WithTotal: query.Get("totalCount") == "true"}
+	if versioning.FromContext(r.Context()) == versioning.V1 {
+		page.Limit = v1Limit
+	}
+
+	limit := query.Get("limit")
+	if limit == "" {
+		limit = query.Get("pageSize")
+	}
+	if limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 || parsed > MaxLimit {
+			return Page{}, &customerrors.HTTPError{
+				Status: http.StatusBadRequest,
+				Msg:    "limit must be between 1 and " + strconv.Itoa(MaxLimit),
+			}
+		}
+		page.Limit = parsed
+	}
+
+	value, number := query.Get("cursor"), query.Get("page")
+	switch {
+	case value != "" && number != "":
+		return Page{}, &customerrors.HTTPError{Status: http.StatusBadRequest, Msg: "Give either cursor or page, not both"}
+	case value != "":
+		offset, err := DecodeCursor(value)
+		if err != nil {
+			return Page{}, err
+		}
+		page.Offset = offset
+	case number != "":
+		parsed, err := strconv.Atoi(number)
+		if err != nil || parsed < 1 {
+			return Page{}, invalid("page")
+		}
+		page.Offset = (parsed - 1) * page.Limit
+	}
+	return page, nil
+}
+
+// FromPage converts a page number and size, as taken by the GraphQL and gRPC APIs, clamping them to valid values.
+func FromPage(number, size int) Page {
+	if size < 1 {
+		size = DefaultLimit
+	}
+	size = min(size, MaxLimit)
+	return Page{Offset: (max(number, 1) - 1) * size, Limit: size}
+}
+
+func invalid(parameter string) error {
+	return &customerrors.HTTPError{Status: http.StatusBadRequest, Msg: "Invalid " + parameter + " parameter"}
+}
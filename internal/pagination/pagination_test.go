@@ -0,0 +1,147 @@
+package pagination
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/versioning"
+	"testing"
+)
+
+// routed returns a request for the services list with query, as routed to version.
+func routed(version versioning.Version, query string) (routed *http.Request) {
+	capture := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) { routed = r })
+	r := httptest.NewRequest(http.MethodGet, version.Prefix()+"/services"+query, nil)
+	versioning.WithVersion(version)(capture).ServeHTTP(httptest.NewRecorder(), r)
+	return routed
+}
+
+func TestFromRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  Page
+	}{
+		{"Defaults", "", Page{Limit: DefaultLimit}},
+		{"Cursor", "?cursor=" + EncodeCursor(40) + "&limit=20", Page{Offset: 40, Limit: 20}},
+		{"Total", "?limit=5&totalCount=true", Page{Limit: 5, WithTotal: true}},
+		{"LegacyPage", "?page=3&pageSize=10", Page{Offset: 20, Limit: 10}},
+		{"LegacyPageWithDefaultSize", "?page=2", Page{Offset: DefaultLimit, Limit: DefaultLimit}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FromRequest(routed(versioning.V2, tc.query), 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromRequest_Invalid(t *testing.T) {
+	for _, query := range []string{
+		"?limit=0",
+		"?limit=101",
+		"?pageSize=abc",
+		"?page=0",
+		"?cursor=not-a-cursor",
+		"?cursor=" + EncodeCursor(-5),
+		"?cursor=" + EncodeCursor(10) + "&page=2",
+	} {
+		_, err := FromRequest(routed(versioning.V2, query), DefaultLimit)
+		var httpErr *customerrors.HTTPError
+		if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest {
+			t.Errorf("%s: expected a 400, got %v", query, err)
+		}
+	}
+}
+
+func TestFromRequest_V1Limit(t *testing.T) {
+	v1 := func(query string) *http.Request { return routed(versioning.V1, query) }
+	tests := []struct {
+		name    string
+		r       *http.Request
+		v1Limit int
+		want    Page
+	}{
+		{"V1Default", v1(""), 10, Page{Limit: 10}},
+		{"V1Unlimited", v1(""), Unlimited, Page{Limit: Unlimited}},
+		{"V1Limit", v1("?limit=5"), Unlimited, Page{Limit: 5}},
+		{"V1Page", v1("?page=2"), 10, Page{Offset: 10, Limit: 10}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FromRequest(tc.r, tc.v1Limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromPage(t *testing.T) {
+	tests := []struct {
+		number, size int
+		want         Page
+	}{
+		{1, 10, Page{Limit: 10}},
+		{3, 10, Page{Offset: 20, Limit: 10}},
+		{0, 0, Page{Limit: DefaultLimit}},
+		{2, 500, Page{Offset: MaxLimit, Limit: MaxLimit}},
+	}
+	for _, tc := range tests {
+		if got := FromPage(tc.number, tc.size); got != tc.want {
+			t.Errorf("FromPage(%d, %d) = %+v, want %+v", tc.number, tc.size, got, tc.want)
+		}
+	}
+}
+
+func TestSlice(t *testing.T) {
+	all := []int{1, 2, 3, 4, 5}
+
+	first := Slice(all, Page{Limit: 2, WithTotal: true})
+	if len(first.Items) != 2 || first.Items[0] != 1 || first.TotalCount == nil || *first.TotalCount != 5 {
+		t.Errorf("unexpected first page %+v", first)
+	}
+	offset, err := DecodeCursor(first.NextCursor)
+	if err != nil || offset != 2 {
+		t.Errorf("expected the cursor to start at 2, got %d, %v", offset, err)
+	}
+
+	last := Slice(all, Page{Offset: 4, Limit: 2})
+	if len(last.Items) != 1 || last.Items[0] != 5 || last.NextCursor != "" || last.TotalCount != nil {
+		t.Errorf("unexpected last page %+v", last)
+	}
+
+	past := Slice(all, Page{Offset: 10, Limit: 2})
+	if past.Items == nil || len(past.Items) != 0 || past.NextCursor != "" {
+		t.Errorf("expected an empty page past the end, got %+v", past)
+	}
+}
+
+func TestMap(t *testing.T) {
+	result := Slice([]int{1, 2, 3}, Page{Limit: 2, WithTotal: true})
+	mapped := Map(result, func(i int) int { return i * 10 })
+	if len(mapped.Items) != 2 || mapped.Items[1] != 20 || mapped.NextCursor != result.NextCursor || mapped.TotalCount != result.TotalCount {
+		t.Errorf("unexpected mapped result %+v", mapped)
+	}
+}
+
+func TestAll(t *testing.T) {
+	all := []int{1, 2, 3, 4, 5}
+	calls := 0
+	got, err := All(2, func(page Page) (Result[int], error) {
+		calls++
+		return Slice(all, page), nil
+	})
+	if err != nil || len(got) != 5 || got[4] != 5 || calls != 3 {
+		t.Errorf("got %v after %d calls: %v", got, calls, err)
+	}
+}
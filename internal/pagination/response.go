@@ -0,0 +1,52 @@
+package pagination
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"service-atlas/internal"
+	"service-atlas/internal/versioning"
+)
+
+// Write sends the result of a list request. A Link header points at the next page in every version. v2 sends the
+// Result itself, while v1 sends the bare items as it always has, with the total in an X-Total-Count header.
+func Write[T any](rw http.ResponseWriter, r *http.Request, result Result[T]) {
+	SetNextLink(rw, r, result.NextCursor)
+	rw.Header().Set("Content-Type", "application/json")
+
+	var body any = result
+	if versioning.FromContext(r.Context()) == versioning.V1 {
+		if result.TotalCount != nil {
+			rw.Header().Set("X-Total-Count", strconv.FormatInt(*result.TotalCount, 10))
+		}
+		body = result.Items
+	}
+	if err := json.NewEncoder(rw).Encode(body); err != nil {
+		internal.LoggerFromContext(r.Context()).Debug("Error encoding list json",
+			slog.String("error", err.Error()))
+	}
+}
+
+// SetNextLink adds the Link header pointing at the page starting at cursor, unless it is empty, for responses that
+// carry a page of a list in a shape of their own.
+func SetNextLink(rw http.ResponseWriter, r *http.Request, cursor string) {
+	if cursor != "" {
+		rw.Header().Add("Link", "<"+nextURL(r, cursor)+">; rel=\"next\"")
+	}
+}
+
+// nextURL is the request URL with its page replaced by cursor.
+func nextURL(r *http.Request, cursor string) string {
+	query := r.URL.Query()
+	if size := query.Get("pageSize"); size != "" && query.Get("limit") == "" {
+		query.Set("limit", size)
+	}
+	query.Del("page")
+	query.Del("pageSize")
+	query.Set("cursor", cursor)
+	next := *r.URL
+	next.RawQuery = query.Encode()
+	return next.RequestURI()
+}
@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/versioning"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	result := Slice([]string{"a", "b", "c"}, Page{Limit: 2, WithTotal: true})
+	next := EncodeCursor(2)
+	tests := []struct {
+		name    string
+		version versioning.Version
+		target  string
+		link    string
+		total   string
+		body    string
+	}{
+		{"V1", versioning.V1, "/v1/teams?limit=2&totalCount=true",
+			"</v1/teams?cursor=" + next + "&limit=2&totalCount=true>; rel=\"next\"", "3", `["a","b"]`},
+		{"V2", versioning.V2, "/v2/teams?limit=2&totalCount=true",
+			"</v2/teams?cursor=" + next + "&limit=2&totalCount=true>; rel=\"next\"", "",
+			`{"items":["a","b"],"nextCursor":"` + next + `","totalCount":3}`},
+		{"LegacyPage", versioning.V1, "/teams?page=1&pageSize=2&name=x",
+			"</teams?cursor=" + next + "&limit=2&name=x>; rel=\"next\"", "3", `["a","b"]`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := httptest.NewRecorder()
+			var handler http.Handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				Write(rw, r, result)
+			})
+			versioning.WithVersion(tc.version)(handler).ServeHTTP(rw, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			if got := rw.Header().Get("Link"); got != tc.link {
+				t.Errorf("got Link %q, want %q", got, tc.link)
+			}
+			if got := rw.Header().Get("X-Total-Count"); got != tc.total {
+				t.Errorf("got X-Total-Count %q, want %q", got, tc.total)
+			}
+			if got := rw.Body.String(); got != tc.body+"\n" {
+				t.Errorf("got body %q, want %q", got, tc.body)
+			}
+		})
+	}
+}
+
+func TestWrite_LastPage(t *testing.T) {
+	rw := httptest.NewRecorder()
+	Write(rw, httptest.NewRequest(http.MethodGet, "/teams", nil), Slice([]string{}, Page{Limit: 2}))
+	if got := rw.Header().Get("Link"); got != "" {
+		t.Errorf("expected no Link header on the last page, got %q", got)
+	}
+	if got := rw.Body.String(); got != "[]\n" {
+		t.Errorf("expected an empty array, got %q", got)
+	}
+}
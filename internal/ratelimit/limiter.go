@@ -0,0 +1,99 @@
+// Package ratelimit limits how fast each client can call the API, using a token bucket per client.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Config is the rate and burst of a token bucket. Each request takes a token; tokens refill at Rate per second
+// up to Burst, so a client can make Burst requests at once and Rate per second sustained.
+type Config struct {
+	Rate  float64
+	Burst int
+}
+
+// Decision is the outcome of taking a token, with the state of the bucket afterwards.
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until a token is available, when not allowed.
+	RetryAfter time.Duration
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter holds a token bucket per key. Buckets idle long enough to have refilled are dropped, so memory is
+// bounded by the number of clients active within Burst/Rate seconds.
+type Limiter struct {
+	cfg Config
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func New(cfg Config) *Limiter {
+	if cfg.Burst < 1 {
+		cfg.Burst = 1
+	}
+	return &Limiter{cfg: cfg, now: time.Now, buckets: make(map[string]*bucket)}
+}
+
+// Allow takes a token from the bucket of key, if one is available.
+func (l *Limiter) Allow(key string) Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.cfg.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(l.cfg.Burst), b.tokens+now.Sub(b.last).Seconds()*l.cfg.Rate)
+	b.last = now
+
+	decision := Decision{Limit: l.cfg.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = l.duration(1 - b.tokens)
+	}
+	decision.Remaining = int(b.tokens)
+	decision.Reset = l.duration(float64(l.cfg.Burst) - b.tokens)
+	return decision
+}
+
+// Window is how long an empty bucket takes to refill.
+func (l *Limiter) Window() time.Duration {
+	return l.duration(float64(l.cfg.Burst))
+}
+
+func (l *Limiter) duration(tokens float64) time.Duration {
+	return time.Duration(tokens / l.cfg.Rate * float64(time.Second))
+}
+
+// sweep drops buckets that have refilled, at most once per window.
+func (l *Limiter) sweep(now time.Time) {
+	window := l.Window()
+	if now.Sub(l.lastSweep) < window {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= window {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+func newLimiter(cfg Config) (*Limiter, *clock) {
+	c := &clock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	l := New(cfg)
+	l.now = c.now
+	return l, c
+}
+
+func TestLimiter_BurstThenRefill(t *testing.T) {
+	l, c := newLimiter(Config{Rate: 2, Burst: 3})
+	for i := 2; i >= 0; i-- {
+		d := l.Allow("a")
+		if !d.Allowed || d.Remaining != i {
+			t.Fatalf("expected allowed with %d remaining, got %+v", i, d)
+		}
+	}
+	d := l.Allow("a")
+	if d.Allowed || d.RetryAfter != 500*time.Millisecond || d.Reset != 1500*time.Millisecond {
+		t.Fatalf("expected denial retrying after 500ms, got %+v", d)
+	}
+	c.advance(500 * time.Millisecond)
+	if d = l.Allow("a"); !d.Allowed || d.Remaining != 0 {
+		t.Errorf("expected a refilled token, got %+v", d)
+	}
+}
+
+func TestLimiter_KeysAreIndependent(t *testing.T) {
+	l, _ := newLimiter(Config{Rate: 1, Burst: 1})
+	if !l.Allow("a").Allowed || l.Allow("a").Allowed {
+		t.Fatal("expected a to get exactly one request")
+	}
+	if !l.Allow("b").Allowed {
+		t.Error("expected b to have its own bucket")
+	}
+}
+
+func TestLimiter_SweepsRefilledBuckets(t *testing.T) {
+	l, c := newLimiter(Config{Rate: 1, Burst: 2})
+	l.Allow("a")
+	c.advance(time.Second)
+	l.Allow("b")
+	c.advance(1500 * time.Millisecond)
+	l.Allow("c")
+	if _, ok := l.buckets["a"]; ok {
+		t.Error("expected refilled bucket a to be dropped")
+	}
+	if _, ok := l.buckets["b"]; !ok {
+		t.Error("expected bucket b to be kept")
+	}
+}
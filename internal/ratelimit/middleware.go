@@ -0,0 +1,197 @@
+package ratelimit
+
+import (
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"service-atlas/internal"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/config"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Limits are the limiters applied to API requests. Either can be nil to disable it.
+type Limits struct {
+	// All applies to every request.
+	All *Limiter
+	// Writes additionally applies to requests that change data, so a script cannot write as fast as clients read.
+	Writes *Limiter
+	// Address applies to every request by client address before it is authenticated, so clients flooding the
+	// service with bad credentials are limited too.
+	Address *Limiter
+	// TrustForwarded keys anonymous clients by the address the proxy in front of the service appended to
+	// X-Forwarded-For rather than the connection address, which is only safe behind a proxy that sets it.
+	// Entries to its left are written by the client, so they are never used.
+	TrustForwarded bool
+}
+
+// FromConfig creates the limits configured. A limit is disabled when its rate is zero; bursts default to twice the rate.
+func FromConfig(cfg config.RateLimit) Limits {
+	return Limits{
+		All:            limiter(cfg.RPS, cfg.Burst),
+		Writes:         limiter(cfg.WriteRPS, cfg.WriteBurst),
+		Address:        limiter(cfg.AddressRPS, cfg.AddressBurst),
+		TrustForwarded: cfg.TrustForwarded,
+	}
+}
+
+func limiter(rate float64, burst int) *Limiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = int(math.Ceil(rate * 2))
+	}
+	return New(Config{Rate: rate, Burst: burst})
+}
+
+// Middleware rejects clients over their limits with a 429. Authenticated clients are limited by identity, so an
+// API key or user shares one budget across addresses; anonymous clients are limited by IP address.
+// Responses carry RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset and RateLimit-Policy headers
+// for the most constraining limit applied.
+func (l Limits) Middleware(next http.Handler) http.Handler {
+	if l.All == nil && l.Writes == nil {
+		return next
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		limit(next, rw, r, l.key(r), l.All, l.writes(r))
+	})
+}
+
+// AddressMiddleware rejects clients over the address limit with a 429, as Middleware does. It is applied before
+// authentication, so every request from an address counts whatever credentials it carries.
+func (l Limits) AddressMiddleware(next http.Handler) http.Handler {
+	if l.Address == nil {
+		return next
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		limit(next, rw, r, l.address(r), l.Address)
+	})
+}
+
+func limit(next http.Handler, rw http.ResponseWriter, r *http.Request, key string, limiters ...*Limiter) {
+	var limiter *Limiter
+	var decision Decision
+	for _, candidate := range limiters {
+		if candidate == nil {
+			continue
+		}
+		d := candidate.Allow(key)
+		if limiter == nil || !d.Allowed || (decision.Allowed && d.Remaining < decision.Remaining) {
+			limiter, decision = candidate, d
+		}
+		if !d.Allowed {
+			break
+		}
+	}
+
+	header := rw.Header()
+	header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	header.Set("RateLimit-Reset", seconds(decision.Reset))
+	header.Set("RateLimit-Policy", strconv.Itoa(decision.Limit)+";w="+seconds(limiter.Window()))
+	if !decision.Allowed {
+		internal.LoggerFromContext(r.Context()).Warn("Rate limited", slog.String("client", key))
+		header.Set("Retry-After", seconds(decision.RetryAfter))
+		http.Error(rw, "Too many requests", http.StatusTooManyRequests)
+		return
+	}
+	next.ServeHTTP(rw, r)
+}
+
+// Reloadable are limits that can be replaced while serving. A limiter whose rate and burst are unchanged by a reload
+// is kept, so clients keep their remaining budget.
+type Reloadable struct {
+	mu     sync.Mutex
+	cfg    config.RateLimit
+	limits atomic.Pointer[Limits]
+}
+
+func NewReloadable(cfg config.RateLimit) *Reloadable {
+	r := &Reloadable{cfg: cfg}
+	limits := FromConfig(cfg)
+	r.limits.Store(&limits)
+	return r
+}
+
+// Reload applies cfg to the requests that follow.
+func (r *Reloadable) Reload(cfg config.RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	current := *r.limits.Load()
+	next := FromConfig(cfg)
+	if cfg.RPS == r.cfg.RPS && cfg.Burst == r.cfg.Burst {
+		next.All = current.All
+	}
+	if cfg.WriteRPS == r.cfg.WriteRPS && cfg.WriteBurst == r.cfg.WriteBurst {
+		next.Writes = current.Writes
+	}
+	if current.Address != nil && next.Address != nil && current.Address.cfg == next.Address.cfg {
+		next.Address = current.Address
+	}
+	r.cfg = cfg
+	r.limits.Store(&next)
+}
+
+// Middleware applies the limits in effect when each request arrives, as Limits.Middleware does.
+func (r *Reloadable) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		limits := r.limits.Load()
+		if limits.All == nil && limits.Writes == nil {
+			next.ServeHTTP(rw, req)
+			return
+		}
+		limit(next, rw, req, limits.key(req), limits.All, limits.writes(req))
+	})
+}
+
+// AddressMiddleware applies the address limit in effect when each request arrives, as Limits.AddressMiddleware does.
+func (r *Reloadable) AddressMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		limits := r.limits.Load()
+		if limits.Address == nil {
+			next.ServeHTTP(rw, req)
+			return
+		}
+		limit(next, rw, req, limits.address(req), limits.Address)
+	})
+}
+
+func (l Limits) writes(r *http.Request) *Limiter {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+	return l.Writes
+}
+
+func (l Limits) key(r *http.Request) string {
+	if identity, ok := auth.IdentityFromContext(r.Context()); ok {
+		return identity.Subject()
+	}
+	return l.address(r)
+}
+
+func (l Limits) address(r *http.Request) string {
+	if l.TrustForwarded {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			client := forwarded[strings.LastIndex(forwarded, ",")+1:]
+			return "ip:" + strings.TrimSpace(client)
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// seconds rounds a duration up to whole seconds, as the RateLimit and Retry-After headers expect.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/config"
+	"testing"
+)
+
+var ok = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {})
+
+func serve(h http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	rw := httptest.NewRecorder()
+	h.ServeHTTP(rw, r)
+	return rw
+}
+
+func TestMiddleware_Headers(t *testing.T) {
+	h := Limits{All: New(Config{Rate: 1, Burst: 2})}.Middleware(ok)
+	rw := serve(h, httptest.NewRequest(http.MethodGet, "/", nil))
+	want := map[string]string{"RateLimit-Limit": "2", "RateLimit-Remaining": "1", "RateLimit-Reset": "1", "RateLimit-Policy": "2;w=2"}
+	for name, value := range want {
+		if got := rw.Header().Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+	serve(h, httptest.NewRequest(http.MethodGet, "/", nil))
+	rw = serve(h, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rw.Code != http.StatusTooManyRequests || rw.Header().Get("Retry-After") != "1" {
+		t.Errorf("expected 429 retrying after 1s, got %d %q", rw.Code, rw.Header().Get("Retry-After"))
+	}
+}
+
+func TestMiddleware_WritesHaveTheirOwnLimit(t *testing.T) {
+	h := Limits{All: New(Config{Rate: 10, Burst: 10}), Writes: New(Config{Rate: 1, Burst: 1})}.Middleware(ok)
+	if rw := serve(h, httptest.NewRequest(http.MethodPost, "/", nil)); rw.Code != http.StatusOK || rw.Header().Get("RateLimit-Limit") != "1" {
+		t.Fatalf("expected write to pass under the write limit, got %d %v", rw.Code, rw.Header())
+	}
+	if rw := serve(h, httptest.NewRequest(http.MethodPost, "/", nil)); rw.Code != http.StatusTooManyRequests {
+		t.Errorf("expected second write to be limited, got %d", rw.Code)
+	}
+	if rw := serve(h, httptest.NewRequest(http.MethodGet, "/", nil)); rw.Code != http.StatusOK {
+		t.Errorf("expected reads to be unaffected, got %d", rw.Code)
+	}
+}
+
+func TestMiddleware_Keys(t *testing.T) {
+	withIdentity := func(r *http.Request) *http.Request {
+		return r.WithContext(auth.WithIdentity(r.Context(), auth.Identity{Kind: "apikey", Id: "k1"}))
+	}
+	forwarded := func(r *http.Request) *http.Request {
+		r.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+		return r
+	}
+	tests := []struct {
+		name    string
+		limits  Limits
+		prepare func(*http.Request) *http.Request
+		key     string
+	}{
+		{"Identity", Limits{}, withIdentity, "apikey:k1"},
+		{"RemoteAddr", Limits{}, func(r *http.Request) *http.Request { return r }, "ip:192.0.2.1"},
+		{"ForwardedIgnored", Limits{}, forwarded, "ip:192.0.2.1"},
+		{"ForwardedTrusted", Limits{TrustForwarded: true}, forwarded, "ip:10.0.0.1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if key := tc.limits.key(tc.prepare(httptest.NewRequest(http.MethodGet, "/", nil))); key != tc.key {
+				t.Errorf("got key %q, want %q", key, tc.key)
+			}
+		})
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	limits := FromConfig(config.RateLimit{RPS: 5, WriteRPS: 0.5, WriteBurst: 3})
+	if limits.All == nil || limits.All.cfg != (Config{Rate: 5, Burst: 10}) {
+		t.Errorf("unexpected limit %+v", limits.All)
+	}
+	if limits.Writes == nil || limits.Writes.cfg != (Config{Rate: 0.5, Burst: 3}) {
+		t.Errorf("unexpected write limit %+v", limits.Writes)
+	}
+	if limits.Address != nil {
+		t.Errorf("expected the address limit to be off unless its rate is set, got %+v", limits.Address)
+	}
+	if limits = FromConfig(config.RateLimit{RPS: 5, AddressRPS: 50}); limits.Address.cfg != (Config{Rate: 50, Burst: 100}) {
+		t.Errorf("unexpected address limit %+v", limits.Address)
+	}
+	if FromConfig(config.RateLimit{}).All != nil || FromConfig(config.RateLimit{}).Address != nil {
+		t.Error("expected a zero rate to disable the limit")
+	}
+}
+
+func TestAddressMiddleware_IgnoresIdentity(t *testing.T) {
+	h := Limits{Address: New(Config{Rate: 1, Burst: 1})}.AddressMiddleware(ok)
+	for i, kind := range []string{"apikey", "jwt"} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r = r.WithContext(auth.WithIdentity(r.Context(), auth.Identity{Kind: kind, Id: "k1"}))
+		want := http.StatusOK
+		if i > 0 {
+			want = http.StatusTooManyRequests
+		}
+		if rw := serve(h, r); rw.Code != want {
+			t.Errorf("request %d: expected %d, got %d", i, want, rw.Code)
+		}
+	}
+}
+
+func TestReloadable(t *testing.T) {
+	limits := NewReloadable(config.RateLimit{RPS: 1, Burst: 1, WriteRPS: 1, WriteBurst: 1})
+	h := limits.Middleware(ok)
+	serve(h, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rw := serve(h, httptest.NewRequest(http.MethodGet, "/", nil)); rw.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the second request to be limited, got %d", rw.Code)
+	}
+
+	// the unchanged limit keeps its exhausted budget, while the changed one starts afresh
+	limits.Reload(config.RateLimit{RPS: 1, Burst: 1, WriteRPS: 5, WriteBurst: 5})
+	if rw := serve(h, httptest.NewRequest(http.MethodGet, "/", nil)); rw.Code != http.StatusTooManyRequests {
+		t.Errorf("expected the unchanged limit to be kept, got %d", rw.Code)
+	}
+	limits.Reload(config.RateLimit{})
+	if rw := serve(h, httptest.NewRequest(http.MethodGet, "/", nil)); rw.Code != http.StatusOK || rw.Header().Get("RateLimit-Limit") != "" {
+		t.Errorf("expected limits to be disabled, got %d %v", rw.Code, rw.Header())
+	}
+}
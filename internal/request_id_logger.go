@@ -33,13 +33,18 @@ func RequestIDLogger(next http.Handler) http.Handler {
 		if id == "" {
 			id = uuid.NewString()
 		}
-		logger := slog.Default().With(slog.String(requestKey, id))
-		ctx := context.WithValue(r.Context(), LoggerKey{}, logger)
-		ctx = context.WithValue(ctx, requestIDKey{}, id)
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(WithRequestId(r.Context(), id)))
 	})
 }
 
+// WithRequestId returns a copy of ctx carrying the request id and a logger tagged with it.
+// It lets transports other than HTTP, such as gRPC, share the request id and logging behaviour.
+func WithRequestId(ctx context.Context, id string) context.Context {
+	logger := slog.Default().With(slog.String(requestKey, id))
+	ctx = context.WithValue(ctx, LoggerKey{}, logger)
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
 // GetRequestIdFromContext retrieves the request id stored in the context.
 // It returns an empty string if the value is missing or not a string.
 func GetRequestIdFromContext(ctx context.Context) string {
@@ -0,0 +1,262 @@
+// Package searchquery parses the service search language, which mixes free text with field filters:
+//
+//	kafka "order svc" type:api team:payments owner:none name:"order svc" tag:pci -lifecycle:retired
+//
+// Words and quoted phrases are matched against the full-text index, while field:value pairs filter on the
+// properties of the service. A filter prefixed with - excludes the services it matches. A word whose part before
+// the colon is not a field, such as a url, is free text.
+package searchquery
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+
+	"service-atlas/internal"
+	"service-atlas/internal/customerrors"
+)
+
+// Field is a property a query can filter services on.
+type Field string
+
+const (
+	// FieldType matches the service type, ignoring case.
+	FieldType Field = "type"
+	// FieldTeam matches the id or name of a team owning the service, ignoring case, or services without an owner
+	// when the value is none. owner is accepted as another name for it.
+	FieldTeam Field = "team"
+	// FieldName matches services whose name contains the value, ignoring case.
+	FieldName Field = "name"
+	// FieldLifecycle matches the lifecycle stage of the service.
+	FieldLifecycle Field = "lifecycle"
+	// FieldTag matches services with the tag.
+	FieldTag Field = "tag"
+)
+
+// fields maps the name of each field in a query to the field.
+var fields = map[string]Field{
+	"type":      FieldType,
+	"team":      FieldTeam,
+	"owner":     FieldTeam,
+	"name":      FieldName,
+	"lifecycle": FieldLifecycle,
+	"tag":       FieldTag,
+}
+
+// None is the team filter value matching services without an owner.
+const None = "none"
+
+// Term is free text to match against the full-text index.
+type Term struct {
+	Text string
+	// Phrase is set for quoted text, which must match as a whole rather than word by word.
+	Phrase bool
+}
+
+// Filter is a field:value pair.
+type Filter struct {
+	Field Field
+	Value string
+	// Negated filters exclude the services they match.
+	Negated bool
+}
+
+// Unowned reports whether the filter matches services by their having no owner.
+func (f Filter) Unowned() bool {
+	return f.Field == FieldTeam && strings.EqualFold(f.Value, None)
+}
+
+// Query is a parsed search. Services match when they match every term and filter.
+type Query struct {
+	Terms   []Term
+	Filters []Filter
+}
+
+// IsEmpty reports whether the query has neither terms nor filters.
+func (q Query) IsEmpty() bool {
+	return len(q.Terms) == 0 && len(q.Filters) == 0
+}
+
+// Text returns a query of every word of text as a term, for callers taking free text only.
+func Text(text string) Query {
+	var q Query
+	for _, word := range strings.Fields(text) {
+		q.Terms = append(q.Terms, Term{Text: word})
+	}
+	return q
+}
+
+// Lucene returns the full-text query matching every term, or an empty string when there are none. Words match
+// fuzzily and phrases exactly. Terms are lower-cased and escaped, so no Lucene syntax in them takes effect.
+func (q Query) Lucene() string {
+	clauses := make([]string, 0, len(q.Terms))
+	for _, term := range q.Terms {
+		text := escape(strings.ToLower(term.Text))
+		if term.Phrase {
+			clauses = append(clauses, `"`+text+`"`)
+		} else {
+			clauses = append(clauses, text+"~")
+		}
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// escape backslash-escapes the characters Lucene gives a meaning to.
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if strings.ContainsRune(`+-&|!(){}[]^"~*?:\/`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SyntaxError is a query that could not be parsed. It is a 400 to HTTP callers.
+type SyntaxError struct {
+	// Pos is the position of the problem in the query, counting characters from 1.
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid search query at position %d: %s", e.Pos, e.Msg)
+}
+
+// Unwrap exposes the error as a 400 to callers matching *customerrors.HTTPError.
+func (e *SyntaxError) Unwrap() error {
+	return &customerrors.HTTPError{Status: http.StatusBadRequest, Msg: e.Error()}
+}
+
+// Parse parses a search query.
+func Parse(input string) (Query, error) {
+	p := parser{input: []rune(input)}
+	var q Query
+	for {
+		p.skipSpace()
+		if p.done() {
+			return q, nil
+		}
+		start := p.pos
+		negated := p.peek() == '-'
+		if negated {
+			p.pos++
+		}
+
+		if p.peek() == '"' {
+			phrase, err := p.quoted()
+			if err != nil {
+				return Query{}, err
+			}
+			if negated {
+				return Query{}, p.errorAt(start, "only filters can be negated, as in -lifecycle:retired")
+			}
+			q.Terms = append(q.Terms, Term{Text: phrase, Phrase: true})
+			continue
+		}
+
+		word := p.word()
+		name, _, hasColon := strings.Cut(word, ":")
+		field, isFilter := fields[strings.ToLower(name)]
+		isFilter = isFilter && hasColon
+		switch {
+		case word == "":
+			return Query{}, p.errorAt(start, "expected a word, phrase or filter after -")
+		case !isFilter && negated:
+			return Query{}, p.errorAt(start, "only filters can be negated, as in -lifecycle:retired")
+		case !isFilter:
+			if strings.ContainsRune(word, '"') {
+				return Query{}, p.errorAt(start, "quotes must surround a whole phrase or filter value")
+			}
+			q.Terms = append(q.Terms, Term{Text: word})
+			continue
+		}
+
+		// the value follows the colon, and may be quoted
+		p.pos = start + len([]rune(name)) + 1
+		if negated {
+			p.pos++
+		}
+		value, err := p.value()
+		if err != nil {
+			return Query{}, err
+		}
+		if value == "" {
+			return Query{}, p.errorAt(start, fmt.Sprintf("%s: needs a value", name))
+		}
+		if field == FieldLifecycle && !internal.ServiceLifecycles.IsMember(value) {
+			return Query{}, p.errorAt(start, fmt.Sprintf("lifecycle must be one of %s", strings.Join(internal.ServiceLifecycles.Members(), ", ")))
+		}
+		q.Filters = append(q.Filters, Filter{Field: field, Value: value, Negated: negated})
+	}
+}
+
+type parser struct {
+	input []rune
+	pos   int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// word reads up to the next space.
+func (p *parser) word() string {
+	start := p.pos
+	for !p.done() && !unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// quoted reads a phrase between double quotes, starting at the opening quote.
+func (p *parser) quoted() (string, error) {
+	start := p.pos
+	p.pos++
+	end := slices.Index(p.input[p.pos:], '"')
+	if end < 0 {
+		return "", p.errorAt(start, "unterminated quote")
+	}
+	phrase := strings.TrimSpace(string(p.input[p.pos : p.pos+end]))
+	p.pos += end + 1
+	if phrase == "" {
+		return "", p.errorAt(start, "empty quotes")
+	}
+	if !p.done() && !unicode.IsSpace(p.peek()) {
+		return "", p.errorAt(p.pos, "expected a space after the closing quote")
+	}
+	return phrase, nil
+}
+
+// value reads the value of a filter, quoted or up to the next space.
+func (p *parser) value() (string, error) {
+	if p.peek() == '"' {
+		return p.quoted()
+	}
+	start := p.pos
+	value := p.word()
+	if strings.ContainsRune(value, '"') {
+		return "", p.errorAt(start, "quotes must surround a whole phrase or filter value")
+	}
+	return value, nil
+}
+
+func (p *parser) errorAt(pos int, msg string) error {
+	return &SyntaxError{Pos: pos + 1, Msg: msg}
+}
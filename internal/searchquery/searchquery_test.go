@@ -0,0 +1,115 @@
+package searchquery
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"service-atlas/internal/customerrors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Query
+	}{
+		{"Empty", "  ", Query{}},
+		{"Words", "order  svc", Query{Terms: []Term{{Text: "order"}, {Text: "svc"}}}},
+		{"Phrase", `"order svc" kafka`, Query{Terms: []Term{{Text: "order svc", Phrase: true}, {Text: "kafka"}}}},
+		{"Filters", `type:api team:payments name:"order svc" tag:PCI`, Query{Filters: []Filter{
+			{Field: FieldType, Value: "api"},
+			{Field: FieldTeam, Value: "payments"},
+			{Field: FieldName, Value: "order svc"},
+			{Field: FieldTag, Value: "PCI"},
+		}}},
+		{"OwnerIsTeam", "Owner:none", Query{Filters: []Filter{{Field: FieldTeam, Value: "none"}}}},
+		{"Negated", "orders -lifecycle:retired", Query{
+			Terms:   []Term{{Text: "orders"}},
+			Filters: []Filter{{Field: FieldLifecycle, Value: "retired", Negated: true}},
+		}},
+		{"ColonInValue", "name:a:b", Query{Filters: []Filter{{Field: FieldName, Value: "a:b"}}}},
+		{"QuotedColon", `"https://git.example.com/orders"`, Query{Terms: []Term{{Text: "https://git.example.com/orders", Phrase: true}}}},
+		{"UnknownFieldIsText", "https://git.example.com/orders colour:red", Query{Terms: []Term{
+			{Text: "https://git.example.com/orders"}, {Text: "colour:red"},
+		}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`name:"order svc`, `invalid search query at position 6: unterminated quote`},
+		{`"order`, `invalid search query at position 1: unterminated quote`},
+		{"orders owner:", "invalid search query at position 8: owner: needs a value"},
+		{"-colour:red", "invalid search query at position 1: only filters can be negated, as in -lifecycle:retired"},
+		{"-orders", "invalid search query at position 1: only filters can be negated, as in -lifecycle:retired"},
+		{`-"order svc"`, "invalid search query at position 1: only filters can be negated, as in -lifecycle:retired"},
+		{"orders -", "invalid search query at position 8: expected a word, phrase or filter after -"},
+		{"lifecycle:gone", "invalid search query at position 1: lifecycle must be one of experimental, active, deprecated, retired"},
+		{`or"ders`, "invalid search query at position 1: quotes must surround a whole phrase or filter value"},
+		{`name:"a"b`, "invalid search query at position 9: expected a space after the closing quote"},
+		{`""`, "invalid search query at position 1: empty quotes"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := Parse(tc.input)
+			if err == nil || err.Error() != tc.want {
+				t.Fatalf("got %v, want %q", err, tc.want)
+			}
+			var httpErr *customerrors.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.Status != http.StatusBadRequest || httpErr.Msg != tc.want {
+				t.Errorf("expected a 400, got %v", err)
+			}
+		})
+	}
+}
+
+func TestQuery_Lucene(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"type:api", ""},
+		{"Orders", "orders~"},
+		{`order svc "Payment API"`, `order~ AND svc~ AND "payment api"`},
+		{"NOT a+b* (c)", `not~ AND a\+b\*~ AND \(c\)~`},
+		{`"https://x"`, `"https\:\/\/x"`},
+	}
+	for _, tc := range tests {
+		q, err := Parse(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := q.Lucene(); got != tc.want {
+			t.Errorf("Lucene(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	got := Text(` order "svc `)
+	if got.Lucene() != `order~ AND \"svc~` || len(got.Filters) != 0 {
+		t.Errorf("unexpected query %+v", got)
+	}
+}
+
+func TestFilter_Unowned(t *testing.T) {
+	if !(Filter{Field: FieldTeam, Value: "None"}).Unowned() || (Filter{Field: FieldTag, Value: "none"}).Unowned() {
+		t.Error("expected only team:none to match unowned services")
+	}
+}
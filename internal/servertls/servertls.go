@@ -0,0 +1,143 @@
+// Package servertls configures HTTPS and gRPC over TLS for the server, reloading its certificate, key and client CAs when the files
+// change so renewed certificates are served without a restart.
+package servertls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"service-atlas/internal/config"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// Reloader serves the certificate and client CAs in the configured files, checking them for changes at most
+// once per reload interval as handshakes arrive. A change that fails to load is logged and the previous
+// files keep being served.
+type Reloader struct {
+	cfg        config.TLS
+	clientAuth tls.ClientAuthType
+	now        func() time.Time
+
+	mu      sync.Mutex
+	checked time.Time
+	stamp   string
+	current *tls.Config
+}
+
+func New(cfg config.TLS) (*Reloader, error) {
+	r := &Reloader{cfg: cfg, now: time.Now}
+	switch strings.ToLower(cfg.ClientAuth) {
+	case "", "none":
+		r.clientAuth = tls.NoClientCert
+	case "optional":
+		r.clientAuth = tls.VerifyClientCertIfGiven
+	case "require":
+		r.clientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unknown client auth %q", cfg.ClientAuth)
+	}
+	stamp, err := r.stampFiles()
+	if err != nil {
+		return nil, err
+	}
+	current, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.stamp, r.current, r.checked = stamp, current, r.now()
+	return r, nil
+}
+
+// TLSConfig returns the configuration for the server, which asks the Reloader for the files in effect on each
+// handshake.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.config(), nil
+		},
+	}
+}
+
+// GRPCCredentials secures a gRPC server with the same certificate and client certificate checks as TLSConfig.
+func (r *Reloader) GRPCCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(r.TLSConfig())
+}
+
+func (r *Reloader) config() *tls.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now := r.now(); now.Sub(r.checked) >= r.cfg.ReloadInterval {
+		r.checked = now
+		r.reload()
+	}
+	return r.current
+}
+
+// reload loads the files again if they changed since they were last loaded.
+func (r *Reloader) reload() {
+	stamp, err := r.stampFiles()
+	if err != nil || stamp == r.stamp {
+		if err != nil {
+			slog.Warn("Error checking TLS files for changes", slog.Any("error", err))
+		}
+		return
+	}
+	// the new files are only tried once, so a bad certificate is not reloaded on every handshake
+	r.stamp = stamp
+	current, err := r.load()
+	if err != nil {
+		slog.Error("Error reloading TLS files, keeping the previous certificate", slog.Any("error", err))
+		return
+	}
+	r.current = current
+	slog.Info("Reloaded TLS certificate", slog.String("cert", r.cfg.CertFile))
+}
+
+func (r *Reloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading certificate: %w", err)
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   r.clientAuth,
+		// set here as well as by http.Server, since this config replaces the server's during the handshake
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if r.cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading client CAs: %w", err)
+		}
+		cfg.ClientCAs = x509.NewCertPool()
+		if !cfg.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in " + r.cfg.ClientCAFile)
+		}
+	}
+	return cfg, nil
+}
+
+// stampFiles identifies the current version of the files by their sizes and modification times.
+func (r *Reloader) stampFiles() (string, error) {
+	var stamp strings.Builder
+	for _, path := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(&stamp, "%s:%d:%d;", path, info.Size(), info.ModTime().UnixNano())
+	}
+	return stamp.String(), nil
+}
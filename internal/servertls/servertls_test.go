@@ -0,0 +1,239 @@
+package servertls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"service-atlas/internal/config"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type issued struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// issue creates a certificate for name signed by parent, or self-signed when parent is nil.
+func issue(t *testing.T, name string, parent *issued, usage x509.ExtKeyUsage) *issued {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA, template.BasicConstraintsValid = true, true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &issued{cert: cert, key: key}
+}
+
+func (i *issued) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: i.cert.Raw})
+}
+
+func (i *issued) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(i.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (i *issued) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(i.certPEM(), i.keyPEM(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func write(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// setup writes a server certificate and client CA issued by ca, returning their configuration.
+func setup(t *testing.T, ca *issued, clientAuth string) config.TLS {
+	t.Helper()
+	dir := t.TempDir()
+	cfg := config.TLS{
+		CertFile:       filepath.Join(dir, "tls.crt"),
+		KeyFile:        filepath.Join(dir, "tls.key"),
+		ClientCAFile:   filepath.Join(dir, "ca.crt"),
+		ClientAuth:     clientAuth,
+		ReloadInterval: time.Second,
+	}
+	server := issue(t, "server-1", ca, x509.ExtKeyUsageServerAuth)
+	write(t, cfg.CertFile, server.certPEM())
+	write(t, cfg.KeyFile, server.keyPEM(t))
+	write(t, cfg.ClientCAFile, ca.certPEM())
+	return cfg
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := issue(t, "test-ca", nil, x509.ExtKeyUsageAny)
+	reloader, err := New(setup(t, ca, "require"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var subject string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		subject = r.TLS.VerifiedChains[0][0].Subject.CommonName
+	}))
+	server.TLS = reloader.TLSConfig()
+	server.StartTLS()
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	client := func(certs ...tls.Certificate) *http.Client {
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs}}}
+	}
+
+	if _, err := client().Get(server.URL); err == nil {
+		t.Error("expected a client without a certificate to be rejected")
+	}
+	clientCert := issue(t, "deployer", ca, x509.ExtKeyUsageClientAuth)
+	resp, err := client(clientCert.tlsCertificate(t)).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if subject != "deployer" {
+		t.Errorf("expected the verified client certificate, got %q", subject)
+	}
+	other := issue(t, "other-ca", nil, x509.ExtKeyUsageAny)
+	if _, err := client(issue(t, "intruder", other, x509.ExtKeyUsageClientAuth).tlsCertificate(t)).Get(server.URL); err == nil {
+		t.Error("expected a certificate from another CA to be rejected")
+	}
+}
+
+func TestReload(t *testing.T) {
+	ca := issue(t, "test-ca", nil, x509.ExtKeyUsageAny)
+	cfg := setup(t, ca, "none")
+	reloader, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	reloader.now = func() time.Time { return now }
+	served := func() string {
+		cert, err := x509.ParseCertificate(reloader.config().Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert.Subject.CommonName
+	}
+
+	renewed := issue(t, "server-2", ca, x509.ExtKeyUsageServerAuth)
+	write(t, cfg.CertFile, renewed.certPEM())
+	write(t, cfg.KeyFile, renewed.keyPEM(t))
+	// make the change visible on file systems with coarse modification times
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(cfg.CertFile, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if got := served(); got != "server-1" {
+		t.Errorf("expected the files to be checked only once per interval, got %s", got)
+	}
+	now = now.Add(time.Second)
+	if got := served(); got != "server-2" {
+		t.Errorf("expected the renewed certificate, got %s", got)
+	}
+
+	write(t, cfg.CertFile, []byte("not a certificate"))
+	now = now.Add(time.Second)
+	if got := served(); got != "server-2" {
+		t.Errorf("expected an invalid certificate to keep the previous one, got %s", got)
+	}
+}
+
+func TestNewRejectsInvalidFiles(t *testing.T) {
+	ca := issue(t, "test-ca", nil, x509.ExtKeyUsageAny)
+	cfg := setup(t, ca, "require")
+	write(t, cfg.ClientCAFile, []byte("nothing here"))
+	if _, err := New(cfg); err == nil {
+		t.Error("expected an error for a client CA file without certificates")
+	}
+}
+
+func TestGRPCMutualTLS(t *testing.T) {
+	ca := issue(t, "test-ca", nil, x509.ExtKeyUsageAny)
+	reloader, err := New(setup(t, ca, "require"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(grpc.Creds(reloader.GRPCCredentials()))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	check := func(creds credentials.TransportCredentials) error {
+		conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = conn.Close() }()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	if err := check(insecure.NewCredentials()); err == nil {
+		t.Error("expected a plaintext client to be rejected")
+	}
+	if err := check(credentials.NewTLS(&tls.Config{RootCAs: roots})); err == nil {
+		t.Error("expected a client without a certificate to be rejected")
+	}
+	clientCert := issue(t, "deployer", ca, x509.ExtKeyUsageClientAuth)
+	if err := check(credentials.NewTLS(&tls.Config{RootCAs: roots, Certificates: []tls.Certificate{clientCert.tlsCertificate(t)}})); err != nil {
+		t.Errorf("expected a client with a certificate from the CA to be served, got %v", err)
+	}
+}
@@ -1,31 +1,66 @@
 package internal
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"service-atlas/internal/metrics"
+	"sync"
 	"time"
+
+	"github.com/go-chi/chi/v5"
 )
 
+// logAttrsKey is an unexported type used as the context key for the attributes added to a request's log entry.
+type logAttrsKey struct{}
+
+// logAttrs collects the attributes the middleware and handlers a request passes through add to its log entry.
+type logAttrs struct {
+	mu    sync.Mutex
+	attrs []any
+}
+
+// AddLogAttrs adds attrs to the entry StructuredLoggerFromContext logs once the request carrying ctx is served, so
+// middleware running after it, such as authentication, can still describe the request. It does nothing for requests
+// not being logged.
+func AddLogAttrs(ctx context.Context, attrs ...slog.Attr) {
+	holder, ok := ctx.Value(logAttrsKey{}).(*logAttrs)
+	if !ok {
+		return
+	}
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	for _, attr := range attrs {
+		holder.attrs = append(holder.attrs, attr)
+	}
+}
+
+// StructuredLoggerFromContext logs each request with the logger from its context, and records it in the
+// request metrics under the route pattern it matched.
 func StructuredLoggerFromContext() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			logger := LoggerFromContext(r.Context())
 			start := time.Now()
+			holder := &logAttrs{}
 
 			// Use a wrapper to get the status code
 			ww := &responseWriter{ResponseWriter: w, status: http.StatusOK}
-			next.ServeHTTP(ww, r)
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), logAttrsKey{}, holder)))
 
 			duration := time.Since(start)
+			metrics.ObserveRequest(r.Method, routePattern(r), ww.status, duration)
 
-			logger.Info("WEB_REQUEST",
+			holder.mu.Lock()
+			defer holder.mu.Unlock()
+			logger.Info("WEB_REQUEST", append([]any{
 				slog.String("method", r.Method),
 				slog.String("path", r.URL.Path),
 				slog.Int("status", ww.status),
 				slog.String("remote", r.RemoteAddr),
 				slog.String("user_agent", r.UserAgent()),
 				slog.Int64("duration_ms", duration.Milliseconds()),
-			)
+			}, holder.attrs...)...)
 		})
 	}
 }
@@ -54,6 +89,14 @@ func StructuredLogger(logger *slog.Logger) func(http.Handler) http.Handler {
 	}
 }
 
+// routePattern is the chi route pattern r matched, or "" if it matched none.
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		return rctx.RoutePattern()
+	}
+	return ""
+}
+
 // responseWriter wraps http.ResponseWriter to capture the status code
 type responseWriter struct {
 	http.ResponseWriter
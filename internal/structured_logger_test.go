@@ -2,11 +2,16 @@ package internal
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"service-atlas/internal/metrics"
+	"strings"
 	"testing"
+
+	"github.com/go-chi/chi/v5"
 )
 
 func TestStructuredLogger(t *testing.T) {
@@ -190,3 +195,38 @@ func TestStructuredLoggerWithDefaultStatus(t *testing.T) {
 		t.Errorf("Expected remote '192.168.1.1:12345', got %v", remote)
 	}
 }
+
+func TestStructuredLoggerFromContextRecordsRoutePattern(t *testing.T) {
+	router := chi.NewRouter()
+	router.Use(StructuredLoggerFromContext())
+	router.Get("/things/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things/42", nil))
+
+	rw := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	want := `service_atlas_http_requests_total{method="GET",route="/things/{id}",status="202"} 1`
+	if !strings.Contains(rw.Body.String(), want) {
+		t.Errorf("expected %q in metrics", want)
+	}
+}
+
+func TestStructuredLoggerFromContextLogsAddedAttrs(t *testing.T) {
+	var logBuffer bytes.Buffer
+	ctx := context.WithValue(context.Background(), LoggerKey{}, slog.New(slog.NewJSONHandler(&logBuffer, nil)))
+	handler := StructuredLoggerFromContext()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddLogAttrs(r.Context(), slog.String("caller", "apikey:42"))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/things", nil).WithContext(ctx))
+
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal(logBuffer.Bytes(), &logEntry); err != nil {
+		t.Fatalf("Failed to parse log output: %v", err)
+	}
+	if caller, ok := logEntry["caller"].(string); !ok || caller != "apikey:42" {
+		t.Errorf("Expected caller 'apikey:42', got %v", logEntry["caller"])
+	}
+}
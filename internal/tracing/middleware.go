@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"service-atlas/internal"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentation = "service-atlas/internal/tracing"
+
+// Middleware starts a server span for each request, continuing the trace of an incoming traceparent header.
+// The span is named after the route pattern the request matched, and the trace id is added to the request's
+// log lines as trace_id.
+func Middleware(next http.Handler) http.Handler {
+	tracer := otel.Tracer(instrumentation)
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(r.URL.Path),
+				semconv.UserAgentOriginal(r.UserAgent()),
+				semconv.ClientAddress(r.RemoteAddr),
+			))
+		defer span.End()
+		if spanContext := span.SpanContext(); spanContext.HasTraceID() {
+			ctx = withTraceLogger(ctx, spanContext)
+			if requestId := internal.GetRequestIdFromContext(ctx); requestId != "" {
+				span.SetAttributes(attribute.String("request_id", requestId))
+			}
+		}
+
+		ww := middleware.NewWrapResponseWriter(rw, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			span.SetName(r.Method + " " + rctx.RoutePattern())
+			span.SetAttributes(semconv.HTTPRoute(rctx.RoutePattern()))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	})
+}
+
+func withTraceLogger(ctx context.Context, spanContext trace.SpanContext) context.Context {
+	logger := internal.LoggerFromContext(ctx).With(slog.String("trace_id", spanContext.TraceID().String()))
+	return context.WithValue(ctx, internal.LoggerKey{}, logger)
+}
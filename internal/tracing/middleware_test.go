@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return recorder
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := recordSpans(t)
+	router := chi.NewRouter()
+	router.Use(Middleware)
+	router.Get("/things/{id}", func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/things/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "GET /things/{id}" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.SpanContext().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" || span.Parent().SpanID().String() != "00f067aa0ba902b7" {
+		t.Errorf("expected the span to continue the incoming trace, got %s parent %s", span.SpanContext().TraceID(), span.Parent().SpanID())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected an error status for a 500, got %v", span.Status())
+	}
+	want := map[string]bool{
+		string(semconv.HTTPRoute("/things/{id}").Key):          false,
+		string(semconv.HTTPResponseStatusCode(500).Key):        false,
+		string(semconv.HTTPRequestMethodKey.String("GET").Key): false,
+	}
+	for _, attr := range span.Attributes() {
+		if _, ok := want[string(attr.Key)]; ok {
+			want[string(attr.Key)] = true
+		}
+	}
+	for key, found := range want {
+		if !found {
+			t.Errorf("expected attribute %s", key)
+		}
+	}
+}
+
+func TestMiddleware_StartsNewTrace(t *testing.T) {
+	recorder := recordSpans(t)
+	handler := Middleware(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Parent().IsValid() || spans[0].Name() != http.MethodGet {
+		t.Fatalf("expected one root span named after the method, got %+v", spans)
+	}
+	if spans[0].Status().Code == codes.Error {
+		t.Error("expected a 200 not to be an error")
+	}
+}
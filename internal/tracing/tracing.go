@@ -0,0 +1,105 @@
+// Package tracing sets up OpenTelemetry tracing and traces the HTTP requests the API serves.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+)
+
+// serviceName names the service in traces unless OTEL_SERVICE_NAME says otherwise.
+const serviceName = "service-atlas"
+
+// Setup installs the global tracer provider selected by OTEL_TRACES_EXPORTER:
+//   - "otlp" exports to a collector over OTEL_EXPORTER_OTLP_PROTOCOL, "grpc" or "http/protobuf" (the default),
+//     configured by the standard OTEL_EXPORTER_OTLP_* variables
+//   - "stdout" (or "console") writes spans to standard output as JSON
+//   - "file" writes spans as JSON to the file named by OTEL_TRACES_FILE
+//   - "none", the default, leaves tracing disabled
+//
+// Incoming and outgoing trace context is propagated with W3C traceparent and baggage headers in every case.
+// The returned function flushes buffered spans and must be called before exiting.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	kind := strings.ToLower(strings.TrimSpace(os.Getenv("OTEL_TRACES_EXPORTER")))
+	exporter, closer, err := newExporter(ctx, kind)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// the sampler is configured by OTEL_TRACES_SAMPLER and OTEL_TRACES_SAMPLER_ARG
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	slog.Info("Tracing enabled", slog.String("exporter", kind))
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			if closeErr := closer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}, nil
+}
+
+// newExporter creates the exporter named by kind, and the file it writes to if any.
+func newExporter(ctx context.Context, kind string) (sdktrace.SpanExporter, io.Closer, error) {
+	switch kind {
+	case "", "none":
+		return nil, nil, nil
+	case "otlp":
+		protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+		if protocol == "" {
+			protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+		}
+		switch protocol {
+		case "", "http/protobuf":
+			exporter, err := otlptracehttp.New(ctx)
+			return exporter, nil, err
+		case "grpc":
+			exporter, err := otlptracegrpc.New(ctx)
+			return exporter, nil, err
+		}
+		return nil, nil, fmt.Errorf("unsupported OTLP protocol %q", protocol)
+	case "stdout", "console":
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		return exporter, nil, err
+	case "file":
+		path := os.Getenv("OTEL_TRACES_FILE")
+		if path == "" {
+			return nil, nil, fmt.Errorf("OTEL_TRACES_FILE is required for the file exporter")
+		}
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			_ = file.Close()
+			return nil, nil, err
+		}
+		return exporter, file, nil
+	}
+	return nil, nil, fmt.Errorf("unknown OTEL_TRACES_EXPORTER %q", kind)
+}
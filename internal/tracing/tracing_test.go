@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewExporter(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		env     map[string]string
+		enabled bool
+		wantErr bool
+	}{
+		{"Disabled", "", nil, false, false},
+		{"None", "none", nil, false, false},
+		{"Stdout", "stdout", nil, true, false},
+		{"Console", "console", nil, true, false},
+		{"File", "file", map[string]string{"OTEL_TRACES_FILE": filepath.Join(t.TempDir(), "traces.json")}, true, false},
+		{"FileWithoutPath", "file", nil, false, true},
+		{"OtlpHttp", "otlp", nil, true, false},
+		{"OtlpGrpc", "otlp", map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "grpc"}, true, false},
+		{"OtlpUnknownProtocol", "otlp", map[string]string{"OTEL_EXPORTER_OTLP_PROTOCOL": "carrier-pigeon"}, false, true},
+		{"Unknown", "zipkin", nil, false, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("OTEL_TRACES_FILE", "")
+			t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "")
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+			exporter, closer, err := newExporter(context.Background(), tc.kind)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if (exporter != nil) != tc.enabled {
+				t.Fatalf("expected enabled %v, got exporter %v", tc.enabled, exporter)
+			}
+			if exporter != nil {
+				_ = exporter.Shutdown(context.Background())
+			}
+			if closer != nil {
+				_ = closer.Close()
+			}
+		})
+	}
+}
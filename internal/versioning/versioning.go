@@ -0,0 +1,73 @@
+// Package versioning tags requests with the API version they were routed to, which decides the shape of list responses.
+package versioning
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Version names an API version, which is also its path prefix.
+type Version string
+
+const (
+	V1 Version = "v1"
+	// V2 sends lists as a pagination.Result rather than a bare array.
+	V2 Version = "v2"
+)
+
+// Latest is the newest stable version, served by unversioned legacy paths.
+const Latest = V1
+
+// Versions lists every version the API is served under.
+var Versions = []Version{V1, V2}
+
+// Prefix is the path prefix routes of the version are mounted under.
+func (v Version) Prefix() string {
+	return "/" + string(v)
+}
+
+type versionKey struct{}
+
+// WithVersion is middleware recording the version a request was routed to.
+func WithVersion(v Version) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), versionKey{}, v)))
+		})
+	}
+}
+
+// FromContext returns the version a request was routed to, defaulting to Latest.
+func FromContext(ctx context.Context) Version {
+	if v, ok := ctx.Value(versionKey{}).(Version); ok {
+		return v
+	}
+	return Latest
+}
+
+// Deprecated is middleware for paths being retired. It sets the Deprecation (RFC 9745) and Sunset (RFC 8594) headers,
+// and links to the same path under the successor version.
+func Deprecated(successor Version, deprecatedAt, sunset time.Time) func(http.Handler) http.Handler {
+	deprecation := "@" + strconv.FormatInt(deprecatedAt.Unix(), 10)
+	sunsetValue := sunset.UTC().Format(http.TimeFormat)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			rw.Header().Set("Deprecation", deprecation)
+			rw.Header().Set("Sunset", sunsetValue)
+			rw.Header().Add("Link", "<"+successor.Prefix()+r.URL.Path+">; rel=\"successor-version\"")
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// ParseSunset parses a sunset date given as YYYY-MM-DD or RFC 3339.
+func ParseSunset(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
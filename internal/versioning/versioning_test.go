@@ -0,0 +1,62 @@
+package versioning
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func jsonHandler(status int, body string) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		_, _ = io.WriteString(rw, body)
+	})
+}
+
+func TestWithVersion(t *testing.T) {
+	if FromContext(context.Background()) != Latest {
+		t.Errorf("expected default version %q", Latest)
+	}
+	var got Version
+	handler := WithVersion(V2)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if got != V2 {
+		t.Errorf("expected %q, got %q", V2, got)
+	}
+}
+
+func TestDeprecated(t *testing.T) {
+	deprecatedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 4, 19, 0, 0, 0, 0, time.UTC)
+	rw := httptest.NewRecorder()
+	Deprecated(V1, deprecatedAt, sunset)(jsonHandler(http.StatusOK, "[]")).
+		ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/services?page=1", nil))
+
+	if got := rw.Header().Get("Deprecation"); got != "@1792368000" {
+		t.Errorf("unexpected Deprecation header %q", got)
+	}
+	if got := rw.Header().Get("Sunset"); got != "Mon, 19 Apr 2027 00:00:00 GMT" {
+		t.Errorf("unexpected Sunset header %q", got)
+	}
+	if got := rw.Header().Get("Link"); got != `</v1/services>; rel="successor-version"` {
+		t.Errorf("unexpected Link header %q", got)
+	}
+}
+
+func TestParseSunset(t *testing.T) {
+	for _, value := range []string{"2027-04-19", "2027-04-19T00:00:00Z"} {
+		got, err := ParseSunset(value)
+		if err != nil || !got.Equal(time.Date(2027, 4, 19, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("ParseSunset(%q) = %v, %v", value, got, err)
+		}
+	}
+	if _, err := ParseSunset("next spring"); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,64 @@
+package memoryrepositories
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"time"
+)
+
+func (s *Store) CreateApiKey(_ context.Context, key repositories.ApiKey) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// hashes are unique, as the Neo4j constraint makes them
+	for _, existing := range s.data.apiKeys {
+		if existing.Hash == key.Hash {
+			return "", &customerrors.HTTPError{Status: http.StatusInternalServerError, Msg: "Error creating api key"}
+		}
+	}
+	key.Id = newId()
+	key.Created = s.now()
+	key.Scopes = slices.Clone(key.Scopes)
+	s.data.apiKeys[key.Id] = key
+	if err := s.commit(); err != nil {
+		return "", err
+	}
+	return key.Id, nil
+}
+
+func (s *Store) GetApiKeys(_ context.Context, page pagination.Page) (pagination.Result[repositories.ApiKey], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]repositories.ApiKey, 0, len(s.data.apiKeys))
+	for _, key := range s.data.apiKeys {
+		keys = append(keys, key)
+	}
+	newestFirst(keys,
+		func(k repositories.ApiKey) time.Time { return k.Created },
+		func(k repositories.ApiKey) string { return k.Id })
+	return paginate(keys, page), nil
+}
+
+func (s *Store) GetApiKeyByHash(_ context.Context, hash string) (*repositories.ApiKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, key := range s.data.apiKeys {
+		if key.Hash == hash {
+			return &key, nil
+		}
+	}
+	return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+}
+
+func (s *Store) DeleteApiKey(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data.apiKeys[id]; !ok {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+	}
+	delete(s.data.apiKeys, id)
+	return s.commit()
+}
@@ -0,0 +1,80 @@
+package memoryrepositories
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"time"
+)
+
+// defaultDebtStatus is the status of new debt items, as in the Neo4j repository.
+const defaultDebtStatus = "pending"
+
+func (s *Store) CreateDebtItem(_ context.Context, debt repositories.Debt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data.services[debt.ServiceId]; !ok {
+		return serviceNotFound(debt.ServiceId)
+	}
+	debt.Id = newId()
+	debt.Status = defaultDebtStatus
+	s.data.debt = append(s.data.debt, debtItem{Debt: debt, Created: s.now()})
+	return s.commit()
+}
+
+func (s *Store) UpdateStatus(_ context.Context, id, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	i := slices.IndexFunc(s.data.debt, func(d debtItem) bool { return d.Id == id })
+	if i < 0 {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+	}
+	s.data.debt[i].Status = status
+	return s.commit()
+}
+
+func (s *Store) GetDebtById(_ context.Context, id string) (repositories.Debt, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := slices.IndexFunc(s.data.debt, func(d debtItem) bool { return d.Id == id })
+	if i < 0 {
+		return repositories.Debt{}, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+	}
+	return s.data.debt[i].Debt, nil
+}
+
+func (s *Store) GetDebtByServiceId(_ context.Context, id string, page pagination.Page, onlyResolved bool) (pagination.Result[repositories.Debt], error) {
+	if err := invalidPage(page); err != nil {
+		return pagination.Result[repositories.Debt]{}, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	debt := make([]repositories.Debt, 0)
+	for _, item := range s.debtOf(id) {
+		if !onlyResolved || item.Status == "remediated" {
+			debt = append(debt, item)
+		}
+	}
+	return paginate(debt, page), nil
+}
+
+// debtOf returns the debt items of the service, newest first.
+func (s *Store) debtOf(serviceId string) []repositories.Debt {
+	items := make([]debtItem, 0)
+	for _, item := range s.data.debt {
+		if item.ServiceId == serviceId {
+			items = append(items, item)
+		}
+	}
+	newestFirst(items,
+		func(d debtItem) time.Time { return d.Created },
+		func(d debtItem) string { return d.Id })
+	debt := make([]repositories.Debt, 0, len(items))
+	for _, item := range items {
+		debt = append(debt, item.Debt)
+	}
+	return debt
+}
@@ -0,0 +1,103 @@
+package memoryrepositories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+)
+
+// AddDependency records that the service depends on another. Adding the same dependency twice has no effect.
+func (s *Store) AddDependency(_ context.Context, id string, dep repositories.Dependency) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, serviceFound := s.data.services[id]
+	_, dependencyFound := s.data.services[dep.Id]
+	if !serviceFound || !dependencyFound {
+		return &customerrors.HTTPError{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("One or both services not found: %s, %s", id, dep.Id),
+		}
+	}
+	relationship := dependency{ServiceId: id, DependsOnId: dep.Id, Version: dep.Version}
+	if !slices.Contains(s.data.dependencies, relationship) {
+		s.data.dependencies = append(s.data.dependencies, relationship)
+	}
+	return s.commit()
+}
+
+func (s *Store) GetDependencies(_ context.Context, id string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.data.services[id]; !ok {
+		return pagination.Result[*repositories.Dependency]{}, serviceNotFound(id)
+	}
+	return paginate(s.dependenciesOf(id), page), nil
+}
+
+func (s *Store) GetDependents(_ context.Context, id string, version string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.data.services[id]; !ok {
+		return pagination.Result[*repositories.Dependency]{}, serviceNotFound(id)
+	}
+	dependents := s.dependentsOf(id)
+	if version != "" {
+		dependents = slices.DeleteFunc(dependents, func(d *repositories.Dependency) bool { return d.Version != version })
+	}
+	return paginate(dependents, page), nil
+}
+
+// DeleteDependency removes the dependency at every version.
+func (s *Store) DeleteDependency(_ context.Context, id string, dependsOnID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	matches := func(d dependency) bool { return d.ServiceId == id && d.DependsOnId == dependsOnID }
+	if !slices.ContainsFunc(s.data.dependencies, matches) {
+		return &customerrors.HTTPError{
+			Status: http.StatusNotFound,
+			Msg:    fmt.Sprintf("Dependency relationship not found between services: %s -> %s", id, dependsOnID),
+		}
+	}
+	s.data.dependencies = slices.DeleteFunc(s.data.dependencies, matches)
+	return s.commit()
+}
+
+// dependenciesOf returns the services the service depends on, by name.
+func (s *Store) dependenciesOf(id string) []*repositories.Dependency {
+	var dependencies []*repositories.Dependency
+	for _, d := range s.data.dependencies {
+		if d.ServiceId == id {
+			dependencies = append(dependencies, s.describe(d.DependsOnId, d.Version))
+		}
+	}
+	sortByName(dependencies)
+	return dependencies
+}
+
+// dependentsOf returns the services depending on the service, by name.
+func (s *Store) dependentsOf(id string) []*repositories.Dependency {
+	var dependents []*repositories.Dependency
+	for _, d := range s.data.dependencies {
+		if d.DependsOnId == id {
+			dependents = append(dependents, s.describe(d.ServiceId, d.Version))
+		}
+	}
+	sortByName(dependents)
+	return dependents
+}
+
+func (s *Store) describe(serviceId, version string) *repositories.Dependency {
+	service := s.data.services[serviceId]
+	return &repositories.Dependency{Id: serviceId, Name: service.Name, ServiceType: service.ServiceType, Version: version}
+}
+
+func sortByName(dependencies []*repositories.Dependency) {
+	slices.SortStableFunc(dependencies, func(a, b *repositories.Dependency) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
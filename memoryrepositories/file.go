@@ -0,0 +1,175 @@
+package memoryrepositories
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+	"time"
+)
+
+// snapshotVersion is the format of the snapshot file, raised when it changes incompatibly.
+const snapshotVersion = 1
+
+// snapshot is the catalog as written to the file.
+type snapshot struct {
+	Version      int                    `json:"version"`
+	Services     []repositories.Service `json:"services"`
+	Teams        []repositories.Team    `json:"teams"`
+	Debt         []debtItem             `json:"debt"`
+	Releases     []repositories.Release `json:"releases"`
+	Dependencies []dependency           `json:"dependencies"`
+	Owners       []ownership            `json:"owners"`
+	ApiKeys      []storedApiKey         `json:"apiKeys"`
+}
+
+// storedApiKey keeps the hash, which ApiKey leaves out of its JSON so it is never served.
+type storedApiKey struct {
+	repositories.ApiKey
+	Hash string `json:"hash"`
+}
+
+// file persists the catalog to a snapshot at path.
+type file struct {
+	path string
+	// saved is the last snapshot written, restored when writing a change fails.
+	saved []byte
+}
+
+// Open returns a store persisted to the snapshot file at path, created on the first change if it does not exist.
+// Every change rewrites the snapshot before it is acknowledged, by writing a temporary file next to it and renaming
+// it into place, so after a crash the file holds either the catalog before the change or after it. The file must
+// not be shared by several processes.
+func Open(path string) (*Store, error) {
+	s := New()
+	s.file = &file{path: path}
+	saved, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading catalog: %w", err)
+	}
+	if err = s.restore(saved); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	s.file.saved = saved
+	return s, nil
+}
+
+// commit persists a change made under the write lock. When the snapshot cannot be written the change is undone,
+// so the catalog served never differs from the one on disk.
+func (s *Store) commit() error {
+	if s.file == nil {
+		return nil
+	}
+	data, err := json.Marshal(s.snapshot())
+	if err == nil {
+		err = writeFileAtomic(s.file.path, data)
+	}
+	if err != nil {
+		slog.Error("Error saving the catalog, the change is undone", slog.String("path", s.file.path), slog.Any("error", err))
+		if restoreErr := s.restore(s.file.saved); restoreErr != nil {
+			slog.Error("Error restoring the catalog", slog.Any("error", restoreErr))
+		}
+		return &customerrors.HTTPError{Status: http.StatusInternalServerError, Msg: "Error saving the catalog"}
+	}
+	s.file.saved = data
+	return nil
+}
+
+func (s *Store) snapshot() snapshot {
+	snap := snapshot{
+		Version:      snapshotVersion,
+		Services:     s.sortedServices(),
+		Teams:        make([]repositories.Team, 0, len(s.data.teams)),
+		Debt:         s.data.debt,
+		Releases:     s.data.releases,
+		Dependencies: s.data.dependencies,
+		Owners:       s.data.owners,
+		ApiKeys:      make([]storedApiKey, 0, len(s.data.apiKeys)),
+	}
+	for _, team := range s.data.teams {
+		snap.Teams = append(snap.Teams, team)
+	}
+	newestFirst(snap.Teams,
+		func(t repositories.Team) time.Time { return t.Created },
+		func(t repositories.Team) string { return t.Id })
+	for _, key := range s.data.apiKeys {
+		snap.ApiKeys = append(snap.ApiKeys, storedApiKey{ApiKey: key, Hash: key.Hash})
+	}
+	newestFirst(snap.ApiKeys,
+		func(k storedApiKey) time.Time { return k.Created },
+		func(k storedApiKey) string { return k.Id })
+	return snap
+}
+
+// restore replaces the catalog with the snapshot saved, or empties it when nothing was saved.
+func (s *Store) restore(saved []byte) error {
+	var snap snapshot
+	if len(saved) > 0 {
+		if err := json.Unmarshal(saved, &snap); err != nil {
+			return err
+		}
+		if snap.Version != snapshotVersion {
+			return fmt.Errorf("unsupported catalog version %d", snap.Version)
+		}
+	}
+	s.data = data{
+		services:     make(map[string]repositories.Service, len(snap.Services)),
+		teams:        make(map[string]repositories.Team, len(snap.Teams)),
+		debt:         snap.Debt,
+		releases:     snap.Releases,
+		dependencies: snap.Dependencies,
+		owners:       snap.Owners,
+		apiKeys:      make(map[string]repositories.ApiKey, len(snap.ApiKeys)),
+	}
+	for _, service := range snap.Services {
+		s.data.services[service.Id] = service
+	}
+	for _, team := range snap.Teams {
+		s.data.teams[team.Id] = team
+	}
+	for _, key := range snap.ApiKeys {
+		key.ApiKey.Hash = key.Hash
+		s.data.apiKeys[key.Id] = key.ApiKey
+	}
+	return nil
+}
+
+// writeFileAtomic replaces the file at path with data. The file is synced before it is renamed into place, and the
+// directory after, so the rename survives a crash.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	// the new file is in place, so a failure to sync the directory only risks losing the change on a crash
+	if d, err := os.Open(dir); err == nil {
+		if err = d.Sync(); err != nil {
+			slog.Warn("Error syncing the catalog directory", slog.String("dir", dir), slog.Any("error", err))
+		}
+		_ = d.Close()
+	}
+	return nil
+}
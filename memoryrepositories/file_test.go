@@ -0,0 +1,88 @@
+package memoryrepositories
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"testing"
+	"time"
+)
+
+func TestOpenRestoresTheCatalog(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cart := createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	team, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	for _, err := range []error{
+		s.CreateTeamAssociation(ctx, team, cart),
+		s.AddDependency(ctx, orders, repositories.Dependency{Id: cart, Version: "2"}),
+		s.CreateDebtItem(ctx, repositories.Debt{ServiceId: cart, Type: "code", Title: "tests"}),
+		s.CreateRelease(ctx, repositories.Release{ServiceId: cart, Version: "1.0", ReleaseDate: time.Now()}),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = s.CreateApiKey(ctx, repositories.ApiKey{Name: "ci", Scopes: []string{"read"}, Hash: "abc"}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts, _ := reopened.GetCatalogCounts(ctx); counts.Services != 2 || counts.Teams != 1 || counts.OpenDebt != 1 {
+		t.Errorf("unexpected counts after reopening %+v", counts)
+	}
+	if teams, _ := reopened.GetTeamsByServiceId(ctx, cart, pagination.First(10)); len(teams.Items) != 1 || teams.Items[0].Id != team {
+		t.Errorf("expected the ownership to be restored, got %+v", teams)
+	}
+	if dependencies, _ := reopened.GetDependencies(ctx, orders, pagination.First(10)); len(dependencies.Items) != 1 || dependencies.Items[0].Version != "2" {
+		t.Errorf("expected the dependency to be restored, got %+v", dependencies)
+	}
+	if releases, _ := reopened.GetReleasesByServiceId(ctx, cart, pagination.First(10)); len(releases.Items) != 1 {
+		t.Errorf("expected the release to be restored, got %+v", releases)
+	}
+	if key, err := reopened.GetApiKeyByHash(ctx, "abc"); err != nil || key.Name != "ci" {
+		t.Errorf("expected the api key to be found by its hash, got %+v, %v", key, err)
+	}
+}
+
+func TestFailedWritesAreUndone(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := Open(filepath.Join(dir, "catalog.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cart := createService(t, s, "cart", "https://cart")
+
+	// the snapshot cannot be written once its directory is gone
+	s.file.path = filepath.Join(dir, "missing", "catalog.json")
+	_, err = s.CreateService(ctx, repositories.Service{Name: "orders", ServiceType: "api", Url: "https://orders"})
+	requireStatus(t, err, 500)
+	requireStatus(t, s.DeleteService(ctx, cart), 500)
+	services, _ := s.GetAllServices(ctx, repositories.ServiceFilter{}, pagination.First(10))
+	if len(services.Items) != 1 || services.Items[0].Id != cart {
+		t.Errorf("expected the failed changes to be undone, got %+v", services)
+	}
+}
+
+func TestOpenRejectsAnInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	for _, content := range []string{`{"services": [`, `{"version": 99}`} {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Open(path); err == nil {
+			t.Errorf("expected an error opening %q", content)
+		}
+	}
+}
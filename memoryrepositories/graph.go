@@ -0,0 +1,58 @@
+package memoryrepositories
+
+import (
+	"context"
+	"service-atlas/repositories"
+)
+
+func (s *Store) GetServicesByIds(_ context.Context, ids []string) (map[string]repositories.Service, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	services := make(map[string]repositories.Service, len(ids))
+	for _, id := range ids {
+		if service, ok := s.data.services[id]; ok {
+			services[id] = service
+		}
+	}
+	return services, nil
+}
+
+func (s *Store) GetDependenciesByServiceIds(_ context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	return byId(s, ids, s.dependenciesOf), nil
+}
+
+func (s *Store) GetDependentsByServiceIds(_ context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	return byId(s, ids, s.dependentsOf), nil
+}
+
+func (s *Store) GetTeamsByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Team, error) {
+	return byId(s, ids, s.teamsOf), nil
+}
+
+func (s *Store) GetReleasesByServiceIds(_ context.Context, ids []string, limit int) (map[string][]*repositories.Release, error) {
+	return byId(s, ids, func(id string) []*repositories.Release {
+		releases := s.releasesOf(id)
+		return releases[:min(len(releases), max(limit, 0))]
+	}), nil
+}
+
+func (s *Store) GetDebtByServiceIds(_ context.Context, ids []string) (map[string][]repositories.Debt, error) {
+	return byId(s, ids, s.debtOf), nil
+}
+
+func (s *Store) GetServicesByTeamIds(_ context.Context, ids []string) (map[string][]repositories.Service, error) {
+	return byId(s, ids, s.servicesOf), nil
+}
+
+// byId looks up the values of each id, leaving out ids without any, like the Neo4j repository.
+func byId[T any](s *Store, ids []string, lookup func(id string) []T) map[string][]T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make(map[string][]T, len(ids))
+	for _, id := range ids {
+		if found := lookup(id); len(found) > 0 {
+			values[id] = found
+		}
+	}
+	return values
+}
@@ -0,0 +1,78 @@
+package memoryrepositories
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"time"
+)
+
+func (s *Store) CreateRelease(_ context.Context, release repositories.Release) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data.services[release.ServiceId]; !ok {
+		return serviceNotFound(release.ServiceId)
+	}
+	// the Neo4j repository keeps release dates to the second
+	release.ReleaseDate = release.ReleaseDate.UTC().Truncate(time.Second)
+	s.data.releases = append(s.data.releases, release)
+	return s.commit()
+}
+
+func (s *Store) GetReleasesByServiceId(_ context.Context, serviceId string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
+	if err := invalidPage(page); err != nil {
+		return pagination.Result[*repositories.Release]{}, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.data.services[serviceId]; !ok {
+		return pagination.Result[*repositories.Release]{}, serviceNotFound(serviceId)
+	}
+	return paginate(s.releasesOf(serviceId), page), nil
+}
+
+// GetReleasesInDateRange returns the releases from the start of startDate up to the start of endDate, as the
+// Neo4j repository compares them to the dates alone.
+func (s *Store) GetReleasesInDateRange(_ context.Context, startDate, endDate time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
+	start, end := day(startDate), day(endDate)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	releases := make([]*repositories.ServiceReleaseInfo, 0)
+	for _, release := range s.sortedReleases() {
+		if release.ReleaseDate.Before(start) || release.ReleaseDate.After(end) {
+			continue
+		}
+		service := s.data.services[release.ServiceId]
+		releases = append(releases, &repositories.ServiceReleaseInfo{
+			ServiceName: service.Name,
+			ServiceType: service.ServiceType,
+			Release:     release,
+		})
+	}
+	return paginate(releases, page), nil
+}
+
+// releasesOf returns the releases of the service, newest first.
+func (s *Store) releasesOf(serviceId string) []*repositories.Release {
+	releases := make([]*repositories.Release, 0)
+	for _, release := range s.sortedReleases() {
+		if release.ServiceId == serviceId {
+			releases = append(releases, &release)
+		}
+	}
+	return releases
+}
+
+// sortedReleases returns every release, newest first.
+func (s *Store) sortedReleases() []repositories.Release {
+	releases := slices.Clone(s.data.releases)
+	slices.SortStableFunc(releases, func(a, b repositories.Release) int {
+		return b.ReleaseDate.Compare(a.ReleaseDate)
+	})
+	return releases
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,120 @@
+package memoryrepositories
+
+import (
+	"cmp"
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+)
+
+// isOpen reports whether debt with the status still needs work, as counted in the reports.
+func isOpen(status string) bool {
+	return status == "pending" || status == "in_progress"
+}
+
+func (s *Store) GetServiceRiskReport(_ context.Context, serviceId string) (*repositories.ServiceRiskReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.data.services[serviceId]; !ok {
+		return nil, serviceNotFound(serviceId)
+	}
+	report := &repositories.ServiceRiskReport{DebtCount: make(map[string]int64)}
+	for _, item := range s.data.debt {
+		if item.ServiceId == serviceId {
+			report.DebtCount[item.Type]++
+		}
+	}
+	for _, d := range s.data.dependencies {
+		if d.DependsOnId == serviceId {
+			report.DependentCount++
+		}
+	}
+	return report, nil
+}
+
+func (s *Store) GetServicesByTeam(_ context.Context, teamId string, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return paginate(s.servicesOf(teamId), page), nil
+}
+
+// GetDebtCountByService counts the open debt of each service that has any, most first.
+func (s *Store) GetDebtCountByService(_ context.Context, page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	counts := make(map[string]int64)
+	for _, item := range s.data.debt {
+		if isOpen(item.Status) {
+			counts[item.ServiceId]++
+		}
+	}
+	report := make([]repositories.ServiceDebtReport, 0, len(counts))
+	for id, count := range counts {
+		report = append(report, repositories.ServiceDebtReport{Name: s.data.services[id].Name, Id: id, Count: count})
+	}
+	slices.SortFunc(report, func(a, b repositories.ServiceDebtReport) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name), cmp.Compare(a.Id, b.Id))
+	})
+	return paginate(report, page), nil
+}
+
+func (s *Store) GetCatalogCounts(_ context.Context) (*repositories.CatalogCounts, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	counts := &repositories.CatalogCounts{Services: int64(len(s.data.services)), Teams: int64(len(s.data.teams))}
+	for _, item := range s.data.debt {
+		if isOpen(item.Status) {
+			counts.OpenDebt++
+		}
+	}
+	return counts, nil
+}
+
+// GetIntegrityReport finds what the store would reject if it were written today, such as duplicates in a snapshot
+// edited by hand.
+func (s *Store) GetIntegrityReport(_ context.Context) (*repositories.IntegrityReport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids, names, urls []string
+	for _, service := range s.sortedServices() {
+		ids = append(ids, service.Id)
+		names = append(names, service.Name)
+		urls = append(urls, repositories.NormalizeRepositoryUrl(service.Url))
+	}
+	var teamIds, teamNames []string
+	for _, team := range s.data.teams {
+		teamIds = append(teamIds, team.Id)
+		teamNames = append(teamNames, team.Name)
+	}
+	report := &repositories.IntegrityReport{
+		DuplicateServiceNames: repositories.FindDuplicates(ids, names),
+		DuplicateServiceUrls:  repositories.FindDuplicates(ids, urls),
+		DuplicateTeamNames:    repositories.FindDuplicates(teamIds, teamNames),
+		OrphanedDebt:          make([]repositories.Debt, 0),
+		OrphanedReleases:      make([]repositories.Release, 0),
+	}
+	for _, item := range s.data.debt {
+		if _, ok := s.data.services[item.ServiceId]; !ok {
+			report.OrphanedDebt = append(report.OrphanedDebt, item.Debt)
+		}
+	}
+	for _, release := range s.data.releases {
+		if _, ok := s.data.services[release.ServiceId]; !ok {
+			report.OrphanedReleases = append(report.OrphanedReleases, release)
+		}
+	}
+	return report, nil
+}
+
+// servicesOf returns the services owned by the team, by name.
+func (s *Store) servicesOf(teamId string) []repositories.Service {
+	services := make([]repositories.Service, 0)
+	for _, o := range s.data.owners {
+		if service, ok := s.data.services[o.ServiceId]; ok && o.TeamId == teamId {
+			services = append(services, service)
+		}
+	}
+	slices.SortFunc(services, func(a, b repositories.Service) int { return cmp.Compare(a.Name, b.Name) })
+	return services
+}
@@ -0,0 +1,150 @@
+package memoryrepositories
+
+import (
+	"context"
+	"fmt"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDebtAndReports(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	for _, d := range []repositories.Debt{
+		{ServiceId: cart, Type: "code", Title: "one"},
+		{ServiceId: cart, Type: "code", Title: "two"},
+		{ServiceId: cart, Type: "testing", Title: "three"},
+		{ServiceId: orders, Type: "code", Title: "four"},
+	} {
+		if err := s.CreateDebtItem(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	requireStatus(t, s.CreateDebtItem(ctx, repositories.Debt{ServiceId: "missing"}), 404)
+
+	page, err := s.GetDebtByServiceId(ctx, cart, pagination.First(10), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	debt := page.Items
+	if len(debt) != 3 || debt[0].Title != "three" || debt[0].Status != "pending" {
+		t.Fatalf("expected the newest pending debt first, got %+v", debt)
+	}
+	if err = s.UpdateStatus(ctx, debt[0].Id, "remediated"); err != nil {
+		t.Fatal(err)
+	}
+	requireStatus(t, s.UpdateStatus(ctx, "missing", "remediated"), 404)
+	if resolved, _ := s.GetDebtByServiceId(ctx, cart, pagination.First(10), true); len(resolved.Items) != 1 {
+		t.Errorf("expected one resolved item, got %+v", resolved)
+	}
+	_, err = s.GetDebtByServiceId(ctx, cart, pagination.Page{Offset: -1, Limit: 10}, false)
+	requireStatus(t, err, 400)
+
+	if err = s.AddDependency(ctx, orders, repositories.Dependency{Id: cart, Version: "1.0"}); err != nil {
+		t.Fatal(err)
+	}
+	requireStatus(t, s.AddDependency(ctx, orders, repositories.Dependency{Id: "missing"}), 404)
+	risk, err := s.GetServiceRiskReport(ctx, cart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if risk.DependentCount != 1 || risk.DebtCount["code"] != 2 || risk.DebtCount["testing"] != 1 {
+		t.Errorf("unexpected risk report %+v", risk)
+	}
+
+	report, _ := s.GetDebtCountByService(ctx, pagination.First(10))
+	if len(report.Items) != 2 || report.Items[0].Id != cart || report.Items[0].Count != 2 {
+		t.Errorf("expected cart's two open items first, got %+v", report.Items)
+	}
+	if counts, _ := s.GetCatalogCounts(ctx); counts.Services != 2 || counts.OpenDebt != 3 {
+		t.Errorf("unexpected counts %+v", counts)
+	}
+}
+
+func TestReleases(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	for _, day := range []int{1, 5, 10} {
+		release := repositories.Release{ServiceId: cart, Version: fmt.Sprintf("v%d", day), ReleaseDate: time.Date(2026, 3, day, 12, 0, 0, 0, time.UTC)}
+		if err := s.CreateRelease(ctx, release); err != nil {
+			t.Fatal(err)
+		}
+	}
+	requireStatus(t, s.CreateRelease(ctx, repositories.Release{ServiceId: "missing"}), 404)
+
+	releases, err := s.GetReleasesByServiceId(ctx, cart, pagination.First(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(releases.Items) != 2 || releases.Items[0].ReleaseDate.Day() != 10 {
+		t.Errorf("expected the latest releases first, got %+v", releases.Items)
+	}
+	// the end date is compared as midnight, so releases later that day are left out
+	inRange, err := s.GetReleasesInDateRange(ctx, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inRange.Items) != 2 || inRange.Items[0].ServiceName != "cart" || inRange.Items[0].ReleaseDate.Day() != 5 {
+		t.Errorf("unexpected releases in range %+v", inRange.Items)
+	}
+}
+
+func TestGraphLookups(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	if err := s.AddDependency(ctx, orders, repositories.Dependency{Id: cart}); err != nil {
+		t.Fatal(err)
+	}
+	team, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	if err := s.CreateTeamAssociation(ctx, team, cart); err != nil {
+		t.Fatal(err)
+	}
+
+	services, _ := s.GetServicesByIds(ctx, []string{cart, "missing"})
+	if len(services) != 1 || services[cart].Name != "cart" {
+		t.Errorf("unexpected services %+v", services)
+	}
+	dependents, _ := s.GetDependentsByServiceIds(ctx, []string{cart, orders})
+	if len(dependents) != 1 || dependents[cart][0].Id != orders {
+		t.Errorf("expected orders to depend on cart only, got %+v", dependents)
+	}
+	owned, _ := s.GetServicesByTeamIds(ctx, []string{team})
+	if len(owned[team]) != 1 || owned[team][0].Id != cart {
+		t.Errorf("unexpected owned services %+v", owned)
+	}
+}
+
+func TestIntegrityReport(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://github.com/shop/cart")
+	if report, _ := s.GetIntegrityReport(ctx); len(report.DuplicateServiceNames)+len(report.OrphanedDebt) != 0 {
+		t.Errorf("expected a clean catalog, got %+v", report)
+	}
+
+	// the store rejects duplicates, so write them as a hand-edited snapshot would
+	s.data.services["copy"] = repositories.Service{Id: "copy", Name: "cart", Url: "https://github.com/shop/cart.git"}
+	s.data.debt = append(s.data.debt, debtItem{Debt: repositories.Debt{Id: "lost", ServiceId: "gone"}})
+	s.data.releases = append(s.data.releases, repositories.Release{ServiceId: "gone", Version: "1.0"})
+	report, err := s.GetIntegrityReport(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.DuplicateServiceNames) != 1 || len(report.DuplicateServiceNames[0].Ids) != 2 {
+		t.Errorf("expected the services named cart, got %+v", report.DuplicateServiceNames)
+	}
+	if len(report.DuplicateServiceUrls) != 1 || !slices.Contains(report.DuplicateServiceUrls[0].Ids, cart) {
+		t.Errorf("expected the services of the cart repository, got %+v", report.DuplicateServiceUrls)
+	}
+	if len(report.OrphanedDebt) != 1 || len(report.OrphanedReleases) != 1 {
+		t.Errorf("expected the orphaned debt and release, got %+v", report)
+	}
+}
@@ -0,0 +1,107 @@
+package memoryrepositories
+
+import (
+	"cmp"
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+)
+
+// SearchCatalog matches every word of the text against services as Search does, and against team names, debt titles
+// and descriptions, and release versions.
+func (s *Store) SearchCatalog(_ context.Context, query repositories.SearchQuery, page pagination.Page) (repositories.SearchResults, error) {
+	results := repositories.SearchResults{Facets: repositories.NewSearchFacets()}
+	terms := searchTerms(query.Text)
+	if len(terms) == 0 {
+		results.Result = paginate([]repositories.SearchHit{}, page)
+		return results, nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var hits []repositories.SearchHit
+	for _, service := range s.sortedServices() {
+		if score := searchScore(service, terms); score > 0 {
+			hits = append(hits, withService(repositories.SearchHit{Kind: repositories.KindService, Id: service.Id,
+				Title: service.Name, Description: service.Description, Score: float64(score)}, service))
+		}
+	}
+	for _, team := range s.data.teams {
+		if score := matchScore(terms, team.Name); score > 0 {
+			hits = append(hits, repositories.SearchHit{Kind: repositories.KindTeam, Id: team.Id, Title: team.Name, Score: float64(score)})
+		}
+	}
+	for _, item := range s.data.debt {
+		if score := matchScore(terms, item.Title, item.Description); score > 0 {
+			hits = append(hits, withService(repositories.SearchHit{Kind: repositories.KindDebt, Id: item.Id,
+				Title: item.Title, Description: item.Description, Score: float64(score)}, s.data.services[item.ServiceId]))
+		}
+	}
+	for _, release := range s.data.releases {
+		if score := matchScore(terms, release.Version); score > 0 {
+			hits = append(hits, withService(repositories.SearchHit{Kind: repositories.KindRelease,
+				Title: release.Version, Score: float64(score)}, s.data.services[release.ServiceId]))
+		}
+	}
+
+	narrowed := make([]repositories.SearchHit, 0, len(hits))
+	for _, hit := range hits {
+		results.Facets.Count(hit, 1)
+		if (len(query.Kinds) == 0 || slices.Contains(query.Kinds, hit.Kind)) &&
+			(query.ServiceType == "" || strings.EqualFold(hit.ServiceType, query.ServiceType)) {
+			narrowed = append(narrowed, hit)
+		}
+	}
+	slices.SortFunc(narrowed, func(a, b repositories.SearchHit) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Id, b.Id),
+			cmp.Compare(a.ServiceId, b.ServiceId), cmp.Compare(a.Title, b.Title))
+	})
+	results.Result = paginate(narrowed, page)
+	return results, nil
+}
+
+// withService fills in the service a hit is or belongs to.
+func withService(hit repositories.SearchHit, service repositories.Service) repositories.SearchHit {
+	hit.ServiceId = service.Id
+	hit.ServiceName = service.Name
+	hit.ServiceType = service.ServiceType
+	return hit
+}
+
+// Autocomplete matches the prefix against every service and team name and id.
+func (s *Store) Autocomplete(_ context.Context, query repositories.AutocompleteQuery) ([]repositories.Suggestion, error) {
+	suggestions := make([]repositories.Suggestion, 0)
+	prefix := repositories.NameWords(query.Prefix)
+	if len(prefix) == 0 {
+		return suggestions, nil
+	}
+	wanted := func(kind repositories.SearchKind) bool {
+		return len(query.Kinds) == 0 || slices.Contains(query.Kinds, kind)
+	}
+	completes := func(suggestion repositories.Suggestion) bool {
+		return repositories.CompletesName(prefix, suggestion.Name) || repositories.CompletesId(query.Prefix, suggestion.Id)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if wanted(repositories.KindService) {
+		for _, service := range s.data.services {
+			suggestion := repositories.Suggestion{Kind: repositories.KindService, Id: service.Id, Name: service.Name,
+				Type: service.ServiceType}
+			if completes(suggestion) {
+				suggestions = append(suggestions, suggestion)
+			}
+		}
+	}
+	if wanted(repositories.KindTeam) {
+		for _, team := range s.data.teams {
+			suggestion := repositories.Suggestion{Kind: repositories.KindTeam, Id: team.Id, Name: team.Name}
+			if completes(suggestion) {
+				suggestions = append(suggestions, suggestion)
+			}
+		}
+	}
+	slices.SortFunc(suggestions, repositories.CompareSuggestions(strings.TrimSpace(query.Prefix)))
+	return suggestions[:min(len(suggestions), query.Limit)], nil
+}
@@ -0,0 +1,97 @@
+package memoryrepositories
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSearchCatalog(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	proxy := createService(t, s, "kafka-proxy", "https://kafka-proxy")
+	cart := createService(t, s, "cart", "https://cart")
+	if _, err := s.CreateTeam(ctx, repositories.Team{Name: "Kafka Guild"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateDebtItem(ctx, repositories.Debt{ServiceId: cart, Type: "code", Title: "Upgrade the kafka client"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateRelease(ctx, repositories.Release{ServiceId: proxy, Version: "1.0.0", ReleaseDate: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := s.SearchCatalog(ctx, repositories.SearchQuery{Text: "kafka~"}, pagination.Page{Limit: 10, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Items) != 3 || *results.TotalCount != 3 {
+		t.Fatalf("expected the service, team and debt item, got %+v", results.Items)
+	}
+	// every hit matches by name or title, so they are ordered by kind
+	if results.Items[0].Kind != repositories.KindDebt || results.Items[0].ServiceName != "cart" {
+		t.Errorf("expected the debt item of cart first, got %+v", results.Items[0])
+	}
+	facets := results.Facets
+	if facets.Kinds[repositories.KindService] != 1 || facets.Kinds[repositories.KindTeam] != 1 || facets.ServiceTypes["api"] != 2 {
+		t.Errorf("unexpected facets %+v", facets)
+	}
+
+	narrowed, _ := s.SearchCatalog(ctx, repositories.SearchQuery{Text: "kafka", Kinds: []repositories.SearchKind{repositories.KindTeam}},
+		pagination.First(10))
+	if len(narrowed.Items) != 1 || narrowed.Items[0].Title != "Kafka Guild" || narrowed.Facets.Kinds[repositories.KindDebt] != 1 {
+		t.Errorf("expected only the team, with facets over every hit, got %+v", narrowed)
+	}
+	if typed, _ := s.SearchCatalog(ctx, repositories.SearchQuery{Text: "1.0", ServiceType: "API"}, pagination.First(10)); len(typed.Items) != 1 ||
+		typed.Items[0].Kind != repositories.KindRelease || typed.Items[0].ServiceId != proxy {
+		t.Errorf("expected the release of kafka-proxy, got %+v", typed.Items)
+	}
+	if empty, _ := s.SearchCatalog(ctx, repositories.SearchQuery{Text: " ~ "}, pagination.First(10)); len(empty.Items) != 0 {
+		t.Errorf("expected no hits for an empty search, got %+v", empty.Items)
+	}
+}
+
+func TestAutocomplete(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	createService(t, s, "legacy-orders", "https://legacy-orders")
+	orders := createService(t, s, "orders", "https://orders")
+	if _, err := s.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "api", Url: "https://cart", Description: "orders things"}); err != nil {
+		t.Fatal(err)
+	}
+	team, err := s.CreateTeam(ctx, repositories.Team{Name: "Order Fulfilment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suggestions, err := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "Ord", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []repositories.Suggestion{
+		{Kind: repositories.KindService, Id: orders, Name: "orders", Type: "api"},
+		{Kind: repositories.KindTeam, Id: team, Name: "Order Fulfilment"},
+	}
+	if len(suggestions) != 3 || suggestions[0] != want[0] || suggestions[1] != want[1] || suggestions[2].Name != "legacy-orders" {
+		t.Errorf("expected names starting with the prefix first, got %+v", suggestions)
+	}
+
+	if teams, _ := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "ord ful", Kinds: []repositories.SearchKind{repositories.KindTeam}, Limit: 10}); len(teams) != 1 || teams[0] != want[1] {
+		t.Errorf("expected only the team, got %+v", teams)
+	}
+	if limited, _ := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "ord", Limit: 1}); len(limited) != 1 || limited[0] != want[0] {
+		t.Errorf("expected only orders, got %+v", limited)
+	}
+	if none, _ := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "--", Limit: 10}); none == nil || len(none) != 0 {
+		t.Errorf("expected no suggestions without a word, got %+v", none)
+	}
+	if byId, _ := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: strings.ToUpper(team[:8]), Limit: 10}); len(byId) != 1 || byId[0] != want[1] {
+		t.Errorf("expected the team whose id starts with the prefix, got %+v", byId)
+	}
+	if byType, _ := s.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "ap", Limit: 10}); len(byType) != 0 {
+		t.Errorf("expected types not to be matched, got %+v", byType)
+	}
+}
@@ -0,0 +1,315 @@
+package memoryrepositories
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"slices"
+	"strings"
+	"time"
+)
+
+func (s *Store) GetAllServices(_ context.Context, filter repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	services := slices.DeleteFunc(s.sortedServices(), func(service repositories.Service) bool {
+		return !s.matches(service, filter)
+	})
+	sort, descending := filter.Order()
+	counts := make(map[string]int)
+	switch sort {
+	case repositories.SortByDependents:
+		for _, service := range services {
+			counts[service.Id] = s.dependentCount(service.Id)
+		}
+	case repositories.SortByDebt:
+		for _, service := range services {
+			counts[service.Id] = s.openDebtOf(service.Id)
+		}
+	}
+	slices.SortStableFunc(services, func(a, b repositories.Service) int {
+		var order int
+		switch sort {
+		case repositories.SortByName:
+			order = cmp.Compare(a.Name, b.Name)
+		case repositories.SortByUpdated:
+			order = lastUpdated(a).Compare(lastUpdated(b))
+		case repositories.SortByDependents, repositories.SortByDebt:
+			order = cmp.Compare(counts[a.Id], counts[b.Id])
+		default:
+			order = a.Created.Compare(b.Created)
+		}
+		if descending {
+			order = -order
+		}
+		return cmp.Or(order, cmp.Compare(a.Id, b.Id))
+	})
+	return paginate(services, page), nil
+}
+
+func (s *Store) CreateService(_ context.Context, service repositories.Service) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.serviceConflict(service); err != nil {
+		return "", err
+	}
+	service.Id = newId()
+	service.Created = s.now()
+	s.data.services[service.Id] = service
+	if err := s.commit(); err != nil {
+		return "", err
+	}
+	return service.Id, nil
+}
+
+func (s *Store) UpdateService(_ context.Context, service repositories.Service) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.data.services[service.Id]
+	if !ok {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Service not found"}
+	}
+	if err := s.serviceConflict(service); err != nil {
+		return err
+	}
+	existing.Name = service.Name
+	existing.ServiceType = service.ServiceType
+	existing.Description = service.Description
+	existing.Url = service.Url
+	existing.Lifecycle = service.Lifecycle
+	existing.Tags = service.Tags
+	existing.Updated = s.now()
+	s.data.services[service.Id] = existing
+	return s.commit()
+}
+
+// DeleteService removes the service with its relationships, debt and releases.
+func (s *Store) DeleteService(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data.services[id]; !ok {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Service not found"}
+	}
+	delete(s.data.services, id)
+	s.data.debt = slices.DeleteFunc(s.data.debt, func(d debtItem) bool { return d.ServiceId == id })
+	s.data.releases = slices.DeleteFunc(s.data.releases, func(r repositories.Release) bool { return r.ServiceId == id })
+	s.data.dependencies = slices.DeleteFunc(s.data.dependencies, func(d dependency) bool {
+		return d.ServiceId == id || d.DependsOnId == id
+	})
+	s.data.owners = slices.DeleteFunc(s.data.owners, func(o ownership) bool { return o.ServiceId == id })
+	return s.commit()
+}
+
+// GetServiceById returns the zero Service when there is no service with the id.
+func (s *Store) GetServiceById(_ context.Context, id string) (repositories.Service, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.services[id], nil
+}
+
+// Search matches every word of query against the name, description, type and url of the services, ignoring
+// case. Services matching by name rank first. The fuzzy "~" suffix of Lucene queries is accepted and ignored.
+func (s *Store) Search(_ context.Context, query searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	services := make([]repositories.Service, 0)
+	if query.IsEmpty() {
+		return paginate(services, page), nil
+	}
+	terms := make([]string, 0, len(query.Terms))
+	for _, term := range query.Terms {
+		terms = append(terms, strings.ToLower(term.Text))
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	scores := make(map[string]int)
+	for _, service := range s.sortedServices() {
+		score := searchScore(service, terms)
+		if (len(terms) == 0 || score > 0) && s.matchesQuery(service, query.Filters) {
+			scores[service.Id] = score
+			services = append(services, service)
+		}
+	}
+	if len(terms) == 0 {
+		slices.SortFunc(services, func(a, b repositories.Service) int {
+			return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Id, b.Id))
+		})
+	}
+	slices.SortStableFunc(services, func(a, b repositories.Service) int {
+		return cmp.Compare(scores[b.Id], scores[a.Id])
+	})
+	return paginate(services, page), nil
+}
+
+func (s *Store) GetTeamsByServiceId(_ context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, ok := s.data.services[serviceId]; !ok {
+		return pagination.Result[repositories.Team]{}, customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Service not found"}
+	}
+	return paginate(s.teamsOf(serviceId), page), nil
+}
+
+func (s *Store) GetServicesByUrl(_ context.Context, repoUrl string) ([]repositories.Service, error) {
+	services := make([]repositories.Service, 0)
+	normalized := repositories.NormalizeRepositoryUrl(repoUrl)
+	if normalized == "" {
+		return services, nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, service := range s.sortedServices() {
+		if repositories.NormalizeRepositoryUrl(service.Url) == normalized {
+			services = append(services, service)
+		}
+	}
+	// oldest first, like the Neo4j repository
+	slices.Reverse(services)
+	return services, nil
+}
+
+// searchScore is zero unless every term is in one of the searched fields, and higher the more are in the name.
+func searchScore(service repositories.Service, terms []string) int {
+	return matchScore(terms, service.Name, service.Description, service.ServiceType, service.Url)
+}
+
+// matchScore is zero unless every term is in title or one of others, ignoring case, and higher the more are in title.
+func matchScore(terms []string, title string, others ...string) int {
+	score := 0
+	for _, term := range terms {
+		switch {
+		case strings.Contains(strings.ToLower(title), term):
+			score += 2
+		case slices.ContainsFunc(others, func(other string) bool { return strings.Contains(strings.ToLower(other), term) }):
+			score++
+		default:
+			return 0
+		}
+	}
+	return score
+}
+
+// searchTerms splits a search into lower case terms. The fuzzy "~" suffix of Lucene queries is accepted and ignored.
+func searchTerms(query string) []string {
+	return strings.Fields(strings.ToLower(strings.ReplaceAll(query, "~", " ")))
+}
+
+// serviceConflict returns a 409 holding the oldest other service with the name or repository url of service, if any.
+func (s *Store) serviceConflict(service repositories.Service) error {
+	services := s.sortedServices()
+	slices.Reverse(services)
+	for _, existing := range services {
+		if msg := service.Conflict(existing); msg != "" {
+			return customerrors.NewConflictError(msg, existing)
+		}
+	}
+	return nil
+}
+
+// sortedServices returns every service, newest first.
+func (s *Store) sortedServices() []repositories.Service {
+	services := make([]repositories.Service, 0, len(s.data.services))
+	for _, service := range s.data.services {
+		services = append(services, service)
+	}
+	newestFirst(services,
+		func(s repositories.Service) time.Time { return s.Created },
+		func(s repositories.Service) string { return s.Id })
+	return services
+}
+
+// teamsOf returns the teams owning the service, by name.
+func (s *Store) teamsOf(serviceId string) []repositories.Team {
+	teams := make([]repositories.Team, 0)
+	for _, o := range s.data.owners {
+		if team, ok := s.data.teams[o.TeamId]; ok && o.ServiceId == serviceId {
+			teams = append(teams, team)
+		}
+	}
+	slices.SortFunc(teams, func(a, b repositories.Team) int { return cmp.Compare(a.Name, b.Name) })
+	return teams
+}
+
+// matches reports whether service passes every condition of filter.
+func (s *Store) matches(service repositories.Service, filter repositories.ServiceFilter) bool {
+	switch {
+	case filter.Type != "" && !strings.EqualFold(service.ServiceType, filter.Type),
+		filter.Lifecycle != "" && !strings.EqualFold(service.Lifecycle, filter.Lifecycle),
+		filter.HasOpenDebt != nil && *filter.HasOpenDebt != (s.openDebtOf(service.Id) > 0),
+		!filter.CreatedAfter.IsZero() && !service.Created.After(filter.CreatedAfter),
+		!filter.CreatedBefore.IsZero() && !service.Created.Before(filter.CreatedBefore),
+		!filter.UpdatedAfter.IsZero() && !lastUpdated(service).After(filter.UpdatedAfter),
+		!filter.UpdatedBefore.IsZero() && !lastUpdated(service).Before(filter.UpdatedBefore):
+		return false
+	}
+	for _, tag := range filter.Tags {
+		if !slices.Contains(service.Tags, tag) {
+			return false
+		}
+	}
+	if filter.Team != "" {
+		return slices.ContainsFunc(s.teamsOf(service.Id), func(team repositories.Team) bool {
+			return team.Id == filter.Team || team.Name == filter.Team
+		})
+	}
+	return true
+}
+
+// matchesQuery reports whether service passes every filter of a search query.
+func (s *Store) matchesQuery(service repositories.Service, filters []searchquery.Filter) bool {
+	for _, filter := range filters {
+		var match bool
+		switch {
+		case filter.Unowned():
+			match = len(s.teamsOf(service.Id)) == 0
+		case filter.Field == searchquery.FieldType:
+			match = strings.EqualFold(service.ServiceType, filter.Value)
+		case filter.Field == searchquery.FieldTeam:
+			match = slices.ContainsFunc(s.teamsOf(service.Id), func(team repositories.Team) bool {
+				return team.Id == filter.Value || strings.EqualFold(team.Name, filter.Value)
+			})
+		case filter.Field == searchquery.FieldName:
+			match = strings.Contains(strings.ToLower(service.Name), strings.ToLower(filter.Value))
+		case filter.Field == searchquery.FieldLifecycle:
+			match = strings.EqualFold(service.Lifecycle, filter.Value)
+		case filter.Field == searchquery.FieldTag:
+			match = slices.Contains(service.Tags, strings.ToLower(filter.Value))
+		}
+		if match == filter.Negated {
+			return false
+		}
+	}
+	return true
+}
+
+// lastUpdated is when the service was last updated, or created when it never was.
+func lastUpdated(service repositories.Service) time.Time {
+	if service.Updated.IsZero() {
+		return service.Created
+	}
+	return service.Updated
+}
+
+// dependentCount counts the services depending on the service, at any version.
+func (s *Store) dependentCount(serviceId string) int {
+	dependents := make(map[string]bool)
+	for _, d := range s.data.dependencies {
+		if d.DependsOnId == serviceId {
+			dependents[d.ServiceId] = true
+		}
+	}
+	return len(dependents)
+}
+
+// openDebtOf counts the debt items of the service that still need work.
+func (s *Store) openDebtOf(serviceId string) int {
+	count := 0
+	for _, item := range s.data.debt {
+		if item.ServiceId == serviceId && isOpen(item.Status) {
+			count++
+		}
+	}
+	return count
+}
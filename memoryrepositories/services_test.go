@@ -0,0 +1,312 @@
+package memoryrepositories
+
+import (
+	"context"
+	"errors"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	"service-atlas/repositories"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newTestStore returns a store whose clock advances a second on each write, so listings have a fixed order.
+func newTestStore() *Store {
+	s := New()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return s
+}
+
+func createService(t *testing.T, s *Store, name, url string) string {
+	t.Helper()
+	id, err := s.CreateService(context.Background(), repositories.Service{Name: name, ServiceType: "api", Url: url})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var httpErr *customerrors.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != status {
+		t.Fatalf("expected HTTP %d, got %v", status, err)
+	}
+}
+
+func TestServices(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://github.com/shop/cart")
+	orders := createService(t, s, "orders", "https://github.com/shop/orders")
+
+	services, err := s.GetAllServices(ctx, repositories.ServiceFilter{}, pagination.Page{Limit: 1, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services.Items) != 1 || services.Items[0].Id != orders {
+		t.Errorf("expected the newest service first, got %+v", services.Items)
+	}
+	if services.NextCursor != pagination.EncodeCursor(1) || services.TotalCount == nil || *services.TotalCount != 2 {
+		t.Errorf("expected a cursor to the next page and a total of 2, got %+v", services)
+	}
+	if services, _ = s.GetAllServices(ctx, repositories.ServiceFilter{}, pagination.Page{Offset: 1, Limit: 1}); len(services.Items) != 1 || services.NextCursor != "" {
+		t.Errorf("expected the last page without a cursor, got %+v", services)
+	}
+	if services, _ = s.GetAllServices(ctx, repositories.ServiceFilter{}, pagination.Page{Offset: 2, Limit: 1}); len(services.Items) != 0 {
+		t.Errorf("expected an empty page past the end, got %+v", services)
+	}
+
+	if err = s.UpdateService(ctx, repositories.Service{Id: cart, Name: "basket", ServiceType: "api", Url: "https://basket"}); err != nil {
+		t.Fatal(err)
+	}
+	svc, _ := s.GetServiceById(ctx, cart)
+	if svc.Name != "basket" || svc.Created.IsZero() || !svc.Updated.After(svc.Created) {
+		t.Errorf("unexpected updated service %+v", svc)
+	}
+	requireStatus(t, s.UpdateService(ctx, repositories.Service{Id: "missing"}), 404)
+
+	if svc, _ = s.GetServiceById(ctx, "missing"); svc.Id != "" {
+		t.Errorf("expected the zero service, got %+v", svc)
+	}
+}
+
+func TestGetAllServicesFiltered(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	users := createService(t, s, "users", "https://users")
+	if err := s.UpdateService(ctx, repositories.Service{Id: cart, Name: "cart", ServiceType: "api", Url: "https://cart",
+		Lifecycle: "active", Tags: []string{"kafka", "pci"}}); err != nil {
+		t.Fatal(err)
+	}
+	team, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	for _, id := range []string{cart, orders} {
+		if err := s.CreateTeamAssociation(ctx, team, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, d := range [][2]string{{orders, cart}, {users, cart}, {users, orders}} {
+		if err := s.AddDependency(ctx, d[0], repositories.Dependency{Id: d[1]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, id := range []string{orders, orders, cart} {
+		if err := s.CreateDebtItem(ctx, repositories.Debt{ServiceId: id, Type: "code", Title: "tests"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	yes, no := true, false
+	tests := []struct {
+		name   string
+		filter repositories.ServiceFilter
+		want   []string
+	}{
+		{"NewestFirst", repositories.ServiceFilter{}, []string{users, orders, cart}},
+		{"Team", repositories.ServiceFilter{Team: "shop"}, []string{orders, cart}},
+		{"TeamById", repositories.ServiceFilter{Team: team}, []string{orders, cart}},
+		{"Lifecycle", repositories.ServiceFilter{Lifecycle: "active"}, []string{cart}},
+		{"Tags", repositories.ServiceFilter{Tags: []string{"pci", "kafka"}}, []string{cart}},
+		{"MissingTag", repositories.ServiceFilter{Tags: []string{"pci", "grpc"}}, []string{}},
+		{"Type", repositories.ServiceFilter{Type: "API", HasOpenDebt: &yes}, []string{orders, cart}},
+		{"NoOpenDebt", repositories.ServiceFilter{HasOpenDebt: &no}, []string{users}},
+		{"UpdatedAfter", repositories.ServiceFilter{UpdatedAfter: s.now()}, []string{}},
+		{"ByName", repositories.ServiceFilter{Sort: repositories.SortByName}, []string{cart, orders, users}},
+		{"ByUpdated", repositories.ServiceFilter{Sort: repositories.SortByUpdated, Descending: true}, []string{cart, users, orders}},
+		{"ByDependents", repositories.ServiceFilter{Sort: repositories.SortByDependents, Descending: true}, []string{cart, orders, users}},
+		{"ByDebt", repositories.ServiceFilter{Sort: repositories.SortByDebt, Descending: true}, []string{orders, cart, users}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			services, err := s.GetAllServices(ctx, tc.filter, pagination.Page{Limit: 10, WithTotal: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, 0)
+			for _, service := range services.Items {
+				got = append(got, service.Id)
+			}
+			if !slices.Equal(got, tc.want) || *services.TotalCount != int64(len(tc.want)) {
+				t.Errorf("got %v of %d, want %v", got, *services.TotalCount, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeleteServiceRemovesRelationships(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	team, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	if err := s.CreateTeamAssociation(ctx, team, cart); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddDependency(ctx, orders, repositories.Dependency{Id: cart}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateDebtItem(ctx, repositories.Debt{ServiceId: cart, Type: "code", Title: "tests"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.DeleteService(ctx, cart); err != nil {
+		t.Fatal(err)
+	}
+	requireStatus(t, s.DeleteService(ctx, cart), 404)
+	if services, _ := s.GetServicesByTeam(ctx, team, pagination.First(10)); len(services.Items) != 0 {
+		t.Errorf("expected the ownership to be removed, got %+v", services)
+	}
+	if dependencies, _ := s.GetDependencies(ctx, orders, pagination.First(10)); len(dependencies.Items) != 0 {
+		t.Errorf("expected the dependency to be removed, got %+v", dependencies)
+	}
+	if counts, _ := s.GetCatalogCounts(ctx); counts.Services != 1 || counts.OpenDebt != 0 {
+		t.Errorf("unexpected counts %+v", counts)
+	}
+}
+
+func TestGetDependentsPaged(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	for i, name := range []string{"orders", "billing", "shipping"} {
+		dependent := createService(t, s, name, "https://"+name)
+		if err := s.AddDependency(ctx, dependent, repositories.Dependency{Id: cart, Version: strconv.Itoa(i % 2)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first, err := s.GetDependents(ctx, cart, "", pagination.Page{Limit: 2, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Items) != 2 || first.Items[0].Name != "billing" || first.NextCursor == "" || *first.TotalCount != 3 {
+		t.Errorf("expected the first two dependents by name, got %+v", first)
+	}
+	atVersion, _ := s.GetDependents(ctx, cart, "0", pagination.First(10))
+	if len(atVersion.Items) != 2 || atVersion.Items[0].Name != "orders" || atVersion.Items[1].Name != "shipping" {
+		t.Errorf("expected the dependents at version 0, got %+v", atVersion.Items)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://github.com/shop/cart")
+	if _, err := s.CreateService(ctx, repositories.Service{Name: "checkout", Description: "pays for the cart", ServiceType: "api", Url: "https://checkout"}); err != nil {
+		t.Fatal(err)
+	}
+	createService(t, s, "orders", "https://orders")
+
+	services, err := s.Search(ctx, searchquery.Text("CART"), pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services.Items) != 2 || services.Items[0].Id != cart {
+		t.Errorf("expected name matches first, got %+v", services)
+	}
+	if services, _ = s.Search(ctx, searchquery.Text("cart pays"), pagination.First(10)); len(services.Items) != 1 || services.Items[0].Name != "checkout" {
+		t.Errorf("expected every term to match, got %+v", services)
+	}
+	if services, _ = s.Search(ctx, searchquery.Query{}, pagination.First(10)); len(services.Items) != 0 {
+		t.Errorf("expected no results for an empty query, got %+v", services)
+	}
+}
+
+func TestSearchWithFilters(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	createService(t, s, "cart", "https://cart")
+	orders := createService(t, s, "orders", "https://orders")
+	if _, err := s.CreateService(ctx, repositories.Service{Name: "order svc", ServiceType: "grpc", Url: "https://order-svc", Lifecycle: "retired", Tags: []string{"pci"}}); err != nil {
+		t.Fatal(err)
+	}
+	team, err := s.CreateTeam(ctx, repositories.Team{Name: "Payments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.CreateTeamAssociation(ctx, team, orders); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"team:payments", []string{"orders"}},
+		{"owner:none", []string{"cart", "order svc"}},
+		{"-owner:none", []string{"orders"}},
+		{"order -lifecycle:retired", []string{"orders"}},
+		{`name:"order svc" type:GRPC tag:PCI`, []string{"order svc"}},
+		{"type:grpc", []string{"order svc"}},
+		{"-type:grpc", []string{"cart", "orders"}},
+	}
+	for _, tc := range tests {
+		query, err := searchquery.Parse(tc.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		services, err := s.Search(ctx, query, pagination.First(10))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, service := range services.Items {
+			names = append(names, service.Name)
+		}
+		if !slices.Equal(names, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.query, names, tc.want)
+		}
+	}
+}
+
+func TestGetServicesByUrl(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://github.com/shop/cart")
+	createService(t, s, "cart mirror", "https://gitlab.com/shop/cart")
+	for _, url := range []string{"git@github.com:shop/cart.git", "HTTPS://GITHUB.COM/shop/cart/"} {
+		services, err := s.GetServicesByUrl(ctx, url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(services) != 1 || services[0].Id != cart {
+			t.Errorf("GetServicesByUrl(%q) = %+v, want service %s", url, services, cart)
+		}
+	}
+}
+
+func TestServiceConflicts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://github.com/shop/cart")
+	basket := createService(t, s, "basket", "https://github.com/shop/basket")
+
+	for _, duplicate := range []repositories.Service{
+		{Name: "cart", ServiceType: "api", Url: "https://github.com/shop/till"},
+		{Name: "till", ServiceType: "api", Url: "git@github.com:shop/cart.git"},
+	} {
+		_, err := s.CreateService(ctx, duplicate)
+		var conflict *customerrors.ConflictError
+		if !errors.As(err, &conflict) || conflict.Status != 409 {
+			t.Fatalf("expected a conflict creating %+v, got %v", duplicate, err)
+		}
+		if existing := conflict.Existing.(repositories.Service); existing.Id != cart {
+			t.Errorf("expected the conflict to hold the cart service, got %+v", existing)
+		}
+	}
+	err := s.UpdateService(ctx, repositories.Service{Id: basket, Name: "cart", ServiceType: "api", Url: "https://github.com/shop/basket"})
+	requireStatus(t, err, 409)
+	// keeping its own name and url is not a conflict
+	if err = s.UpdateService(ctx, repositories.Service{Id: cart, Name: "cart", ServiceType: "web", Url: "https://github.com/shop/cart"}); err != nil {
+		t.Error(err)
+	}
+}
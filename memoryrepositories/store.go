@@ -0,0 +1,115 @@
+// Package memoryrepositories keeps the catalog in memory, for local development, demos and small installations
+// without a Neo4j database. It behaves like the Neo4j repositories, returning the same errors. The catalog is lost
+// when the process stops unless the store is opened on a file, and no events are recorded for the outbox.
+package memoryrepositories
+
+import (
+	"cmp"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Store holds the whole catalog and implements every repository over it. It is safe for concurrent use.
+type Store struct {
+	mu   sync.RWMutex
+	now  func() time.Time
+	data data
+	// file persists the catalog, or is nil when it is only kept in memory.
+	file *file
+}
+
+// data is the catalog. Relationships refer to services and teams by id and are removed with them.
+type data struct {
+	services     map[string]repositories.Service
+	teams        map[string]repositories.Team
+	debt         []debtItem
+	releases     []repositories.Release
+	dependencies []dependency
+	owners       []ownership
+	apiKeys      map[string]repositories.ApiKey
+}
+
+// debtItem is a debt item with its creation time, which items are listed by.
+type debtItem struct {
+	repositories.Debt
+	Created time.Time `json:"created"`
+}
+
+// dependency is a DEPENDS_ON relationship. A service can depend on another at several versions.
+type dependency struct {
+	ServiceId   string `json:"serviceId"`
+	DependsOnId string `json:"dependsOnId"`
+	Version     string `json:"version,omitempty"`
+}
+
+// ownership is a team owning a service.
+type ownership struct {
+	TeamId    string `json:"teamId"`
+	ServiceId string `json:"serviceId"`
+}
+
+func New() *Store {
+	return &Store{
+		now: func() time.Time { return time.Now().UTC() },
+		data: data{
+			services: make(map[string]repositories.Service),
+			teams:    make(map[string]repositories.Team),
+			apiKeys:  make(map[string]repositories.ApiKey),
+		},
+	}
+}
+
+// Repositories returns the store as every repository of the catalog.
+func (s *Store) Repositories() repositories.Repositories {
+	return repositories.Repositories{
+		Services:     s,
+		Dependencies: s,
+		Teams:        s,
+		Debt:         s,
+		Releases:     s,
+		Reports:      s,
+		Graph:        s,
+		ApiKeys:      s,
+		Search:       s,
+	}
+}
+
+func newId() string {
+	return uuid.NewString()
+}
+
+func serviceNotFound(id string) error {
+	return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Service not found: " + id}
+}
+
+func invalidPage(page pagination.Page) error {
+	if page.Offset < 0 || page.Limit <= 0 {
+		return &customerrors.HTTPError{
+			Status: http.StatusBadRequest,
+			Msg:    "page offset and limit must not be negative",
+		}
+	}
+	return nil
+}
+
+// paginate returns the page of items, or an empty one when the page is out of range.
+func paginate[T any](items []T, page pagination.Page) pagination.Result[T] {
+	if invalidPage(page) != nil {
+		return pagination.NewResult(items[:0], page)
+	}
+	return pagination.Slice(items, page)
+}
+
+// newestFirst orders items by their creation time, newest first, breaking ties by id so pages are stable.
+func newestFirst[T any](items []T, created func(T) time.Time, id func(T) string) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Or(created(b).Compare(created(a)), cmp.Compare(id(a), id(b)))
+	})
+}
@@ -0,0 +1,113 @@
+package memoryrepositories
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"slices"
+	"time"
+)
+
+func (s *Store) CreateTeam(_ context.Context, team repositories.Team) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.teamConflict(team); err != nil {
+		return "", err
+	}
+	team.Id = newId()
+	team.Created = s.now()
+	team.Updated = team.Created
+	s.data.teams[team.Id] = team
+	if err := s.commit(); err != nil {
+		return "", err
+	}
+	return team.Id, nil
+}
+
+func (s *Store) GetTeam(_ context.Context, teamId string) (*repositories.Team, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	team, ok := s.data.teams[teamId]
+	if !ok {
+		return nil, customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Team not found"}
+	}
+	return &team, nil
+}
+
+func (s *Store) GetTeams(_ context.Context, page pagination.Page) (pagination.Result[repositories.Team], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	teams := make([]repositories.Team, 0, len(s.data.teams))
+	for _, team := range s.data.teams {
+		teams = append(teams, team)
+	}
+	newestFirst(teams,
+		func(t repositories.Team) time.Time { return t.Created },
+		func(t repositories.Team) string { return t.Id })
+	return paginate(teams, page), nil
+}
+
+func (s *Store) UpdateTeam(_ context.Context, team repositories.Team) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.data.teams[team.Id]
+	if !ok {
+		return customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Team not found"}
+	}
+	if err := s.teamConflict(team); err != nil {
+		return err
+	}
+	existing.Name = team.Name
+	existing.Updated = s.now()
+	s.data.teams[team.Id] = existing
+	return s.commit()
+}
+
+// DeleteTeam removes the team and the services' ownership by it.
+func (s *Store) DeleteTeam(_ context.Context, teamId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.data.teams[teamId]; !ok {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Team not found"}
+	}
+	delete(s.data.teams, teamId)
+	s.data.owners = slices.DeleteFunc(s.data.owners, func(o ownership) bool { return o.TeamId == teamId })
+	return s.commit()
+}
+
+func (s *Store) CreateTeamAssociation(_ context.Context, teamId, serviceId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, teamFound := s.data.teams[teamId]
+	_, serviceFound := s.data.services[serviceId]
+	if !teamFound || !serviceFound {
+		return &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Failed to create team association"}
+	}
+	association := ownership{TeamId: teamId, ServiceId: serviceId}
+	if !slices.Contains(s.data.owners, association) {
+		s.data.owners = append(s.data.owners, association)
+	}
+	return s.commit()
+}
+
+// DeleteTeamAssociation succeeds without a change when the team does not own the service.
+func (s *Store) DeleteTeamAssociation(_ context.Context, teamId, serviceId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.owners = slices.DeleteFunc(s.data.owners, func(o ownership) bool {
+		return o == ownership{TeamId: teamId, ServiceId: serviceId}
+	})
+	return s.commit()
+}
+
+// teamConflict returns a 409 holding the other team named like team, if any.
+func (s *Store) teamConflict(team repositories.Team) error {
+	for _, existing := range s.data.teams {
+		if existing.Id != team.Id && existing.Name == team.Name {
+			return customerrors.NewConflictError("A team named "+team.Name+" already exists", existing)
+		}
+	}
+	return nil
+}
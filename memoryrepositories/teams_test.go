@@ -0,0 +1,107 @@
+package memoryrepositories
+
+import (
+	"context"
+	"errors"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/repositories"
+	"testing"
+)
+
+func TestTeams(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	first, _ := s.CreateTeam(ctx, repositories.Team{Name: "first"})
+	second, _ := s.CreateTeam(ctx, repositories.Team{Name: "second"})
+
+	teams, err := s.GetTeams(ctx, pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams.Items) != 2 || teams.Items[0].Id != second {
+		t.Errorf("expected the newest team first, got %+v", teams.Items)
+	}
+	if err = s.UpdateTeam(ctx, repositories.Team{Id: first, Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if team, _ := s.GetTeam(ctx, first); team.Name != "renamed" {
+		t.Errorf("expected the renamed team, got %+v", team)
+	}
+
+	// the Neo4j repository returns team lookups' errors as values
+	var notFound customerrors.HTTPError
+	if _, err = s.GetTeam(ctx, "missing"); !errors.As(err, &notFound) || notFound.Status != 404 {
+		t.Errorf("expected a 404 for a missing team, got %v", err)
+	}
+	if err = s.DeleteTeam(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+	requireStatus(t, s.DeleteTeam(ctx, second), 404)
+}
+
+func TestTeamConflicts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	payments, _ := s.CreateTeam(ctx, repositories.Team{Name: "payments"})
+	shop, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+
+	_, err := s.CreateTeam(ctx, repositories.Team{Name: "payments"})
+	requireStatus(t, err, 409)
+	requireStatus(t, s.UpdateTeam(ctx, repositories.Team{Id: shop, Name: "payments"}), 409)
+	if err = s.UpdateTeam(ctx, repositories.Team{Id: payments, Name: "payments"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamAssociations(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	cart := createService(t, s, "cart", "https://cart")
+	team, _ := s.CreateTeam(ctx, repositories.Team{Name: "shop"})
+
+	requireStatus(t, s.CreateTeamAssociation(ctx, team, "missing"), 404)
+	for range 2 {
+		if err := s.CreateTeamAssociation(ctx, team, cart); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result, err := s.GetTeamsByServiceId(ctx, cart, pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if teams := result.Items; len(teams) != 1 || teams[0].Id != team {
+		t.Errorf("expected the team once, got %+v", teams)
+	}
+
+	if err = s.DeleteTeamAssociation(ctx, team, cart); err != nil {
+		t.Fatal(err)
+	}
+	if services, _ := s.GetServicesByTeam(ctx, team, pagination.First(10)); len(services.Items) != 0 {
+		t.Errorf("expected the association to be removed, got %+v", services)
+	}
+	if err = s.DeleteTeamAssociation(ctx, team, cart); err != nil {
+		t.Errorf("expected removing a missing association to succeed, got %v", err)
+	}
+}
+
+func TestApiKeys(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore()
+	id, err := s.CreateApiKey(ctx, repositories.ApiKey{Name: "ci", Scopes: []string{"read"}, Hash: "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.CreateApiKey(ctx, repositories.ApiKey{Name: "copy", Scopes: []string{"read"}, Hash: "abc"}); err == nil {
+		t.Error("expected a duplicate hash to be rejected")
+	}
+	key, err := s.GetApiKeyByHash(ctx, "abc")
+	if err != nil || key.Id != id {
+		t.Fatalf("expected key %s, got %+v, %v", id, key, err)
+	}
+	if err = s.DeleteApiKey(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.GetApiKeyByHash(ctx, "abc")
+	requireStatus(t, err, 404)
+}
@@ -0,0 +1,53 @@
+package apikeyrepository
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (r Neo4jApiKeyRepository) CreateApiKey(ctx context.Context, key repositories.ApiKey) (string, error) {
+	createKeyTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			CREATE (k:ApiKey {id: randomuuid(), created: datetime(), name: $name, scopes: $scopes, hash: $hash})
+			RETURN k.id AS id
+		`, map[string]any{
+			"name":   key.Name,
+			"scopes": key.Scopes,
+			"hash":   key.Hash,
+		})
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		id, _ := record.Get("id")
+		key.Id, _ = id.(string)
+		if err = outbox.Record(ctx, tx, events.ApiKeyCreated, events.AggregateApiKey, key.Id, key); err != nil {
+			return nil, err
+		}
+		return key.Id, nil
+	}
+	result, err := r.manager.ExecuteWrite(ctx, createKeyTransaction)
+	if err != nil {
+		return "", &customerrors.HTTPError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Error creating api key",
+		}
+	}
+	id, ok := result.(string)
+	if !ok || id == "" {
+		return "", &customerrors.HTTPError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Id not returned when creating api key",
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,35 @@
+package apikeyrepository
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (r Neo4jApiKeyRepository) DeleteApiKey(ctx context.Context, id string) error {
+	deleteKeyTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			OPTIONAL MATCH (k:ApiKey {id: $id})
+			DELETE k
+			RETURN count(k) AS deletedCount
+		`, map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		deletedCount, _ := record.Get("deletedCount")
+		if count, _ := deletedCount.(int64); count == 0 {
+			return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Api key not found"}
+		}
+		return nil, outbox.Record(ctx, tx, events.ApiKeyRevoked, events.AggregateApiKey, id, map[string]string{"id": id})
+	}
+	_, err := r.manager.ExecuteWrite(ctx, deleteKeyTransaction)
+	return err
+}
@@ -0,0 +1,107 @@
+package apikeyrepository
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (r Neo4jApiKeyRepository) GetApiKeys(ctx context.Context, page pagination.Page) (pagination.Result[repositories.ApiKey], error) {
+	getKeysTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			MATCH (k:ApiKey)
+			RETURN k
+			ORDER BY k.created DESC, k.id
+			SKIP $skip
+			LIMIT $limit
+		`, nRepo.PageParams(page, nil))
+		if err != nil {
+			return nil, err
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]repositories.ApiKey, 0, len(records))
+		for _, record := range records {
+			node, _ := record.Get("k")
+			if n, ok := node.(neo4j.Node); ok {
+				keys = append(keys, mapNodeToApiKey(n))
+			}
+		}
+		paged := pagination.NewResult(keys, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `MATCH (k:ApiKey) RETURN count(k) AS total`, nil)
+		return paged, err
+	}
+	result, err := r.manager.ExecuteRead(ctx, getKeysTransaction)
+	if err != nil {
+		return pagination.Result[repositories.ApiKey]{}, &customerrors.HTTPError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Error retrieving api keys",
+		}
+	}
+	keys, _ := result.(pagination.Result[repositories.ApiKey])
+	return keys, nil
+}
+
+func (r Neo4jApiKeyRepository) GetApiKeyByHash(ctx context.Context, hash string) (*repositories.ApiKey, error) {
+	getKeyTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			MATCH (k:ApiKey {hash: $hash})
+			RETURN k
+		`, map[string]any{"hash": hash})
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+		node, _ := result.Record().Get("k")
+		n, ok := node.(neo4j.Node)
+		if !ok {
+			return nil, nil
+		}
+		key := mapNodeToApiKey(n)
+		return &key, nil
+	}
+	result, err := r.manager.ExecuteRead(ctx, getKeyTransaction)
+	if err != nil {
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusInternalServerError,
+			Msg:    "Error retrieving api key",
+		}
+	}
+	key, _ := result.(*repositories.ApiKey)
+	if key == nil {
+		return nil, &customerrors.HTTPError{
+			Status: http.StatusNotFound,
+			Msg:    "Api key not found",
+		}
+	}
+	return key, nil
+}
+
+func mapNodeToApiKey(n neo4j.Node) repositories.ApiKey {
+	key := repositories.ApiKey{}
+	key.Id, _ = n.Props["id"].(string)
+	key.Name, _ = n.Props["name"].(string)
+	key.Hash, _ = n.Props["hash"].(string)
+	key.Created, _ = n.Props["created"].(time.Time)
+	if scopes, ok := n.Props["scopes"].([]any); ok {
+		for _, scope := range scopes {
+			if s, ok := scope.(string); ok {
+				key.Scopes = append(key.Scopes, s)
+			}
+		}
+	}
+	return key
+}
@@ -0,0 +1,15 @@
+package apikeyrepository
+
+import (
+	"service-atlas/databaseadapter"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type Neo4jApiKeyRepository struct {
+	manager databaseadapter.DriverManager
+}
+
+func New(driver neo4j.DriverWithContext) *Neo4jApiKeyRepository {
+	return &Neo4jApiKeyRepository{manager: databaseadapter.NewDriverManager(driver)}
+}
@@ -0,0 +1,72 @@
+package apikeyrepository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	"service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jApiKeyRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx := context.Background()
+	tc, err := neo4jrepositories.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = tc.Container.Terminate(ctx)
+	})
+
+	driver, err := neo4j.NewDriverWithContext(
+		tc.Endpoint,
+		neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = driver.Close(ctx)
+	}()
+	repo := New(driver)
+
+	id, err := repo.CreateApiKey(ctx, repositories.ApiKey{Name: "ci", Scopes: []string{"read", "write:debt"}, Hash: "abc123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := repo.GetApiKeyByHash(ctx, "abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Id != id || key.Name != "ci" || len(key.Scopes) != 2 || key.Scopes[1] != "write:debt" || key.Created.IsZero() {
+		t.Errorf("unexpected key %+v", key)
+	}
+
+	keysResult, err := repo.GetApiKeys(ctx, pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := keysResult.Items
+	if len(keys) != 1 || keys[0].Id != id {
+		t.Errorf("unexpected keys %+v", keys)
+	}
+
+	if err = repo.DeleteApiKey(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	var httpErr *customerrors.HTTPError
+	if _, err = repo.GetApiKeyByHash(ctx, "abc123"); !errors.As(err, &httpErr) || httpErr.Status != http.StatusNotFound {
+		t.Errorf("expected not found after revoking, got %v", err)
+	}
+	if err = repo.DeleteApiKey(ctx, id); !errors.As(err, &httpErr) || httpErr.Status != http.StatusNotFound {
+		t.Errorf("expected not found revoking twice, got %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -34,10 +36,11 @@ func (n Neo4jDebtRepository) CreateDebtItem(ctx context.Context, debt repositori
 				Msg:    fmt.Sprintf("Service not found: %s", debt.ServiceId),
 			}
 		}
-		_, err = tx.Run(ctx, `
+		result, err = tx.Run(ctx, `
 				MATCH (s:Service {id: $serviceId})
 				CREATE (n:Debt {id: randomuuid(), created: datetime(), title: $title, type: $type, description: $description, status: $status})
 				CREATE (s)-[r:OWNS]->(n)
+				RETURN n.id AS id
         `, map[string]any{
 			"title":       debt.Title,
 			"type":        debt.Type,
@@ -45,7 +48,18 @@ func (n Neo4jDebtRepository) CreateDebtItem(ctx context.Context, debt repositori
 			"status":      DefaultStatus,
 			"serviceId":   debt.ServiceId,
 		})
-		return nil, err
+		if err != nil {
+			return nil, err
+		}
+		record, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if id, ok := record.Get("id"); ok && id != nil {
+			debt.Id, _ = id.(string)
+		}
+		debt.Status = DefaultStatus
+		return nil, outbox.Record(ctx, tx, events.DebtCreated, events.AggregateDebt, debt.Id, debt)
 	}
 	_, err := n.manager.ExecuteWrite(ctx, createDebtTransaction)
 	return err
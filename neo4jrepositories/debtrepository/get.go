@@ -4,16 +4,18 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (n Neo4jDebtRepository) GetDebtByServiceId(ctx context.Context, id string, page, pageSize int, onlyResolved bool) ([]repositories.Debt, error) {
-	if page <= 0 || pageSize <= 0 {
-		return nil, &customerrors.HTTPError{
+func (n Neo4jDebtRepository) GetDebtByServiceId(ctx context.Context, id string, page pagination.Page, onlyResolved bool) (pagination.Result[repositories.Debt], error) {
+	if page.Offset < 0 || page.Limit <= 0 {
+		return pagination.Result[repositories.Debt]{}, &customerrors.HTTPError{
 			Status: http.StatusBadRequest,
-			Msg:    "page and page size must be positive integers",
+			Msg:    "page offset and limit must not be negative",
 		}
 	}
 	getByServiceIdTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
@@ -22,8 +24,6 @@ func (n Neo4jDebtRepository) GetDebtByServiceId(ctx context.Context, id string,
 		`
 		params := map[string]any{
 			"serviceId": id,
-			"skip":      (page - 1) * pageSize,
-			"limit":     pageSize,
 		}
 		if onlyResolved {
 			cypher += `
@@ -31,12 +31,14 @@ func (n Neo4jDebtRepository) GetDebtByServiceId(ctx context.Context, id string,
 			`
 			params["status"] = "remediated"
 		}
+		total := cypher + `
+			RETURN count(d) AS total`
 		cypher += `
 			RETURN d.title as title, d.description as description, d.type as type, d.status as status, d.id as id
-			ORDER BY d.created DESC
+			ORDER BY d.created DESC, d.id
 			SKIP $skip
 			LIMIT $limit`
-		result, err := tx.Run(ctx, cypher, params)
+		result, err := tx.Run(ctx, cypher, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -75,17 +77,19 @@ func (n Neo4jDebtRepository) GetDebtByServiceId(ctx context.Context, id string,
 		if err := result.Err(); err != nil {
 			return nil, err
 		}
-		return debtList, nil
+		paged := pagination.NewResult(debtList, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, total, params)
+		return paged, err
 	}
 
 	debtList, err := n.manager.ExecuteRead(ctx, getByServiceIdTransaction)
 	if err != nil {
-		return nil, err
+		return pagination.Result[repositories.Debt]{}, err
 	}
-	if typedDebtList, ok := debtList.([]repositories.Debt); ok {
+	if typedDebtList, ok := debtList.(pagination.Result[repositories.Debt]); ok {
 		return typedDebtList, nil
 	}
-	return nil, &customerrors.HTTPError{
+	return pagination.Result[repositories.Debt]{}, &customerrors.HTTPError{
 		Status: http.StatusInternalServerError,
 		Msg:    "unexpected return type from transaction",
 	}
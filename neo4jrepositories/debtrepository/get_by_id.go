@@ -0,0 +1,45 @@
+package debtrepository
+
+import (
+	"context"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (n Neo4jDebtRepository) GetDebtById(ctx context.Context, id string) (repositories.Debt, error) {
+	debt, err := n.manager.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			MATCH (s:Service)-[:OWNS]->(d:Debt {id: $id})
+			RETURN d.title AS title, d.description AS description, d.type AS type, d.status AS status, s.id AS serviceId
+		`, map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next(ctx) {
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+			return nil, &customerrors.HTTPError{Status: http.StatusNotFound, Msg: "Debt not found"}
+		}
+		record := result.Record()
+		text := func(key string) string {
+			value, _, _ := neo4j.GetRecordValue[string](record, key)
+			return value
+		}
+		return repositories.Debt{
+			Id:          id,
+			ServiceId:   text("serviceId"),
+			Title:       text("title"),
+			Description: text("description"),
+			Type:        text("type"),
+			Status:      text("status"),
+		}, nil
+	})
+	if err != nil {
+		return repositories.Debt{}, err
+	}
+	return debt.(repositories.Debt), nil
+}
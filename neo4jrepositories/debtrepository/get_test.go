@@ -3,6 +3,7 @@ package debtrepository
 import (
 	"context"
 	"errors"
+	"service-atlas/internal/pagination"
 	"testing"
 	"time"
 
@@ -99,10 +100,11 @@ func TestNeo4jDebtRepository_GetDebtByServiceId_BasicAndFilter(t *testing.T) {
 	}
 
 	// Act: Get without filter (should return both)
-	list, err := repo.GetDebtByServiceId(ctx, serviceID, 1, 10, false)
+	listResult, err := repo.GetDebtByServiceId(ctx, serviceID, pagination.FromPage(1, 10), false)
 	if err != nil {
 		t.Fatalf("GetDebtByServiceId error: %v", err)
 	}
+	list := listResult.Items
 	if len(list) < 2 {
 		t.Fatalf("expected at least 2 debts, got %d", len(list))
 	}
@@ -122,10 +124,11 @@ func TestNeo4jDebtRepository_GetDebtByServiceId_BasicAndFilter(t *testing.T) {
 	}
 
 	// Act: Get only resolved
-	resolved, err := repo.GetDebtByServiceId(ctx, serviceID, 1, 10, true)
+	resolvedResult, err := repo.GetDebtByServiceId(ctx, serviceID, pagination.FromPage(1, 10), true)
 	if err != nil {
 		t.Fatalf("GetDebtByServiceId(onlyResolved) error: %v", err)
 	}
+	resolved := resolvedResult.Items
 	if len(resolved) != 1 {
 		t.Fatalf("expected exactly 1 resolved debt, got %d", len(resolved))
 	}
@@ -157,7 +160,7 @@ func TestNeo4jDebtRepository_GetDebtByServiceId_InvalidPaging(t *testing.T) {
 
 	repo := New(driver)
 
-	_, err = repo.GetDebtByServiceId(ctx, "some-service", 0, 10, false)
+	_, err = repo.GetDebtByServiceId(ctx, "some-service", pagination.Page{Offset: -1, Limit: 10}, false)
 	if err == nil {
 		t.Fatalf("expected error for invalid page")
 	}
@@ -169,7 +172,7 @@ func TestNeo4jDebtRepository_GetDebtByServiceId_InvalidPaging(t *testing.T) {
 		t.Fatalf("expected 400, got %d", httpErr.Status)
 	}
 
-	_, err = repo.GetDebtByServiceId(ctx, "some-service", 1, 0, false)
+	_, err = repo.GetDebtByServiceId(ctx, "some-service", pagination.Page{Limit: 0}, false)
 	if err == nil {
 		t.Fatalf("expected error for invalid page size")
 	}
@@ -3,6 +3,8 @@ package debtrepository
 import (
 	"context"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
@@ -31,7 +33,10 @@ func (n Neo4jDebtRepository) UpdateStatus(ctx context.Context, id, status string
 		} else if result.Err() != nil {
 			return nil, result.Err()
 		}
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.DebtStatusUpdated, events.AggregateDebt, id, map[string]string{
+			"id":     id,
+			"status": status,
+		})
 	})
 	return err
 }
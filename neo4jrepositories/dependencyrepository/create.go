@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -71,7 +73,11 @@ func (d *Neo4jDependencyRepository) AddDependency(ctx context.Context, id string
 			return nil, err
 		}
 
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.DependencyCreated, events.AggregateService, id, map[string]string{
+			"serviceId":   id,
+			"dependsOnId": dependency.Id,
+			"version":     dependency.Version,
+		})
 	}
 
 	_, err := d.manager.ExecuteWrite(ctx, createDependencyTransaction)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
@@ -48,7 +50,10 @@ func (d *Neo4jDependencyRepository) DeleteDependency(ctx context.Context, id str
 			return nil, err
 		}
 
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.DependencyDeleted, events.AggregateService, id, map[string]string{
+			"serviceId":   id,
+			"dependsOnId": dependsOnID,
+		})
 	}
 
 	_, err := d.manager.ExecuteWrite(ctx, deleteDependencyTransaction)
@@ -3,39 +3,59 @@ package dependencyrepository
 import (
 	"context"
 	"fmt"
+	"maps"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (d *Neo4jDependencyRepository) GetDependencies(ctx context.Context, id string) ([]*repositories.Dependency, error) {
+func (d *Neo4jDependencyRepository) GetDependencies(ctx context.Context, id string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
 
 	query := `
 			MATCH (s1:Service {id: $serviceId})-[r:DEPENDS_ON]->(s2:Service)
 			RETURN s2.id as id, s2.name as name, r.version as version, s2.type as type
+			ORDER BY name, id
+			SKIP $skip
+			LIMIT $limit
 		`
-	result, err := d.manager.ExecuteRead(ctx, makeGetTransaction(ctx, id, query))
+	countQuery := `
+			MATCH (:Service {id: $serviceId})-[r:DEPENDS_ON]->(:Service)
+			RETURN count(r) AS total
+		`
+	result, err := d.manager.ExecuteRead(ctx, makeGetTransaction(ctx, id, query, countQuery, nil, page))
 	if err != nil {
-		return nil, err
+		return pagination.Result[*repositories.Dependency]{}, err
 	}
 
-	return result.([]*repositories.Dependency), nil
+	return result.(pagination.Result[*repositories.Dependency]), nil
 }
 
-func (d *Neo4jDependencyRepository) GetDependents(ctx context.Context, id string) ([]*repositories.Dependency, error) {
+func (d *Neo4jDependencyRepository) GetDependents(ctx context.Context, id string, version string, page pagination.Page) (pagination.Result[*repositories.Dependency], error) {
 	query := `
 			MATCH (s1:Service)-[r:DEPENDS_ON]->(s2:Service {id: $serviceId})
+			WHERE $version = '' OR r.version = $version
 			RETURN s1.id as id, s1.name as name, s1.type as type, r.version as version
+			ORDER BY name, id
+			SKIP $skip
+			LIMIT $limit
+		`
+	countQuery := `
+			MATCH (:Service)-[r:DEPENDS_ON]->(:Service {id: $serviceId})
+			WHERE $version = '' OR r.version = $version
+			RETURN count(r) AS total
 		`
-	result, err := d.manager.ExecuteRead(ctx, makeGetTransaction(ctx, id, query))
+	params := map[string]any{"version": version}
+	result, err := d.manager.ExecuteRead(ctx, makeGetTransaction(ctx, id, query, countQuery, params, page))
 	if err != nil {
-		return nil, err
+		return pagination.Result[*repositories.Dependency]{}, err
 	}
-	return result.([]*repositories.Dependency), nil
+	return result.(pagination.Result[*repositories.Dependency]), nil
 }
 
-func makeGetTransaction(ctx context.Context, id string, query string) func(tx neo4j.ManagedTransaction) (any, error) {
+func makeGetTransaction(ctx context.Context, id string, query string, countQuery string, params map[string]any, page pagination.Page) func(tx neo4j.ManagedTransaction) (any, error) {
 	return func(tx neo4j.ManagedTransaction) (any, error) {
 		// First check if the service exists
 		checkQuery := `
@@ -63,9 +83,9 @@ func makeGetTransaction(ctx context.Context, id string, query string) func(tx ne
 
 		// Find all services that depend on the service with the given ID
 
-		result, err = tx.Run(ctx, query, map[string]any{
-			"serviceId": id,
-		})
+		withService := map[string]any{"serviceId": id}
+		maps.Copy(withService, params)
+		result, err = tx.Run(ctx, query, nRepo.PageParams(page, withService))
 		if err != nil {
 			return nil, err
 		}
@@ -100,6 +120,8 @@ func makeGetTransaction(ctx context.Context, id string, query string) func(tx ne
 			dependencies = append(dependencies, dependency)
 		}
 
-		return dependencies, nil
+		paged := pagination.NewResult(dependencies, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, countQuery, withService)
+		return paged, err
 	}
 }
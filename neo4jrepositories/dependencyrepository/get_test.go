@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	"service-atlas/neo4jrepositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -58,10 +59,11 @@ func TestNeo4jDependencyRepository_GetDependencies_Success(t *testing.T) {
 	}
 
 	// Act
-	deps, err := repo.GetDependencies(ctx, s1)
+	result, err := repo.GetDependencies(ctx, s1, pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetDependencies returned error: %v", err)
 	}
+	deps := result.Items
 
 	// Assert
 	if len(deps) != 2 {
@@ -115,7 +117,7 @@ func TestNeo4jDependencyRepository_GetDependencies_NotFound(t *testing.T) {
 
 	repo := New(driver)
 
-	_, err = repo.GetDependencies(ctx, "00000000-0000-0000-0000-000000000000")
+	_, err = repo.GetDependencies(ctx, "00000000-0000-0000-0000-000000000000", pagination.First(10))
 	if err == nil {
 		t.Fatalf("expected error when service not found")
 	}
@@ -172,10 +174,11 @@ func TestNeo4jDependencyRepository_GetDependents_Success(t *testing.T) {
 	}
 
 	// Act
-	deps, err := repo.GetDependents(ctx, sB)
+	result, err := repo.GetDependents(ctx, sB, "", pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetDependents returned error: %v", err)
 	}
+	deps := result.Items
 
 	// Assert: expect 2 dependents A and C
 	if len(deps) != 2 {
@@ -205,6 +208,15 @@ func TestNeo4jDependencyRepository_GetDependents_Success(t *testing.T) {
 	if !seen["A"] || !seen["C"] {
 		t.Fatalf("missing expected dependents: %+v", seen)
 	}
+
+	// only the dependents at the version are paged
+	result, err = repo.GetDependents(ctx, sB, "0.1.0", pagination.Page{Limit: 1, WithTotal: true})
+	if err != nil {
+		t.Fatalf("GetDependents returned error: %v", err)
+	}
+	if len(result.Items) != 1 || result.Items[0].Id != sA || result.NextCursor != "" || *result.TotalCount != 1 {
+		t.Fatalf("expected only A at version 0.1.0, got %+v", result)
+	}
 }
 
 func TestNeo4jDependencyRepository_GetDependents_NotFound(t *testing.T) {
@@ -233,7 +245,7 @@ func TestNeo4jDependencyRepository_GetDependents_NotFound(t *testing.T) {
 
 	repo := New(driver)
 
-	_, err = repo.GetDependents(ctx, "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	_, err = repo.GetDependents(ctx, "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb", "", pagination.First(10))
 	if err == nil {
 		t.Fatalf("expected error when service not found")
 	}
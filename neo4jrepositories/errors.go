@@ -0,0 +1,22 @@
+package neo4jrepositories
+
+import (
+	"errors"
+	"net/http"
+	"service-atlas/internal/customerrors"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// constraintFailed is the code of the error raised when a write breaks a uniqueness constraint.
+const constraintFailed = "Neo.ClientError.Schema.ConstraintValidationFailed"
+
+// ConflictOnConstraint turns a write breaking a uniqueness constraint, as when two requests add the same name at
+// once, into a 409 with msg. Other errors are returned unchanged.
+func ConflictOnConstraint(err error, msg string) error {
+	var neo4jErr *neo4j.Neo4jError
+	if errors.As(err, &neo4jErr) && neo4jErr.Code == constraintFailed {
+		return &customerrors.HTTPError{Status: http.StatusConflict, Msg: msg}
+	}
+	return err
+}
@@ -0,0 +1,26 @@
+package neo4jrepositories
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"service-atlas/internal/customerrors"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestConflictOnConstraint(t *testing.T) {
+	violation := fmt.Errorf("creating: %w", &neo4j.Neo4jError{Code: constraintFailed, Msg: "already exists"})
+	var httpErr *customerrors.HTTPError
+	if err := ConflictOnConstraint(violation, "name taken"); !errors.As(err, &httpErr) || httpErr.Status != http.StatusConflict {
+		t.Errorf("expected a 409, got %v", err)
+	}
+	other := &neo4j.Neo4jError{Code: "Neo.ClientError.Statement.SyntaxError"}
+	if err := ConflictOnConstraint(other, "name taken"); err != other {
+		t.Errorf("expected other errors unchanged, got %v", err)
+	}
+	if err := ConflictOnConstraint(nil, "name taken"); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
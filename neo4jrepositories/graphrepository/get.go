@@ -0,0 +1,187 @@
+package graphrepository
+
+import (
+	"context"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (r *Neo4jGraphRepository) GetServicesByIds(ctx context.Context, ids []string) (map[string]repositories.Service, error) {
+	services := make(map[string]repositories.Service, len(ids))
+	err := r.readRecords(ctx, `
+		MATCH (s:Service)
+		WHERE s.id IN $ids
+		RETURN s.id AS key, s
+	`, map[string]any{"ids": ids}, func(key string, record *neo4j.Record) {
+		if node, ok := getNode(record, "s"); ok {
+			services[key] = nRepo.MapNodeToService(node)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+func (r *Neo4jGraphRepository) GetDependenciesByServiceIds(ctx context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	return r.getDependencies(ctx, `
+		MATCH (parent:Service)-[r:DEPENDS_ON]->(s:Service)
+		WHERE parent.id IN $ids
+		RETURN parent.id AS key, s.id AS id, s.name AS name, s.type AS type, r.version AS version
+		ORDER BY name
+	`, ids)
+}
+
+func (r *Neo4jGraphRepository) GetDependentsByServiceIds(ctx context.Context, ids []string) (map[string][]*repositories.Dependency, error) {
+	return r.getDependencies(ctx, `
+		MATCH (s:Service)-[r:DEPENDS_ON]->(parent:Service)
+		WHERE parent.id IN $ids
+		RETURN parent.id AS key, s.id AS id, s.name AS name, s.type AS type, r.version AS version
+		ORDER BY name
+	`, ids)
+}
+
+func (r *Neo4jGraphRepository) getDependencies(ctx context.Context, cypher string, ids []string) (map[string][]*repositories.Dependency, error) {
+	dependencies := make(map[string][]*repositories.Dependency, len(ids))
+	err := r.readRecords(ctx, cypher, map[string]any{"ids": ids}, func(key string, record *neo4j.Record) {
+		dependency := &repositories.Dependency{
+			Id:          getString(record, "id"),
+			Name:        getString(record, "name"),
+			ServiceType: getString(record, "type"),
+			Version:     getString(record, "version"),
+		}
+		dependencies[key] = append(dependencies[key], dependency)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dependencies, nil
+}
+
+func (r *Neo4jGraphRepository) GetTeamsByServiceIds(ctx context.Context, ids []string) (map[string][]repositories.Team, error) {
+	teams := make(map[string][]repositories.Team, len(ids))
+	err := r.readRecords(ctx, `
+		MATCH (t:Team)-[:OWNS]->(s:Service)
+		WHERE s.id IN $ids
+		RETURN s.id AS key, t
+		ORDER BY t.name
+	`, map[string]any{"ids": ids}, func(key string, record *neo4j.Record) {
+		if node, ok := getNode(record, "t"); ok {
+			if team, ok := nRepo.MapNodeToTeam(node); ok {
+				teams[key] = append(teams[key], team)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (r *Neo4jGraphRepository) GetReleasesByServiceIds(ctx context.Context, ids []string, limit int) (map[string][]*repositories.Release, error) {
+	releases := make(map[string][]*repositories.Release, len(ids))
+	err := r.readRecords(ctx, `
+		MATCH (s:Service)-[:RELEASED]->(r:Release)
+		WHERE s.id IN $ids
+		WITH s, r
+		ORDER BY r.releaseDate DESC
+		WITH s, collect(r)[0..$limit] AS latest
+		UNWIND latest AS r
+		RETURN s.id AS key, r.releaseDate AS releaseDate, r.url AS url, r.version AS version
+		ORDER BY key, releaseDate DESC
+	`, map[string]any{"ids": ids, "limit": limit}, func(key string, record *neo4j.Record) {
+		release := &repositories.Release{
+			ServiceId: key,
+			Url:       getString(record, "url"),
+			Version:   getString(record, "version"),
+		}
+		if releaseDate, ok := record.Get("releaseDate"); ok {
+			release.ReleaseDate, _ = releaseDate.(time.Time)
+		}
+		releases[key] = append(releases[key], release)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+func (r *Neo4jGraphRepository) GetDebtByServiceIds(ctx context.Context, ids []string) (map[string][]repositories.Debt, error) {
+	debt := make(map[string][]repositories.Debt, len(ids))
+	err := r.readRecords(ctx, `
+		MATCH (s:Service)-[:OWNS]->(d:Debt)
+		WHERE s.id IN $ids
+		RETURN s.id AS key, d.id AS id, d.title AS title, d.description AS description, d.type AS type, d.status AS status
+		ORDER BY d.created DESC
+	`, map[string]any{"ids": ids}, func(key string, record *neo4j.Record) {
+		debt[key] = append(debt[key], repositories.Debt{
+			ServiceId:   key,
+			Id:          getString(record, "id"),
+			Title:       getString(record, "title"),
+			Description: getString(record, "description"),
+			Type:        getString(record, "type"),
+			Status:      getString(record, "status"),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return debt, nil
+}
+
+func (r *Neo4jGraphRepository) GetServicesByTeamIds(ctx context.Context, ids []string) (map[string][]repositories.Service, error) {
+	services := make(map[string][]repositories.Service, len(ids))
+	err := r.readRecords(ctx, `
+		MATCH (t:Team)-[:OWNS]->(s:Service)
+		WHERE t.id IN $ids
+		RETURN t.id AS key, s
+		ORDER BY s.name
+	`, map[string]any{"ids": ids}, func(key string, record *neo4j.Record) {
+		if node, ok := getNode(record, "s"); ok {
+			services[key] = append(services[key], nRepo.MapNodeToService(node))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// readRecords runs a read query whose records carry the parent id in a "key" column and hands each record to collect.
+// Records are only collected once the transaction has succeeded, so a retried transaction cannot add duplicates.
+func (r *Neo4jGraphRepository) readRecords(ctx context.Context, cypher string, params map[string]any, collect func(key string, record *neo4j.Record)) error {
+	result, err := r.manager.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, cypher, params)
+		if err != nil {
+			return nil, err
+		}
+		return result.Collect(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	for _, record := range result.([]*neo4j.Record) {
+		collect(getString(record, "key"), record)
+	}
+	return nil
+}
+
+func getString(record *neo4j.Record, key string) string {
+	if value, ok := record.Get(key); ok && value != nil {
+		if str, ok := value.(string); ok {
+			return str
+		}
+	}
+	return ""
+}
+
+func getNode(record *neo4j.Record, key string) (neo4j.Node, bool) {
+	if value, ok := record.Get(key); ok && value != nil {
+		node, ok := value.(neo4j.Node)
+		return node, ok
+	}
+	return neo4j.Node{}, false
+}
@@ -0,0 +1,133 @@
+package graphrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/debtrepository"
+	"service-atlas/neo4jrepositories/dependencyrepository"
+	"service-atlas/neo4jrepositories/releaserepository"
+	"service-atlas/neo4jrepositories/servicerepository"
+	"service-atlas/neo4jrepositories/teamrepository"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jGraphRepository_BatchLookups(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	services := servicerepository.New(driver)
+	cartId, err := services.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "api", Url: "https://cart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbId, err := services.CreateService(ctx, repositories.Service{Name: "cart-db", ServiceType: "database", Url: "https://cart-db"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = dependencyrepository.New(driver).AddDependency(ctx, cartId, repositories.Dependency{Id: dbId, Version: "16"}); err != nil {
+		t.Fatal(err)
+	}
+	teams := teamrepository.New(driver)
+	teamId, err := teams.CreateTeam(ctx, repositories.Team{Name: "Shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = teams.CreateTeamAssociation(ctx, teamId, cartId); err != nil {
+		t.Fatal(err)
+	}
+	releases := releaserepository.New(driver)
+	for i, version := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+		if err = releases.CreateRelease(ctx, repositories.Release{
+			ServiceId:   cartId,
+			Version:     version,
+			ReleaseDate: time.Date(2025, 1, i+1, 0, 0, 0, 0, time.UTC),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = debtrepository.New(driver).CreateDebtItem(ctx, repositories.Debt{ServiceId: cartId, Title: "no tests", Type: "testing"}); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := New(driver)
+	ids := []string{cartId, dbId}
+
+	byId, err := repo.GetServicesByIds(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byId) != 2 || byId[cartId].Name != "cart" || byId[dbId].Name != "cart-db" {
+		t.Errorf("unexpected services %+v", byId)
+	}
+
+	dependencies, err := repo.GetDependenciesByServiceIds(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dependencies[cartId]) != 1 || dependencies[cartId][0].Id != dbId || dependencies[cartId][0].Version != "16" {
+		t.Errorf("unexpected dependencies %+v", dependencies)
+	}
+	if len(dependencies[dbId]) != 0 {
+		t.Errorf("expected no dependencies for %s, got %+v", dbId, dependencies[dbId])
+	}
+
+	dependents, err := repo.GetDependentsByServiceIds(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dependents[dbId]) != 1 || dependents[dbId][0].Id != cartId {
+		t.Errorf("unexpected dependents %+v", dependents)
+	}
+
+	owners, err := repo.GetTeamsByServiceIds(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(owners[cartId]) != 1 || owners[cartId][0].Id != teamId {
+		t.Errorf("unexpected owners %+v", owners)
+	}
+
+	latest, err := repo.GetReleasesByServiceIds(ctx, ids, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(latest[cartId]) != 2 || latest[cartId][0].Version != "1.2.0" || latest[cartId][1].Version != "1.1.0" {
+		t.Errorf("unexpected releases %+v", latest[cartId])
+	}
+
+	debt, err := repo.GetDebtByServiceIds(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(debt[cartId]) != 1 || debt[cartId][0].Title != "no tests" {
+		t.Errorf("unexpected debt %+v", debt)
+	}
+
+	owned, err := repo.GetServicesByTeamIds(ctx, []string{teamId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(owned[teamId]) != 1 || owned[teamId][0].Id != cartId {
+		t.Errorf("unexpected team services %+v", owned)
+	}
+}
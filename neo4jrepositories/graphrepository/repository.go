@@ -0,0 +1,16 @@
+package graphrepository
+
+import (
+	"service-atlas/databaseadapter"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Neo4jGraphRepository implements batched lookups used to resolve nested graph queries.
+type Neo4jGraphRepository struct {
+	manager databaseadapter.DriverManager
+}
+
+func New(driver neo4j.DriverWithContext) *Neo4jGraphRepository {
+	return &Neo4jGraphRepository{manager: databaseadapter.NewDriverManager(driver)}
+}
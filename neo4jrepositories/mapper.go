@@ -57,6 +57,19 @@ func MapNodeToService(n neo4j.Node) repositories.Service {
 			svc.Updated = dateStr
 		}
 	}
+
+	if lifecycle, ok := getPropFromNode[string](n, "lifecycle"); ok {
+		svc.Lifecycle = lifecycle
+	}
+
+	// lists come back from the driver as []any
+	if tags, ok := getPropFromNode[[]any](n, "tags"); ok && len(tags) > 0 {
+		for _, tag := range tags {
+			if tagStr, ok := tag.(string); ok {
+				svc.Tags = append(svc.Tags, tagStr)
+			}
+		}
+	}
 	return svc
 }
 
@@ -93,7 +106,7 @@ func MapNodeToTeam(n neo4j.Node) (repositories.Team, bool) {
 	return team, true
 }
 
-func getPropFromNode[T string | time.Time](n neo4j.Node, key string) (T, bool) {
+func getPropFromNode[T string | time.Time | []any](n neo4j.Node, key string) (T, bool) {
 	if value, ok := n.Props[key]; ok {
 		if v, ok := value.(T); ok {
 			return v, true
@@ -1,6 +1,7 @@
 package neo4jrepositories
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -124,6 +125,8 @@ func Test_mapNodeToService(t *testing.T) {
 		wantUrl         string
 		wantCreated     time.Time
 		wantUpdated     time.Time
+		wantLifecycle   string
+		wantTags        []string
 	}{
 		{
 			name: "all properties present with correct types",
@@ -135,6 +138,8 @@ func Test_mapNodeToService(t *testing.T) {
 				"url":         "https://example.com",
 				"created":     now,
 				"updated":     later,
+				"lifecycle":   "active",
+				"tags":        []any{"kafka", "pci"},
 			}},
 			wantName:        "svc-a",
 			wantDescription: "a test service",
@@ -143,6 +148,8 @@ func Test_mapNodeToService(t *testing.T) {
 			wantUrl:         "https://example.com",
 			wantCreated:     now,
 			wantUpdated:     later,
+			wantLifecycle:   "active",
+			wantTags:        []string{"kafka", "pci"},
 		},
 		{
 			name: "missing optional properties are zero-valued",
@@ -217,6 +224,12 @@ func Test_mapNodeToService(t *testing.T) {
 			if got.Url != tt.wantUrl {
 				t.Errorf("Url: expected %q, got %q", tt.wantUrl, got.Url)
 			}
+			if got.Lifecycle != tt.wantLifecycle {
+				t.Errorf("Lifecycle: expected %q, got %q", tt.wantLifecycle, got.Lifecycle)
+			}
+			if !slices.Equal(got.Tags, tt.wantTags) {
+				t.Errorf("Tags: expected %v, got %v", tt.wantTags, got.Tags)
+			}
 			// Created
 			if tt.wantCreated.IsZero() {
 				if !got.Created.IsZero() {
@@ -0,0 +1,368 @@
+package neo4jrepositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"service-atlas/databaseadapter"
+
+	"github.com/google/uuid"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// migrationLockName names the lock node held while migrating.
+	migrationLockName = "schema"
+	// migrationLockExpiry is how long a lock is held without being renewed before another migrator takes it over,
+	// so a replica crashing while migrating does not block the others for good.
+	migrationLockExpiry = "PT5M"
+	// migrationLockRetry is how often a migrator waiting for the lock tries again.
+	migrationLockRetry = time.Second
+	// maxBlockersLogged is how many of the problems deferring a migration are logged.
+	maxBlockersLogged = 20
+	// deferredRecheck is how long Verify trusts the migrations found blocked by existing data before looking for
+	// the blockers again, as the queries scan every node they check and readiness is probed every few seconds.
+	deferredRecheck = 5 * time.Minute
+)
+
+// Migrator applies migrations, recording each applied one as a Migration node. Replicas migrating at the same time
+// take turns holding a lock, so each migration is applied once.
+type Migrator struct {
+	manager    databaseadapter.DriverManager
+	migrations []Migration
+	owner      string
+	now        func() time.Time
+
+	mu       sync.Mutex
+	deferred map[int]bool
+	checked  time.Time
+}
+
+// NewMigrator returns a migrator applying Migrations.
+func NewMigrator(driver neo4j.DriverWithContext) *Migrator {
+	return &Migrator{
+		manager:    databaseadapter.NewDriverManager(driver),
+		migrations: Migrations,
+		owner:      uuid.NewString(),
+		now:        time.Now,
+	}
+}
+
+// Pending returns the migrations not yet applied, in order, without changing anything.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	if err := checkVersions(m.migrations); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]Migration, 0)
+	for _, migration := range m.migrations {
+		if !slices.Contains(applied, migration.Version) {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies the pending migrations in order, waiting for the lock while another migrator holds it, and returns
+// those it applied. Migrations blocked by existing data are logged and left pending.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := checkVersions(m.migrations); err != nil {
+		return nil, err
+	}
+	// the constraint makes creating the lock node atomic
+	_, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, runErr := tx.Run(ctx, `
+            CREATE CONSTRAINT migration_lock IF NOT EXISTS
+            FOR (l:MigrationLock) REQUIRE l.name IS UNIQUE
+        `, nil)
+		return nil, runErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = m.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer m.unlock()
+
+	// read after locking, as another migrator may have applied some while this one waited
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied := make([]Migration, 0, len(pending))
+	deferred := make(map[int]bool)
+	for _, migration := range pending {
+		blockers, err := m.blockers(ctx, migration)
+		if err != nil {
+			return applied, fmt.Errorf("migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+		if len(blockers) > 0 {
+			deferred[migration.Version] = true
+			slog.Warn("Deferring a migration until the data blocking it is fixed", slog.Int("version", migration.Version),
+				slog.String("description", migration.Description), slog.Int("problems", len(blockers)),
+				slog.Any("blockers", blockers[:min(len(blockers), maxBlockersLogged)]))
+			continue
+		}
+		if err = m.apply(ctx, migration); err != nil {
+			return applied, fmt.Errorf("migration %d (%s): %w", migration.Version, migration.Description, err)
+		}
+		slog.Info("Applied migration", slog.Int("version", migration.Version),
+			slog.String("description", migration.Description))
+		applied = append(applied, migration)
+	}
+	m.remember(deferred)
+	return applied, nil
+}
+
+// Verify checks that every migration is applied and the indexes they create are online. Migrations deferred while
+// existing data blocks them are skipped, so the service stays ready without them.
+func (m *Migrator) Verify(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	deferred, err := m.deferredOf(ctx, pending)
+	if err != nil {
+		return err
+	}
+	var problems []string
+	for _, migration := range pending {
+		if !deferred[migration.Version] {
+			problems = append(problems, fmt.Sprintf("migration %d is pending", migration.Version))
+		}
+	}
+	var indexes []string
+	for _, migration := range m.migrations {
+		if !deferred[migration.Version] {
+			indexes = append(indexes, migration.Indexes...)
+		}
+	}
+	states, err := m.manager.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, runErr := tx.Run(ctx, `
+            SHOW INDEXES YIELD name, state
+            WHERE name IN $names
+            RETURN name, state
+        `, map[string]any{"names": indexes})
+		if runErr != nil {
+			return nil, runErr
+		}
+		states := make(map[string]string)
+		for result.Next(ctx) {
+			name, _, _ := neo4j.GetRecordValue[string](result.Record(), "name")
+			state, _, _ := neo4j.GetRecordValue[string](result.Record(), "state")
+			states[name] = state
+		}
+		return states, result.Err()
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range indexes {
+		switch state, ok := states.(map[string]string)[name]; {
+		case !ok:
+			problems = append(problems, "index "+name+" is missing")
+		case state != "ONLINE":
+			problems = append(problems, "index "+name+" is "+strings.ToLower(state))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context) ([]int, error) {
+	versions, err := m.manager.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, runErr := tx.Run(ctx, `MATCH (m:Migration) RETURN m.version AS version`, nil)
+		if runErr != nil {
+			return nil, runErr
+		}
+		versions := make([]int, 0)
+		for result.Next(ctx) {
+			version, _, _ := neo4j.GetRecordValue[int64](result.Record(), "version")
+			versions = append(versions, int(version))
+		}
+		return versions, result.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions.([]int), nil
+}
+
+// deferredOf returns the versions of the pending migrations blocked by existing data, as last found by Up or by
+// looking for their blockers again once deferredRecheck has passed.
+func (m *Migrator) deferredOf(ctx context.Context, pending []Migration) (map[int]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deferred != nil && m.now().Sub(m.checked) < deferredRecheck {
+		return m.deferred, nil
+	}
+	deferred := make(map[int]bool)
+	for _, migration := range pending {
+		blockers, err := m.blockers(ctx, migration)
+		if err != nil {
+			return nil, err
+		}
+		if len(blockers) > 0 {
+			deferred[migration.Version] = true
+		}
+	}
+	m.deferred, m.checked = deferred, m.now()
+	return deferred, nil
+}
+
+// remember records the versions of the migrations Up deferred, so Verify need not look for their blockers again.
+func (m *Migrator) remember(deferred map[int]bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deferred, m.checked = deferred, m.now()
+}
+
+// blockers returns the problems with existing data the migration cannot be applied over.
+func (m *Migrator) blockers(ctx context.Context, migration Migration) ([]string, error) {
+	if migration.Blockers == "" {
+		return nil, nil
+	}
+	problems, err := m.manager.ExecuteRead(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, runErr := tx.Run(ctx, migration.Blockers, nil)
+		if runErr != nil {
+			return nil, runErr
+		}
+		problems := make([]string, 0)
+		for result.Next(ctx) {
+			problem, _, _ := neo4j.GetRecordValue[string](result.Record(), "problem")
+			problems = append(problems, problem)
+		}
+		return problems, result.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return problems.([]string), nil
+}
+
+// apply runs the statements of the migration, renewing the lock after each, then records it.
+func (m *Migrator) apply(ctx context.Context, migration Migration) error {
+	for _, statement := range migration.Statements {
+		_, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			_, runErr := tx.Run(ctx, statement, nil)
+			return nil, runErr
+		})
+		if err != nil {
+			return err
+		}
+		if err = m.renew(ctx); err != nil {
+			return err
+		}
+	}
+	_, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, runErr := tx.Run(ctx, `
+            MERGE (m:Migration {version: $version})
+            ON CREATE SET m.description = $description, m.applied = datetime()
+        `, map[string]any{"version": migration.Version, "description": migration.Description})
+		return nil, runErr
+	})
+	return err
+}
+
+// lock waits until this migrator holds the lock, taking over one that has expired.
+func (m *Migrator) lock(ctx context.Context) error {
+	for {
+		holder, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			result, runErr := tx.Run(ctx, `
+                MERGE (l:MigrationLock {name: $name})
+                ON CREATE SET l.owner = $owner, l.acquired = datetime()
+                WITH l, l.owner <> $owner AND l.acquired < datetime() - duration($expiry) AS expired
+                FOREACH (_ IN CASE WHEN expired THEN [1] ELSE [] END |
+                    SET l.owner = $owner, l.acquired = datetime())
+                RETURN l.owner AS owner
+            `, map[string]any{"name": migrationLockName, "owner": m.owner, "expiry": migrationLockExpiry})
+			if runErr != nil {
+				return nil, runErr
+			}
+			record, runErr := result.Single(ctx)
+			if runErr != nil {
+				return nil, runErr
+			}
+			owner, _, runErr := neo4j.GetRecordValue[string](record, "owner")
+			return owner, runErr
+		})
+		if err != nil {
+			return err
+		}
+		if holder == m.owner {
+			return nil
+		}
+		slog.Info("Waiting for another migrator to finish", slog.Any("holder", holder))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(migrationLockRetry):
+		}
+	}
+}
+
+// renew extends the lock, failing when another migrator took it over.
+func (m *Migrator) renew(ctx context.Context) error {
+	held, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, runErr := tx.Run(ctx, `
+            MATCH (l:MigrationLock {name: $name, owner: $owner})
+            SET l.acquired = datetime()
+            RETURN count(l) AS held
+        `, map[string]any{"name": migrationLockName, "owner": m.owner})
+		if runErr != nil {
+			return nil, runErr
+		}
+		record, runErr := result.Single(ctx)
+		if runErr != nil {
+			return nil, runErr
+		}
+		held, _, runErr := neo4j.GetRecordValue[int64](record, "held")
+		return held, runErr
+	})
+	if err != nil {
+		return err
+	}
+	if held.(int64) == 0 {
+		return errors.New("the migration lock expired and was taken over")
+	}
+	return nil
+}
+
+// unlock releases the lock, even when ctx is done.
+func (m *Migrator) unlock() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := m.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, runErr := tx.Run(ctx, `
+            MATCH (l:MigrationLock {name: $name, owner: $owner})
+            DELETE l
+        `, map[string]any{"name": migrationLockName, "owner": m.owner})
+		return nil, runErr
+	})
+	if err != nil {
+		slog.Error("Error releasing the migration lock", slog.Any("error", err))
+	}
+}
+
+// checkVersions guards against migrations added out of order or with a reused version.
+func checkVersions(migrations []Migration) error {
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].Version <= migrations[i-1].Version {
+			return fmt.Errorf("migration %d follows %d, versions must increase",
+				migrations[i].Version, migrations[i-1].Version)
+		}
+	}
+	return nil
+}
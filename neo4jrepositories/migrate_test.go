@@ -0,0 +1,237 @@
+package neo4jrepositories
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestMigrations(t *testing.T) {
+	if err := checkVersions(Migrations); err != nil {
+		t.Fatal(err)
+	}
+	for _, migration := range Migrations {
+		for _, statement := range migration.Statements {
+			if !strings.Contains(statement, "IF NOT EXISTS") {
+				t.Errorf("migration %d: statement is not idempotent: %s", migration.Version, statement)
+			}
+		}
+		for _, index := range migration.Indexes {
+			if !strings.Contains(strings.Join(migration.Statements, "\n"), " "+index+" ") {
+				t.Errorf("migration %d does not create index %s", migration.Version, index)
+			}
+		}
+	}
+}
+
+func TestCheckVersions(t *testing.T) {
+	if err := checkVersions([]Migration{{Version: 1}, {Version: 3}, {Version: 3}}); err == nil {
+		t.Error("expected a reused version to be rejected")
+	}
+	if err := checkVersions([]Migration{{Version: 2}, {Version: 1}}); err == nil {
+		t.Error("expected versions out of order to be rejected")
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(context.Background()) })
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	if err := NewMigrator(driver).Verify(ctx); err == nil {
+		t.Fatal("expected pending migrations before migrating")
+	}
+	if pending, err := NewMigrator(driver).Pending(ctx); err != nil || len(pending) != len(Migrations) {
+		t.Fatalf("expected every migration to be pending, got %d, %v", len(pending), err)
+	}
+
+	// replicas starting together apply each migration once between them
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	applied := 0
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			migrations, err := NewMigrator(driver).Up(ctx)
+			if err != nil {
+				t.Errorf("Up: %v", err)
+			}
+			mu.Lock()
+			applied += len(migrations)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if applied != len(Migrations) {
+		t.Errorf("expected %d migrations applied, got %d", len(Migrations), applied)
+	}
+	if pending, err := NewMigrator(driver).Pending(ctx); err != nil || len(pending) != 0 {
+		t.Errorf("expected no pending migrations, got %d, %v", len(pending), err)
+	}
+
+	// indexes are populated in the background, so wait for them to come online
+	deadline := time.Now().Add(30 * time.Second)
+	for err = NewMigrator(driver).Verify(ctx); err != nil && time.Now().Before(deadline); err = NewMigrator(driver).Verify(ctx) {
+		time.Sleep(500 * time.Millisecond)
+	}
+	if err != nil {
+		t.Errorf("expected indexes to be online after migrating: %v", err)
+	}
+}
+
+func TestMigrator_DefersMigrationsBlockedByData(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(context.Background()) })
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	_, err = neo4j.ExecuteQuery(ctx, driver, `
+        CREATE (:Service {id: 's1', name: 'cart', url: 'https://github.com/shop/cart'}),
+               (:Service {id: 's2', name: 'cart', url: 'https://github.com/shop/basket'})
+    `, nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrator := NewMigrator(driver)
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("expected the blocked migration to be deferred, got %v", err)
+	}
+	if len(applied) != len(Migrations)-1 {
+		t.Errorf("expected every other migration to be applied, got %d", len(applied))
+	}
+	if pending, err := migrator.Pending(ctx); err != nil || len(pending) != 1 || pending[0].Version != 5 {
+		t.Fatalf("expected migration 5 to stay pending, got %v, %v", pending, err)
+	}
+
+	deadline := time.Now().Add(30 * time.Second)
+	for err = migrator.Verify(ctx); err != nil && time.Now().Before(deadline); err = migrator.Verify(ctx) {
+		time.Sleep(500 * time.Millisecond)
+	}
+	if err != nil {
+		t.Errorf("expected the service to be ready with the migration deferred: %v", err)
+	}
+
+	_, err = neo4j.ExecuteQuery(ctx, driver, `MATCH (s:Service {id: 's2'}) SET s.name = 'basket'`, nil, neo4j.EagerResultTransformer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied, err = migrator.Up(ctx); err != nil || len(applied) != 1 {
+		t.Errorf("expected the migration to be applied once the duplicate is fixed, got %d, %v", len(applied), err)
+	}
+}
+
+func TestMigrator_VerifyReusesDeferredMigrations(t *testing.T) {
+	manager := &queryCounter{responses: map[string][]*neo4j.Record{
+		"MATCH (m:Migration)": {{Keys: []string{"version"}, Values: []any{int64(1)}}},
+		"duplicates":         {{Keys: []string{"problem"}, Values: []any{"duplicate name cart"}}},
+		"SHOW INDEXES":       {{Keys: []string{"name", "state"}, Values: []any{"first", "ONLINE"}}},
+	}}
+	now := time.Now()
+	migrator := &Migrator{
+		manager: manager,
+		migrations: []Migration{
+			{Version: 1, Indexes: []string{"first"}},
+			{Version: 2, Indexes: []string{"second"}, Blockers: "RETURN 'duplicates' AS problem"},
+		},
+		now: func() time.Time { return now },
+	}
+
+	for range 3 {
+		if err := migrator.Verify(context.Background()); err != nil {
+			t.Fatalf("expected the blocked migration to be deferred, got %v", err)
+		}
+	}
+	if manager.runs["duplicates"] != 1 {
+		t.Errorf("expected the blockers to be looked for once, got %d", manager.runs["duplicates"])
+	}
+	now = now.Add(deferredRecheck)
+	if err := migrator.Verify(context.Background()); err != nil || manager.runs["duplicates"] != 2 {
+		t.Errorf("expected the blockers to be looked for again after %s, got %d runs, %v", deferredRecheck,
+			manager.runs["duplicates"], err)
+	}
+}
+
+// queryCounter answers each query with the records of the first response whose key the query contains, counting
+// the queries answered by each key.
+type queryCounter struct {
+	responses map[string][]*neo4j.Record
+	runs      map[string]int
+}
+
+func (q *queryCounter) ExecuteRead(_ context.Context, work func(tx neo4j.ManagedTransaction) (any, error)) (any, error) {
+	return work(fakeTransaction{counter: q})
+}
+
+func (q *queryCounter) ExecuteWrite(ctx context.Context, work func(tx neo4j.ManagedTransaction) (any, error)) (any, error) {
+	return q.ExecuteRead(ctx, work)
+}
+
+// fakeTransaction embeds the interface for its unexported method, which the migrator never calls.
+type fakeTransaction struct {
+	neo4j.ManagedTransaction
+	counter *queryCounter
+}
+
+func (f fakeTransaction) Run(_ context.Context, cypher string, _ map[string]any) (neo4j.ResultWithContext, error) {
+	if f.counter.runs == nil {
+		f.counter.runs = make(map[string]int)
+	}
+	for key, records := range f.counter.responses {
+		if strings.Contains(cypher, key) {
+			f.counter.runs[key]++
+			return &fakeResult{records: records}, nil
+		}
+	}
+	return &fakeResult{}, nil
+}
+
+type fakeResult struct {
+	neo4j.ResultWithContext
+	records []*neo4j.Record
+	next    int
+}
+
+func (r *fakeResult) Next(context.Context) bool {
+	r.next++
+	return r.next <= len(r.records)
+}
+
+func (r *fakeResult) Record() *neo4j.Record {
+	return r.records[r.next-1]
+}
+
+func (r *fakeResult) Err() error {
+	return nil
+}
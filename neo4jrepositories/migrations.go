@@ -0,0 +1,128 @@
+package neo4jrepositories
+
+// ServiceFulltextIndexName is the name of the fulltext index used for service fuzzy search.
+const ServiceFulltextIndexName = "service_fulltext_index"
+
+// TeamFulltextIndexName, DebtFulltextIndexName and ReleaseFulltextIndexName are the names of the fulltext indexes
+// searched alongside the service index by the catalog search.
+const (
+	TeamFulltextIndexName    = "team_fulltext_index"
+	DebtFulltextIndexName    = "debt_fulltext_index"
+	ReleaseFulltextIndexName = "release_fulltext_index"
+)
+
+// NameFulltextIndexName is the name of the fulltext index on service and team names alone, which autocomplete
+// queries for names starting with what has been typed.
+const NameFulltextIndexName = "name_fulltext_index"
+
+// ApiKeyHashConstraintName is the name of the constraint keeping API key hashes unique, and of its index.
+const ApiKeyHashConstraintName = "api_key_hash"
+
+// Migration is a versioned change to the database schema.
+type Migration struct {
+	Version     int
+	Description string
+	// Statements run in order, each in its own transaction as Neo4j does not mix schema changes with other writes.
+	// They must be idempotent, so a migration interrupted part way through can be run again.
+	Statements []string
+	// Indexes are the indexes and constraints the migration creates, which must be online for the service to be ready.
+	Indexes []string
+	// Blockers is an optional query returning a problem for each piece of existing data the statements would fail on.
+	// While it returns any the migration is deferred: the problems are logged, later migrations are still applied
+	// and the service is ready without the migration's indexes.
+	Blockers string
+}
+
+// Migrations are every migration, in the order they are applied. Append new ones with the next version; applied
+// migrations must not change.
+var Migrations = []Migration{
+	{
+		Version:     1,
+		Description: "Full-text index for searching services",
+		Statements: []string{`
+            CREATE FULLTEXT INDEX ` + ServiceFulltextIndexName + ` IF NOT EXISTS
+            FOR (s:Service) ON EACH [s.name, s.description, s.type, s.url]`},
+		Indexes: []string{ServiceFulltextIndexName},
+	},
+	{
+		// API keys are looked up by hash on every authenticated request.
+		Version:     2,
+		Description: "Unique API key hashes",
+		Statements: []string{`
+            CREATE CONSTRAINT ` + ApiKeyHashConstraintName + ` IF NOT EXISTS
+            FOR (k:ApiKey) REQUIRE k.hash IS UNIQUE`},
+		Indexes: []string{ApiKeyHashConstraintName},
+	},
+	{
+		Version:     3,
+		Description: "Unique service, team and debt ids",
+		Statements: []string{
+			`CREATE CONSTRAINT service_id IF NOT EXISTS FOR (s:Service) REQUIRE s.id IS UNIQUE`,
+			`CREATE CONSTRAINT team_id IF NOT EXISTS FOR (t:Team) REQUIRE t.id IS UNIQUE`,
+			`CREATE CONSTRAINT debt_id IF NOT EXISTS FOR (d:Debt) REQUIRE d.id IS UNIQUE`,
+		},
+		Indexes: []string{"service_id", "team_id", "debt_id"},
+	},
+	{
+		// releases are listed by date across services
+		Version:     4,
+		Description: "Index on release dates",
+		Statements: []string{
+			`CREATE INDEX release_date IF NOT EXISTS FOR (r:Release) ON (r.releaseDate)`,
+		},
+		Indexes: []string{"release_date"},
+	},
+	{
+		// duplicates must be removed first, as listed by the integrity report
+		Version:     5,
+		Description: "Unique service names and urls and team names",
+		Statements: []string{
+			`CREATE CONSTRAINT service_name IF NOT EXISTS FOR (s:Service) REQUIRE s.name IS UNIQUE`,
+			`CREATE CONSTRAINT service_url IF NOT EXISTS FOR (s:Service) REQUIRE s.url IS UNIQUE`,
+			`CREATE CONSTRAINT team_name IF NOT EXISTS FOR (t:Team) REQUIRE t.name IS UNIQUE`,
+		},
+		Indexes: []string{"service_name", "service_url", "team_name"},
+		Blockers: `
+            MATCH (s:Service) WHERE s.name IS NOT NULL
+            WITH s.name AS name, count(s) AS copies WHERE copies > 1
+            RETURN copies + ' services are named ' + name AS problem
+            UNION ALL
+            MATCH (s:Service) WHERE s.url IS NOT NULL
+            WITH s.url AS url, count(s) AS copies WHERE copies > 1
+            RETURN copies + ' services have the url ' + url AS problem
+            UNION ALL
+            MATCH (t:Team) WHERE t.name IS NOT NULL
+            WITH t.name AS name, count(t) AS copies WHERE copies > 1
+            RETURN copies + ' teams are named ' + name AS problem`,
+	},
+	{
+		Version:     6,
+		Description: "Full-text indexes for searching teams, debt and releases",
+		Statements: []string{
+			`CREATE FULLTEXT INDEX ` + TeamFulltextIndexName + ` IF NOT EXISTS FOR (t:Team) ON EACH [t.name]`,
+			`CREATE FULLTEXT INDEX ` + DebtFulltextIndexName + ` IF NOT EXISTS FOR (d:Debt) ON EACH [d.title, d.description]`,
+			`CREATE FULLTEXT INDEX ` + ReleaseFulltextIndexName + ` IF NOT EXISTS FOR (r:Release) ON EACH [r.version]`,
+		},
+		Indexes: []string{TeamFulltextIndexName, DebtFulltextIndexName, ReleaseFulltextIndexName},
+	},
+	{
+		Version:     7,
+		Description: "Full-text index on service and team names for autocomplete",
+		Statements: []string{
+			`CREATE FULLTEXT INDEX ` + NameFulltextIndexName + ` IF NOT EXISTS FOR (n:Service|Team) ON EACH [n.name]`,
+		},
+		Indexes: []string{NameFulltextIndexName},
+	},
+	{
+		// the relay claims pending events in creation order, marks them published by id and sweeps old ones by
+		// publication date
+		Version:     8,
+		Description: "Indexes on outbox events",
+		Statements: []string{
+			`CREATE INDEX outbox_event_id IF NOT EXISTS FOR (e:OutboxEvent) ON (e.id)`,
+			`CREATE INDEX outbox_event_created IF NOT EXISTS FOR (e:OutboxEvent) ON (e.created)`,
+			`CREATE INDEX outbox_event_published IF NOT EXISTS FOR (e:OutboxEvent) ON (e.published)`,
+		},
+		Indexes: []string{"outbox_event_id", "outbox_event_created", "outbox_event_published"},
+	},
+}
@@ -0,0 +1,53 @@
+package outbox
+
+import (
+	"context"
+	"service-atlas/internal"
+	"service-atlas/internal/auth"
+	"service-atlas/internal/events"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Write records an event as an OutboxEvent node using the caller's transaction.
+// It must be called from inside the ExecuteWrite work function that performs the change,
+// so the event is committed (or rolled back) together with it.
+func Write(ctx context.Context, tx neo4j.ManagedTransaction, event events.Event) error {
+	requestId := event.RequestId
+	if requestId == "" {
+		requestId = internal.GetRequestIdFromContext(ctx)
+	}
+	actor := event.Actor
+	if actor == "" {
+		actor = auth.Actor(ctx)
+	}
+	_, err := tx.Run(ctx, `
+		CREATE (e:OutboxEvent {
+			id: randomuuid(),
+			created: datetime(),
+			type: $type,
+			aggregateType: $aggregateType,
+			aggregateId: $aggregateId,
+			requestId: $requestId,
+			actor: $actor,
+			payload: $payload
+		})
+	`, map[string]any{
+		"type":          event.Type,
+		"aggregateType": event.AggregateType,
+		"aggregateId":   event.AggregateId,
+		"requestId":     requestId,
+		"actor":         actor,
+		"payload":       string(event.Payload),
+	})
+	return err
+}
+
+// Record builds an event from its parts and writes it to the outbox in the given transaction.
+func Record(ctx context.Context, tx neo4j.ManagedTransaction, eventType, aggregateType, aggregateId string, payload any) error {
+	event, err := events.New(eventType, aggregateType, aggregateId, payload)
+	if err != nil {
+		return err
+	}
+	return Write(ctx, tx, event)
+}
@@ -0,0 +1,213 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"service-atlas/databaseadapter"
+	"service-atlas/internal/events"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+const (
+	// DefaultBatchSize is the maximum number of events claimed per drain.
+	DefaultBatchSize = 100
+	// claimDuration is how long a relay holds a claim on events before another relay may retry them.
+	claimDuration = "PT1M"
+	// DefaultRetention is how long published events are kept before the relay deletes them.
+	DefaultRetention = 7 * 24 * time.Hour
+	// sweepBatchSize is the most published events deleted in one transaction.
+	sweepBatchSize = 1000
+)
+
+// Relay drains pending OutboxEvent nodes and publishes them to a Sink.
+// Delivery is at-least-once: an event is only marked as published once the sink accepts it,
+// so a crash between publishing and marking results in a redelivery rather than a lost event.
+type Relay struct {
+	manager   databaseadapter.DriverManager
+	sink      events.Sink
+	interval  time.Duration
+	batchSize int
+	retention time.Duration
+	id        string
+}
+
+// NewRelay creates a relay that polls the outbox every interval, deleting events published more than retention ago.
+func NewRelay(driver neo4j.DriverWithContext, sink events.Sink, interval, retention time.Duration) *Relay {
+	return &Relay{
+		manager:   databaseadapter.NewDriverManager(driver),
+		sink:      sink,
+		interval:  interval,
+		batchSize: DefaultBatchSize,
+		retention: retention,
+		id:        uuid.NewString(),
+	}
+}
+
+// Run drains the outbox until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		for {
+			published, err := r.Drain(ctx)
+			if err != nil {
+				slog.Error("error draining outbox", slog.Any("error", err))
+				break
+			}
+			// keep draining while full batches come back
+			if published < r.batchSize {
+				break
+			}
+		}
+		if _, err := r.Sweep(ctx); err != nil {
+			slog.Error("error sweeping outbox", slog.Any("error", err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain claims a batch of pending events, publishes them in creation order and marks each as published.
+// It stops at the first event the sink rejects so that it is retried on the next drain.
+// It returns the number of events published.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	pending, err := r.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	published := 0
+	for _, event := range pending {
+		if err = r.sink.Publish(ctx, event); err != nil {
+			return published, err
+		}
+		if err = r.markPublished(ctx, event.Id); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// Sweep deletes the events published more than the retention ago, so the outbox only holds recent history and the
+// pending events are quick to find. It returns the number of events deleted.
+func (r *Relay) Sweep(ctx context.Context) (int64, error) {
+	var deleted int64
+	for {
+		result, err := r.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			result, err := tx.Run(ctx, `
+				MATCH (e:OutboxEvent)
+				WHERE e.published < datetime() - duration({seconds: $retention})
+				WITH e
+				LIMIT $limit
+				DELETE e
+				RETURN count(*) AS deleted
+			`, map[string]any{
+				"retention": int64(r.retention.Seconds()),
+				"limit":     sweepBatchSize,
+			})
+			if err != nil {
+				return nil, err
+			}
+			record, err := result.Single(ctx)
+			if err != nil {
+				return nil, err
+			}
+			count, _, err := neo4j.GetRecordValue[int64](record, "deleted")
+			return count, err
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted += result.(int64)
+		if result.(int64) < sweepBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+func (r *Relay) claim(ctx context.Context) ([]events.Event, error) {
+	result, err := r.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+			MATCH (e:OutboxEvent)
+			WHERE e.published IS NULL
+				AND (e.claimedUntil IS NULL OR e.claimedUntil < datetime())
+			WITH e
+			ORDER BY e.created ASC, e.id ASC
+			LIMIT $limit
+			SET e.claimedUntil = datetime() + duration($claim), e.claimedBy = $relayId
+			RETURN e.id AS id, e.type AS type, e.aggregateType AS aggregateType, e.aggregateId AS aggregateId,
+				e.requestId AS requestId, e.actor AS actor, e.created AS created, e.payload AS payload
+			ORDER BY created ASC, id ASC
+		`, map[string]any{
+			"limit":   r.batchSize,
+			"claim":   claimDuration,
+			"relayId": r.id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		records, err := result.Collect(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pending := make([]events.Event, 0, len(records))
+		for _, record := range records {
+			pending = append(pending, mapRecordToEvent(record))
+		}
+		return pending, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]events.Event), nil
+}
+
+func (r *Relay) markPublished(ctx context.Context, id string) error {
+	_, err := r.manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, `
+			MATCH (e:OutboxEvent {id: $id})
+			SET e.published = datetime()
+			REMOVE e.claimedUntil, e.claimedBy
+		`, map[string]any{"id": id})
+		return nil, err
+	})
+	return err
+}
+
+func mapRecordToEvent(record *neo4j.Record) events.Event {
+	event := events.Event{}
+	if id, ok := record.Get("id"); ok && id != nil {
+		event.Id, _ = id.(string)
+	}
+	if eventType, ok := record.Get("type"); ok && eventType != nil {
+		event.Type, _ = eventType.(string)
+	}
+	if aggregateType, ok := record.Get("aggregateType"); ok && aggregateType != nil {
+		event.AggregateType, _ = aggregateType.(string)
+	}
+	if aggregateId, ok := record.Get("aggregateId"); ok && aggregateId != nil {
+		event.AggregateId, _ = aggregateId.(string)
+	}
+	if requestId, ok := record.Get("requestId"); ok && requestId != nil {
+		event.RequestId, _ = requestId.(string)
+	}
+	if actor, ok := record.Get("actor"); ok && actor != nil {
+		event.Actor, _ = actor.(string)
+	}
+	if created, ok := record.Get("created"); ok && created != nil {
+		event.Created, _ = created.(time.Time)
+	}
+	if payload, ok := record.Get("payload"); ok && payload != nil {
+		if payloadStr, ok := payload.(string); ok && payloadStr != "" {
+			event.Payload = json.RawMessage(payloadStr)
+		}
+	}
+	return event
+}
@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"service-atlas/databaseadapter"
+	"service-atlas/internal/events"
+	nRepo "service-atlas/neo4jrepositories"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+type capturingSink struct {
+	events []events.Event
+	err    error
+}
+
+func (s *capturingSink) Publish(_ context.Context, event events.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestMapRecordToEvent(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	record := &neo4j.Record{
+		Keys:   []string{"id", "type", "aggregateType", "aggregateId", "requestId", "actor", "created", "payload"},
+		Values: []any{"e-1", events.ServiceCreated, events.AggregateService, "svc-1", "req-1", "apikey:k-1", created, `{"name":"cart"}`},
+	}
+	event := mapRecordToEvent(record)
+	switch {
+	case event.Id != "e-1":
+		t.Errorf("unexpected id %q", event.Id)
+	case event.Type != events.ServiceCreated:
+		t.Errorf("unexpected type %q", event.Type)
+	case event.AggregateType != events.AggregateService || event.AggregateId != "svc-1":
+		t.Errorf("unexpected aggregate %q/%q", event.AggregateType, event.AggregateId)
+	case event.RequestId != "req-1":
+		t.Errorf("unexpected request id %q", event.RequestId)
+	case event.Actor != "apikey:k-1":
+		t.Errorf("unexpected actor %q", event.Actor)
+	case !event.Created.Equal(created):
+		t.Errorf("unexpected created %v", event.Created)
+	case string(event.Payload) != `{"name":"cart"}`:
+		t.Errorf("unexpected payload %s", event.Payload)
+	}
+}
+
+func TestMapRecordToEvent_MissingValues(t *testing.T) {
+	record := &neo4j.Record{
+		Keys:   []string{"id", "type", "payload"},
+		Values: []any{"e-1", nil, nil},
+	}
+	event := mapRecordToEvent(record)
+	if event.Id != "e-1" || event.Type != "" || event.Payload != nil {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestRelay_Drain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	manager := databaseadapter.NewDriverManager(driver)
+	// An event written in a rolled back transaction must never be published
+	_, err = manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := Record(ctx, tx, events.ServiceCreated, events.AggregateService, "rolled-back", nil); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("abort")
+	})
+	if err == nil {
+		t.Fatal("expected transaction to fail")
+	}
+	for _, id := range []string{"svc-1", "svc-2"} {
+		_, err = manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+			return nil, Record(ctx, tx, events.ServiceCreated, events.AggregateService, id, map[string]string{"id": id})
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A failing sink leaves events pending
+	failing := NewRelay(driver, &capturingSink{err: errors.New("sink down")}, time.Second, DefaultRetention)
+	if _, err = failing.Drain(ctx); err == nil {
+		t.Fatal("expected error from failing sink")
+	}
+
+	sink := &capturingSink{}
+	relay := NewRelay(driver, sink, time.Second, DefaultRetention)
+	// Expire the failing relay's claim so the events can be picked up again
+	_, err = manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, "MATCH (e:OutboxEvent) REMOVE e.claimedUntil", nil)
+		return nil, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	published, err := relay.Drain(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if published != 2 || len(sink.events) != 2 {
+		t.Fatalf("expected 2 events published, got %d", published)
+	}
+	for _, e := range sink.events {
+		if e.AggregateId == "rolled-back" {
+			t.Error("event from rolled back transaction was published")
+		}
+	}
+
+	// Nothing left to publish
+	published, err = relay.Drain(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if published != 0 {
+		t.Errorf("expected no events on second drain, got %d", published)
+	}
+
+	// Published events are kept until they are older than the retention
+	if deleted, err := relay.Sweep(ctx); err != nil || deleted != 0 {
+		t.Fatalf("expected recent events to be kept, deleted %d: %v", deleted, err)
+	}
+	_, err = manager.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		_, err := tx.Run(ctx, "MATCH (e:OutboxEvent {aggregateId: 'svc-1'}) SET e.published = datetime() - duration('P30D')", nil)
+		return nil, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted, err := relay.Sweep(ctx); err != nil || deleted != 1 {
+		t.Fatalf("expected the old event to be deleted, deleted %d: %v", deleted, err)
+	}
+}
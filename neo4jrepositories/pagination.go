@@ -0,0 +1,43 @@
+package neo4jrepositories
+
+import (
+	"context"
+	"maps"
+	"service-atlas/internal/pagination"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// PageParams returns params with the $skip and $limit of page added, limit reading the extra row
+// pagination.NewResult needs to tell whether another page follows.
+func PageParams(page pagination.Page, params map[string]any) map[string]any {
+	withPage := maps.Clone(params)
+	if withPage == nil {
+		withPage = make(map[string]any, 2)
+	}
+	withPage["skip"] = page.Offset
+	withPage["limit"] = page.Fetch()
+	return withPage
+}
+
+// CountTotal sets the total of result when page asks for it, running query, which returns the length of the whole
+// list as total.
+func CountTotal[T any](ctx context.Context, tx neo4j.ManagedTransaction, page pagination.Page, result *pagination.Result[T], query string, params map[string]any) error {
+	if !page.WithTotal {
+		return nil
+	}
+	records, err := tx.Run(ctx, query, params)
+	if err != nil {
+		return err
+	}
+	record, err := records.Single(ctx)
+	if err != nil {
+		return err
+	}
+	total, _, err := neo4j.GetRecordValue[int64](record, "total")
+	if err != nil {
+		return err
+	}
+	result.SetTotal(total)
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -62,7 +64,7 @@ func (r *Neo4jReleaseRepository) CreateRelease(ctx context.Context, release repo
 			return nil, err
 		}
 
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.ReleaseCreated, events.AggregateService, release.ServiceId, release)
 	}
 
 	_, err := r.manager.ExecuteWrite(ctx, createReleaseTransaction)
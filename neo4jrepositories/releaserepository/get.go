@@ -5,17 +5,19 @@ import (
 	"fmt"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 	"time"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (r *Neo4jReleaseRepository) GetReleasesByServiceId(ctx context.Context, serviceId string, page, pageSize int) ([]*repositories.Release, error) {
-	if page <= 0 || pageSize <= 0 {
-		return nil, &customerrors.HTTPError{
+func (r *Neo4jReleaseRepository) GetReleasesByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[*repositories.Release], error) {
+	if page.Offset < 0 || page.Limit <= 0 {
+		return pagination.Result[*repositories.Release]{}, &customerrors.HTTPError{
 			Status: http.StatusBadRequest,
-			Msg:    "page and page size must be positive integers",
+			Msg:    "page offset and limit must not be negative",
 		}
 	}
 
@@ -48,15 +50,12 @@ func (r *Neo4jReleaseRepository) GetReleasesByServiceId(ctx context.Context, ser
 		query := `
 			MATCH (s:Service {id: $serviceId})-[rel:RELEASED]->(r:Release)
 			RETURN r.releaseDate as releaseDate, r.url as url, r.version as version
-			ORDER BY r.releaseDate DESC
+			ORDER BY r.releaseDate DESC, r.version
 			SKIP $skip
 			LIMIT $limit
 		`
-		result, err = tx.Run(ctx, query, map[string]any{
-			"serviceId": serviceId,
-			"skip":      (page - 1) * pageSize,
-			"limit":     pageSize,
-		})
+		params := map[string]any{"serviceId": serviceId}
+		result, err = tx.Run(ctx, query, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -85,34 +84,38 @@ func (r *Neo4jReleaseRepository) GetReleasesByServiceId(ctx context.Context, ser
 			releases = append(releases, release)
 		}
 
-		return releases, nil
+		paged := pagination.NewResult(releases, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `
+			MATCH (:Service {id: $serviceId})-[:RELEASED]->(r:Release)
+			RETURN count(r) AS total
+		`, params)
+		return paged, err
 	}
 
 	result, err := r.manager.ExecuteRead(ctx, getReleasesByServiceIdTransaction)
 	if err != nil {
-		return nil, err
+		return pagination.Result[*repositories.Release]{}, err
 	}
 
-	return result.([]*repositories.Release), nil
+	return result.(pagination.Result[*repositories.Release]), nil
 }
 
-func (r *Neo4jReleaseRepository) GetReleasesInDateRange(ctx context.Context, startDate, endDate time.Time, page, pageSize int) ([]*repositories.ServiceReleaseInfo, error) {
+func (r *Neo4jReleaseRepository) GetReleasesInDateRange(ctx context.Context, startDate, endDate time.Time, page pagination.Page) (pagination.Result[*repositories.ServiceReleaseInfo], error) {
+	params := map[string]any{
+		"startDate": startDate.Format("2006-01-02"),
+		"endDate":   endDate.Format("2006-01-02"),
+	}
 	getReleasesInRangeTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
 		query := `
 			MATCH (s:Service)-[rel:RELEASED]->(r:Release)
 			WHERE r.releaseDate >= datetime($startDate) AND r.releaseDate <= datetime($endDate)
 			RETURN r.releaseDate as releaseDate, r.url as url, r.version as version, s.id as serviceId, s.name as serviceName, s.type as serviceType
-			ORDER BY r.releaseDate DESC
+			ORDER BY r.releaseDate DESC, s.id, r.version
 			SKIP $skip
 			LIMIT $limit
 		`
 
-		result, err := tx.Run(ctx, query, map[string]any{
-			"startDate": startDate.Format("2006-01-02"),
-			"endDate":   endDate.Format("2006-01-02"),
-			"skip":      (page - 1) * pageSize,
-			"limit":     pageSize,
-		})
+		result, err := tx.Run(ctx, query, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -145,12 +148,18 @@ func (r *Neo4jReleaseRepository) GetReleasesInDateRange(ctx context.Context, sta
 			releases = append(releases, release)
 		}
 
-		return releases, nil
+		paged := pagination.NewResult(releases, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `
+			MATCH (:Service)-[:RELEASED]->(r:Release)
+			WHERE r.releaseDate >= datetime($startDate) AND r.releaseDate <= datetime($endDate)
+			RETURN count(r) AS total
+		`, params)
+		return paged, err
 
 	}
 	result, err := r.manager.ExecuteRead(ctx, getReleasesInRangeTransaction)
 	if err != nil {
-		return nil, err
+		return pagination.Result[*repositories.ServiceReleaseInfo]{}, err
 	}
-	return result.([]*repositories.ServiceReleaseInfo), nil
+	return result.(pagination.Result[*repositories.ServiceReleaseInfo]), nil
 }
@@ -3,6 +3,7 @@ package releaserepository
 import (
 	"context"
 	"errors"
+	"service-atlas/internal/pagination"
 	"testing"
 	"time"
 
@@ -54,10 +55,11 @@ func TestNeo4jReleaseRepository_GetReleasesByServiceId_SuccessAndPagination(t *t
 	}
 
 	// Act: page 1 size 2 should return newest two releases (Jan 2, Jan 1)
-	page1, err := repo.GetReleasesByServiceId(ctx, serviceID, 1, 2)
+	page1Result, err := repo.GetReleasesByServiceId(ctx, serviceID, pagination.FromPage(1, 2))
 	if err != nil {
 		t.Fatalf("GetReleasesByServiceId page1 error: %v", err)
 	}
+	page1 := page1Result.Items
 	if len(page1) != 2 {
 		t.Fatalf("expected 2 releases on page1, got %d", len(page1))
 	}
@@ -76,10 +78,11 @@ func TestNeo4jReleaseRepository_GetReleasesByServiceId_SuccessAndPagination(t *t
 	}
 
 	// Act: page 2 size 2 should return the last release (Dec 31)
-	page2, err := repo.GetReleasesByServiceId(ctx, serviceID, 2, 2)
+	page2Result, err := repo.GetReleasesByServiceId(ctx, serviceID, pagination.FromPage(2, 2))
 	if err != nil {
 		t.Fatalf("GetReleasesByServiceId page2 error: %v", err)
 	}
+	page2 := page2Result.Items
 	if len(page2) != 1 {
 		t.Fatalf("expected 1 release on page2, got %d", len(page2))
 	}
@@ -112,7 +115,7 @@ func TestNeo4jReleaseRepository_GetReleasesByServiceId_NotFound(t *testing.T) {
 
 	repo := New(driver)
 
-	_, err = repo.GetReleasesByServiceId(ctx, "00000000-0000-0000-0000-000000000000", 1, 10)
+	_, err = repo.GetReleasesByServiceId(ctx, "00000000-0000-0000-0000-000000000000", pagination.FromPage(1, 10))
 	if err == nil {
 		t.Fatalf("expected error for non-existent service")
 	}
@@ -145,10 +148,10 @@ func TestNeo4jReleaseRepository_GetReleasesByServiceId_BadParams(t *testing.T) {
 
 	repo := New(driver)
 
-	// page <= 0
-	_, err = repo.GetReleasesByServiceId(ctx, "some-id", 0, 10)
+	// negative offset
+	_, err = repo.GetReleasesByServiceId(ctx, "some-id", pagination.Page{Offset: -1, Limit: 10})
 	if err == nil {
-		t.Fatalf("expected error for page <= 0")
+		t.Fatalf("expected error for a negative offset")
 	}
 	var httpErr *customerrors.HTTPError
 	if !errors.As(err, &httpErr) {
@@ -158,10 +161,10 @@ func TestNeo4jReleaseRepository_GetReleasesByServiceId_BadParams(t *testing.T) {
 		t.Fatalf("expected HTTP 400, got %d", httpErr.Status)
 	}
 
-	// pageSize <= 0
-	_, err = repo.GetReleasesByServiceId(ctx, "some-id", 1, 0)
+	// limit <= 0
+	_, err = repo.GetReleasesByServiceId(ctx, "some-id", pagination.Page{Limit: 0})
 	if err == nil {
-		t.Fatalf("expected error for pageSize <= 0")
+		t.Fatalf("expected error for limit <= 0")
 	}
 	if !errors.As(err, &httpErr) {
 		t.Fatalf("expected *customerrors.HTTPError, got %T: %v", err, err)
@@ -0,0 +1,48 @@
+package reportrepository
+
+import (
+	"context"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func (r Neo4jReportRepository) GetCatalogCounts(ctx context.Context) (*repositories.CatalogCounts, error) {
+	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		cypher := `
+        CALL { MATCH (s:Service) RETURN count(s) AS services }
+        CALL { MATCH (t:Team) RETURN count(t) AS teams }
+        CALL { MATCH (d:Debt) WHERE d.status IN $statuses RETURN count(d) AS openDebt }
+        RETURN services, teams, openDebt
+        `
+		params := map[string]any{
+			"statuses": []string{"in_progress", "pending"},
+		}
+		result, err := tx.Run(ctx, cypher, params)
+		if err != nil {
+			return nil, err
+		}
+		rec, err := result.Single(ctx)
+		if err != nil {
+			return nil, err
+		}
+		services, _, err := neo4j.GetRecordValue[int64](rec, "services")
+		if err != nil {
+			return nil, err
+		}
+		teams, _, err := neo4j.GetRecordValue[int64](rec, "teams")
+		if err != nil {
+			return nil, err
+		}
+		openDebt, _, err := neo4j.GetRecordValue[int64](rec, "openDebt")
+		if err != nil {
+			return nil, err
+		}
+		return &repositories.CatalogCounts{Services: services, Teams: teams, OpenDebt: openDebt}, nil
+	}
+	counts, err := r.manager.ExecuteRead(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+	return counts.(*repositories.CatalogCounts), nil
+}
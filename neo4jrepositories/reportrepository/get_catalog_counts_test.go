@@ -0,0 +1,85 @@
+package reportrepository
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"testing"
+	"time"
+
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/debtrepository"
+	"service-atlas/neo4jrepositories/servicerepository"
+	"service-atlas/neo4jrepositories/teamrepository"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jReportRepository_GetCatalogCounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(context.Background()) })
+
+	driver, err := neo4j.NewDriverWithContext(
+		tc.Endpoint,
+		neo4j.BasicAuth("neo4j", "letmein!", ""),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	reportRepo := New(driver)
+	svcRepo := servicerepository.New(driver)
+	debtRepo := debtrepository.New(driver)
+
+	// Empty catalog
+	counts, err := reportRepo.GetCatalogCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetCatalogCounts error: %v", err)
+	}
+	if *counts != (repositories.CatalogCounts{}) {
+		t.Errorf("expected empty counts, got %+v", counts)
+	}
+
+	svcA, err := svcRepo.CreateService(ctx, repositories.Service{Name: "svc-A", ServiceType: "api", Url: "https://a"})
+	if err != nil {
+		t.Fatalf("create svcA: %v", err)
+	}
+	if _, err = svcRepo.CreateService(ctx, repositories.Service{Name: "svc-B", ServiceType: "api", Url: "https://b"}); err != nil {
+		t.Fatalf("create svcB: %v", err)
+	}
+	if _, err = teamrepository.New(driver).CreateTeam(ctx, repositories.Team{Name: "team-a"}); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	for _, title := range []string{"A-1", "A-2"} {
+		if err := debtRepo.CreateDebtItem(ctx, repositories.Debt{Type: "debt", Title: title, ServiceId: svcA}); err != nil {
+			t.Fatalf("CreateDebtItem %s: %v", title, err)
+		}
+	}
+	debts, err := debtRepo.GetDebtByServiceId(ctx, svcA, pagination.First(10), false)
+	if err != nil || len(debts.Items) != 2 {
+		t.Fatalf("expected 2 debts, got %d: %v", len(debts.Items), err)
+	}
+	// remediated debt is not open
+	if err := debtRepo.UpdateStatus(ctx, debts.Items[0].Id, "remediated"); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+
+	counts, err = reportRepo.GetCatalogCounts(ctx)
+	if err != nil {
+		t.Fatalf("GetCatalogCounts error: %v", err)
+	}
+	if want := (repositories.CatalogCounts{Services: 2, Teams: 1, OpenDebt: 1}); *counts != want {
+		t.Errorf("expected %+v, got %+v", want, *counts)
+	}
+}
@@ -4,24 +4,28 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (r Neo4jReportRepository) GetDebtCountByService(ctx context.Context) ([]repositories.ServiceDebtReport, error) {
-	debtReport := make([]repositories.ServiceDebtReport, 0)
+func (r Neo4jReportRepository) GetDebtCountByService(ctx context.Context, page pagination.Page) (pagination.Result[repositories.ServiceDebtReport], error) {
 	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		debtReport := make([]repositories.ServiceDebtReport, 0)
 		cypher := `
         MATCH (s:Service)-[:OWNS]->(d:Debt)
         WHERE d.status IN $statuses
         RETURN s.name AS name, s.id AS id, count(d) AS count
-        ORDER BY count DESC, name ASC
+        ORDER BY count DESC, name ASC, id ASC
+        SKIP $skip
+        LIMIT $limit
         `
 		params := map[string]any{
 			"statuses": []string{"in_progress", "pending"},
 		}
-		result, err := tx.Run(ctx, cypher, params)
+		result, err := tx.Run(ctx, cypher, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -46,11 +50,17 @@ func (r Neo4jReportRepository) GetDebtCountByService(ctx context.Context) ([]rep
 		if err := result.Err(); err != nil {
 			return nil, err
 		}
-		return nil, nil
+		paged := pagination.NewResult(debtReport, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `
+        MATCH (s:Service)-[:OWNS]->(d:Debt)
+        WHERE d.status IN $statuses
+        RETURN count(DISTINCT s) AS total
+        `, params)
+		return paged, err
 	}
-	_, err := r.manager.ExecuteRead(ctx, work)
+	debtReport, err := r.manager.ExecuteRead(ctx, work)
 	if err != nil {
-		return nil, err
+		return pagination.Result[repositories.ServiceDebtReport]{}, err
 	}
-	return debtReport, nil
+	return debtReport.(pagination.Result[repositories.ServiceDebtReport]), nil
 }
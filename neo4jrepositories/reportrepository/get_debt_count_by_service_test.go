@@ -2,6 +2,7 @@ package reportrepository
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"testing"
 	"time"
 
@@ -128,10 +129,11 @@ func TestNeo4jReportRepository_GetDebtCountByService_FilterAndExclude(t *testing
 	}
 
 	// Act
-	report, err := reportRepo.GetDebtCountByService(ctx)
+	reportResult, err := reportRepo.GetDebtCountByService(ctx, pagination.First(pagination.MaxLimit))
 	if err != nil {
 		t.Fatalf("GetDebtCountByService error: %v", err)
 	}
+	report := reportResult.Items
 
 	// Convert to map for easy assertions
 	got := make(map[string]int64)
@@ -0,0 +1,104 @@
+package reportrepository
+
+import (
+	"context"
+	"service-atlas/repositories"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// GetIntegrityReport finds services and teams sharing a name, services sharing a repository url, and debt and
+// releases that lost their service. Urls are grouped once normalized, so the whole catalog is read.
+func (r Neo4jReportRepository) GetIntegrityReport(ctx context.Context) (*repositories.IntegrityReport, error) {
+	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		report := &repositories.IntegrityReport{}
+		var serviceIds, serviceNames, serviceUrls []string
+		result, err := tx.Run(ctx, `
+			MATCH (s:Service)
+			RETURN s.id AS id, coalesce(s.name, '') AS name, coalesce(s.url, '') AS url
+		`, nil)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			id, _, _ := neo4j.GetRecordValue[string](result.Record(), "id")
+			name, _, _ := neo4j.GetRecordValue[string](result.Record(), "name")
+			url, _, _ := neo4j.GetRecordValue[string](result.Record(), "url")
+			serviceIds = append(serviceIds, id)
+			serviceNames = append(serviceNames, name)
+			serviceUrls = append(serviceUrls, repositories.NormalizeRepositoryUrl(url))
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		report.DuplicateServiceNames = repositories.FindDuplicates(serviceIds, serviceNames)
+		report.DuplicateServiceUrls = repositories.FindDuplicates(serviceIds, serviceUrls)
+
+		var teamIds, teamNames []string
+		result, err = tx.Run(ctx, `MATCH (t:Team) RETURN t.id AS id, coalesce(t.name, '') AS name`, nil)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			id, _, _ := neo4j.GetRecordValue[string](result.Record(), "id")
+			name, _, _ := neo4j.GetRecordValue[string](result.Record(), "name")
+			teamIds = append(teamIds, id)
+			teamNames = append(teamNames, name)
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		report.DuplicateTeamNames = repositories.FindDuplicates(teamIds, teamNames)
+
+		report.OrphanedDebt = make([]repositories.Debt, 0)
+		result, err = tx.Run(ctx, `
+			MATCH (d:Debt)
+			WHERE NOT (:Service)-[:OWNS]->(d)
+			RETURN d.id AS id, coalesce(d.title, '') AS title, coalesce(d.description, '') AS description,
+				coalesce(d.type, '') AS type, coalesce(d.status, '') AS status
+			ORDER BY d.created
+		`, nil)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			record := result.Record()
+			var debt repositories.Debt
+			debt.Id, _, _ = neo4j.GetRecordValue[string](record, "id")
+			debt.Title, _, _ = neo4j.GetRecordValue[string](record, "title")
+			debt.Description, _, _ = neo4j.GetRecordValue[string](record, "description")
+			debt.Type, _, _ = neo4j.GetRecordValue[string](record, "type")
+			debt.Status, _, _ = neo4j.GetRecordValue[string](record, "status")
+			report.OrphanedDebt = append(report.OrphanedDebt, debt)
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+
+		report.OrphanedReleases = make([]repositories.Release, 0)
+		result, err = tx.Run(ctx, `
+			MATCH (r:Release)
+			WHERE NOT (:Service)-[:RELEASED]->(r)
+			RETURN r.releaseDate AS releaseDate, coalesce(r.url, '') AS url, coalesce(r.version, '') AS version
+			ORDER BY r.releaseDate
+		`, nil)
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			record := result.Record()
+			var release repositories.Release
+			release.ReleaseDate, _, _ = neo4j.GetRecordValue[time.Time](record, "releaseDate")
+			release.Url, _, _ = neo4j.GetRecordValue[string](record, "url")
+			release.Version, _, _ = neo4j.GetRecordValue[string](record, "version")
+			report.OrphanedReleases = append(report.OrphanedReleases, release)
+		}
+		return report, result.Err()
+	}
+	report, err := r.manager.ExecuteRead(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+	return report.(*repositories.IntegrityReport), nil
+}
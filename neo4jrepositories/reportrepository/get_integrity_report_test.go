@@ -0,0 +1,69 @@
+package reportrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nRepo "service-atlas/neo4jrepositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jReportRepository_GetIntegrityReport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(context.Background()) })
+
+	driver, err := neo4j.NewDriverWithContext(
+		tc.Endpoint,
+		neo4j.BasicAuth("neo4j", "letmein!", ""),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	// duplicates written directly, as they were before the repositories rejected them
+	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer func() { _ = session.Close(ctx) }()
+	if _, err = session.Run(ctx, `
+		CREATE (:Service {id: 's1', name: 'cart', url: 'https://github.com/shop/cart'}),
+			(:Service {id: 's2', name: 'cart', url: 'https://github.com/shop/basket'}),
+			(:Service {id: 's3', name: 'basket', url: 'git@github.com:shop/cart.git'})-[:OWNS]->(:Debt {id: 'd1', title: 'owned'}),
+			(:Team {id: 't1', name: 'shop'}), (:Team {id: 't2', name: 'shop'}),
+			(:Debt {id: 'd2', title: 'orphaned', created: datetime()}),
+			(:Release {version: '1.0', releaseDate: datetime()})
+	`, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := New(driver).GetIntegrityReport(ctx)
+	if err != nil {
+		t.Fatalf("GetIntegrityReport error: %v", err)
+	}
+	if len(report.DuplicateServiceNames) != 1 || report.DuplicateServiceNames[0].Value != "cart" {
+		t.Errorf("expected the services named cart, got %+v", report.DuplicateServiceNames)
+	}
+	if len(report.DuplicateServiceUrls) != 1 || len(report.DuplicateServiceUrls[0].Ids) != 2 {
+		t.Errorf("expected the services of the cart repository, got %+v", report.DuplicateServiceUrls)
+	}
+	if len(report.DuplicateTeamNames) != 1 || report.DuplicateTeamNames[0].Value != "shop" {
+		t.Errorf("expected the teams named shop, got %+v", report.DuplicateTeamNames)
+	}
+	if len(report.OrphanedDebt) != 1 || report.OrphanedDebt[0].Id != "d2" {
+		t.Errorf("expected debt d2 to be orphaned, got %+v", report.OrphanedDebt)
+	}
+	if len(report.OrphanedReleases) != 1 || report.OrphanedReleases[0].Version != "1.0" {
+		t.Errorf("expected the release to be orphaned, got %+v", report.OrphanedReleases)
+	}
+}
@@ -4,21 +4,26 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (r Neo4jReportRepository) GetServicesByTeam(ctx context.Context, teamId string) ([]repositories.Service, error) {
+func (r Neo4jReportRepository) GetServicesByTeam(ctx context.Context, teamId string, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	params := map[string]any{
+		"teamId": teamId,
+	}
 	getServicesByTeamTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
 		cypher := `
 		MATCH (t:Team {id: $teamId}) -[r:OWNS]-> (s:Service)
 		RETURN s
+		ORDER BY s.name, s.id
+		SKIP $skip
+		LIMIT $limit
 		`
-		result, err := tx.Run(ctx, cypher, map[string]any{
-			"teamId": teamId,
-		})
+		result, err := tx.Run(ctx, cypher, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, customerrors.HTTPError{
 				Status: http.StatusInternalServerError,
@@ -41,16 +46,24 @@ func (r Neo4jReportRepository) GetServicesByTeam(ctx context.Context, teamId str
 			svc := nRepo.MapNodeToService(n)
 			services = append(services, svc)
 		}
-		return services, nil
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		paged := pagination.NewResult(services, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `
+		MATCH (:Team {id: $teamId}) -[:OWNS]-> (s:Service)
+		RETURN count(s) AS total
+		`, params)
+		return paged, err
 	}
 
 	servicesResult, err := r.manager.ExecuteRead(ctx, getServicesByTeamTransaction)
 	if err != nil {
-		return nil, err
+		return pagination.Result[repositories.Service]{}, err
 	}
-	services, ok := servicesResult.([]repositories.Service)
+	services, ok := servicesResult.(pagination.Result[repositories.Service])
 	if !ok {
-		return nil, customerrors.HTTPError{
+		return pagination.Result[repositories.Service]{}, customerrors.HTTPError{
 			Status: http.StatusInternalServerError,
 			Msg:    "unexpected return type from transaction",
 		}
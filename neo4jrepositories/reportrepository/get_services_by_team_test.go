@@ -2,6 +2,7 @@ package reportrepository
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"testing"
 
 	nRepo "service-atlas/neo4jrepositories"
@@ -69,10 +70,11 @@ func TestNeo4jReportRepository_GetServicesByTeam_ReturnsServices(t *testing.T) {
 	}
 
 	// Act
-	services, err := reportRepo.GetServicesByTeam(ctx, teamID)
+	servicesResult, err := reportRepo.GetServicesByTeam(ctx, teamID, pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetServicesByTeam error: %v", err)
 	}
+	services := servicesResult.Items
 
 	// Assert
 	if len(services) != 2 {
@@ -128,10 +130,11 @@ func TestNeo4jReportRepository_GetServicesByTeam_EmptyWhenNoServices(t *testing.
 		t.Fatalf("CreateTeam error: %v", err)
 	}
 
-	services, err := reportRepo.GetServicesByTeam(ctx, teamID)
+	servicesResult, err := reportRepo.GetServicesByTeam(ctx, teamID, pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetServicesByTeam error: %v", err)
 	}
+	services := servicesResult.Items
 	if len(services) != 0 {
 		t.Fatalf("expected 0 services, got %d", len(services))
 	}
@@ -158,10 +161,11 @@ func TestNeo4jReportRepository_GetServicesByTeam_EmptyWhenTeamNotFound(t *testin
 
 	reportRepo := New(driver)
 	// Act: use some random id that doesn't exist
-	services, err := reportRepo.GetServicesByTeam(ctx, "00000000-0000-0000-0000-000000000000")
+	servicesResult, err := reportRepo.GetServicesByTeam(ctx, "00000000-0000-0000-0000-000000000000", pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetServicesByTeam error: %v", err)
 	}
+	services := servicesResult.Items
 	if len(services) != 0 {
 		t.Fatalf("expected 0 services for non-existent team, got %d", len(services))
 	}
@@ -0,0 +1,81 @@
+package searchrepository
+
+import (
+	"context"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// Autocomplete queries the name index for names with a word starting with each word of the prefix, and adds the
+// services and teams whose id starts with it, which the id constraints index. The words hold only letters and digits,
+// so the query needs no escaping.
+func (r *Neo4jSearchRepository) Autocomplete(ctx context.Context, query repositories.AutocompleteQuery) ([]repositories.Suggestion, error) {
+	words := repositories.NameWords(query.Prefix)
+	if len(words) == 0 {
+		return []repositories.Suggestion{}, nil
+	}
+	for i, word := range words {
+		words[i] = word + "*"
+	}
+	kinds := make([]string, 0, len(repositories.AutocompleteKinds))
+	for _, kind := range query.Kinds {
+		kinds = append(kinds, string(kind))
+	}
+	if len(kinds) == 0 {
+		for _, kind := range repositories.AutocompleteKinds {
+			kinds = append(kinds, string(kind))
+		}
+	}
+	params := map[string]any{
+		"indexName": nRepo.NameFulltextIndexName,
+		"q":         strings.Join(words, " AND "),
+		"prefix":    strings.ToLower(strings.TrimSpace(query.Prefix)),
+		"kinds":     kinds,
+		"limit":     query.Limit,
+	}
+	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, `
+            CALL {
+                CALL db.index.fulltext.queryNodes($indexName, $q) YIELD node
+                RETURN node
+                UNION
+                MATCH (node:Service) WHERE node.id STARTS WITH $prefix
+                RETURN node
+                UNION
+                MATCH (node:Team) WHERE node.id STARTS WITH $prefix
+                RETURN node
+            }
+            WITH node, CASE WHEN node:Service THEN 'service' ELSE 'team' END AS kind
+            WHERE kind IN $kinds
+            RETURN kind, node.id AS id, node.name AS name, CASE kind WHEN 'service' THEN node.type END AS type
+            ORDER BY toLower(name) STARTS WITH $prefix DESC, size(name), name, kind, id
+            LIMIT $limit
+        `, params)
+		if err != nil {
+			return nil, err
+		}
+		suggestions := make([]repositories.Suggestion, 0, query.Limit)
+		for result.Next(ctx) {
+			record := result.Record()
+			kind, _, _ := neo4j.GetRecordValue[string](record, "kind")
+			id, _, _ := neo4j.GetRecordValue[string](record, "id")
+			name, _, _ := neo4j.GetRecordValue[string](record, "name")
+			serviceType, _, _ := neo4j.GetRecordValue[string](record, "type")
+			suggestions = append(suggestions, repositories.Suggestion{
+				Kind: repositories.SearchKind(kind),
+				Id:   id,
+				Name: name,
+				Type: serviceType,
+			})
+		}
+		return suggestions, result.Err()
+	}
+	suggestions, err := r.manager.ExecuteRead(ctx, work)
+	if err != nil {
+		return nil, err
+	}
+	return suggestions.([]repositories.Suggestion), nil
+}
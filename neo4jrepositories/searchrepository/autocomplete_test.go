@@ -0,0 +1,112 @@
+package searchrepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/servicerepository"
+	"service-atlas/neo4jrepositories/teamrepository"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jSearchRepository_Autocomplete(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	_, err = nRepo.NewMigrator(driver).Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Arrange: services and a team with names starting with ord, and one only describing orders
+	svcRepo := servicerepository.New(driver)
+	for _, service := range []repositories.Service{
+		{Name: "legacy-orders", ServiceType: "worker", Url: "https://legacy-orders"},
+		{Name: "orders", ServiceType: "api", Url: "https://orders"},
+		{Name: "cart", ServiceType: "api", Url: "https://cart", Description: "orders things"},
+	} {
+		if _, err = svcRepo.CreateService(ctx, service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	team, err := teamrepository.New(driver).CreateTeam(ctx, repositories.Team{Name: "Order Fulfilment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := New(driver)
+
+	// Act
+	suggestions, err := repo.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "Ord", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert: names starting with the prefix first, shortest first
+	var names []string
+	for _, suggestion := range suggestions {
+		names = append(names, suggestion.Name)
+	}
+	want := []string{"orders", "Order Fulfilment", "legacy-orders"}
+	if len(names) != len(want) || names[0] != want[0] || names[1] != want[1] || names[2] != want[2] {
+		t.Fatalf("expected %v, got %v", want, names)
+	}
+	if suggestions[0].Kind != repositories.KindService || suggestions[0].Type != "api" {
+		t.Errorf("expected the orders api first, got %+v", suggestions[0])
+	}
+	if suggestions[1].Kind != repositories.KindTeam || suggestions[1].Id != team || suggestions[1].Type != "" {
+		t.Errorf("expected the team second, got %+v", suggestions[1])
+	}
+
+	teams, err := repo.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "ord ful", Kinds: []repositories.SearchKind{repositories.KindTeam}, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 1 || teams[0].Id != team {
+		t.Errorf("expected only the team, got %+v", teams)
+	}
+
+	limited, err := repo.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "ord", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limited) != 1 || limited[0].Name != "orders" {
+		t.Errorf("expected only orders, got %+v", limited)
+	}
+
+	byId, err := repo.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: strings.ToUpper(team[:8]), Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byId) != 1 || byId[0].Id != team {
+		t.Errorf("expected the team whose id starts with the prefix, got %+v", byId)
+	}
+
+	byType, err := repo.Autocomplete(ctx, repositories.AutocompleteQuery{Prefix: "work", Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byType) != 0 {
+		t.Errorf("expected types not to be matched, got %+v", byType)
+	}
+}
@@ -0,0 +1,14 @@
+package searchrepository
+
+import (
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"service-atlas/databaseadapter"
+)
+
+type Neo4jSearchRepository struct {
+	manager databaseadapter.DriverManager
+}
+
+func New(driver neo4j.DriverWithContext) *Neo4jSearchRepository {
+	return &Neo4jSearchRepository{manager: databaseadapter.NewDriverManager(driver)}
+}
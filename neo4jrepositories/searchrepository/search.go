@@ -0,0 +1,126 @@
+package searchrepository
+
+import (
+	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// hits queries every fulltext index for $q, returning one row per hit. Scores come from separate indexes, so they
+// rank hits of the same kind better than they compare hits of different kinds.
+const hits = `
+    CALL () {
+        CALL db.index.fulltext.queryNodes($serviceIndex, $q) YIELD node, score
+        RETURN 'service' AS kind, node.id AS id, node.name AS title, node.description AS description,
+            node.id AS serviceId, node.name AS serviceName, node.type AS serviceType, score
+        UNION ALL
+        CALL db.index.fulltext.queryNodes($teamIndex, $q) YIELD node, score
+        RETURN 'team' AS kind, node.id AS id, node.name AS title, null AS description,
+            null AS serviceId, null AS serviceName, null AS serviceType, score
+        UNION ALL
+        CALL db.index.fulltext.queryNodes($debtIndex, $q) YIELD node, score
+        OPTIONAL MATCH (s:Service)-[:OWNS]->(node)
+        RETURN 'debt' AS kind, node.id AS id, node.title AS title, node.description AS description,
+            s.id AS serviceId, s.name AS serviceName, s.type AS serviceType, score
+        UNION ALL
+        CALL db.index.fulltext.queryNodes($releaseIndex, $q) YIELD node, score
+        OPTIONAL MATCH (s:Service)-[:RELEASED]->(node)
+        RETURN 'release' AS kind, null AS id, node.version AS title, null AS description,
+            s.id AS serviceId, s.name AS serviceName, s.type AS serviceType, score
+    }
+`
+
+// narrowed keeps the hits of the kinds and service type of the query.
+const narrowed = hits + `
+    WITH * WHERE (size($kinds) = 0 OR kind IN $kinds)
+        AND ($serviceType = '' OR toLower(serviceType) = toLower($serviceType))
+`
+
+// SearchCatalog queries the fulltext indexes of services, teams, debt and releases, fuzzily matching every word of
+// the text like the service search does.
+func (r *Neo4jSearchRepository) SearchCatalog(ctx context.Context, query repositories.SearchQuery, page pagination.Page) (repositories.SearchResults, error) {
+	results := repositories.SearchResults{
+		Result: pagination.Slice([]repositories.SearchHit{}, page),
+		Facets: repositories.NewSearchFacets(),
+	}
+	text := searchquery.Text(query.Text).Lucene()
+	if text == "" {
+		return results, nil
+	}
+	kinds := make([]string, 0, len(query.Kinds))
+	for _, kind := range query.Kinds {
+		kinds = append(kinds, string(kind))
+	}
+	params := map[string]any{
+		"q":            text,
+		"serviceIndex": nRepo.ServiceFulltextIndexName,
+		"teamIndex":    nRepo.TeamFulltextIndexName,
+		"debtIndex":    nRepo.DebtFulltextIndexName,
+		"releaseIndex": nRepo.ReleaseFulltextIndexName,
+		"kinds":        kinds,
+		"serviceType":  query.ServiceType,
+	}
+	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		result, err := tx.Run(ctx, narrowed+`
+            RETURN kind, id, title, description, serviceId, serviceName, serviceType, score
+            ORDER BY score DESC, kind, id, serviceId, title
+            SKIP $skip
+            LIMIT $limit
+        `, nRepo.PageParams(page, params))
+		if err != nil {
+			return nil, err
+		}
+		found := make([]repositories.SearchHit, 0)
+		for result.Next(ctx) {
+			found = append(found, mapHit(result.Record()))
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		paged := repositories.SearchResults{Result: pagination.NewResult(found, page), Facets: repositories.NewSearchFacets()}
+		if err = nRepo.CountTotal(ctx, tx, page, &paged.Result, narrowed+`RETURN count(*) AS total`, params); err != nil {
+			return nil, err
+		}
+
+		facets, err := tx.Run(ctx, hits+`RETURN kind, serviceType, count(*) AS count`, params)
+		if err != nil {
+			return nil, err
+		}
+		for facets.Next(ctx) {
+			record := facets.Record()
+			count, _, _ := neo4j.GetRecordValue[int64](record, "count")
+			paged.Facets.Count(mapHit(record), count)
+		}
+		return paged, facets.Err()
+	}
+	found, err := r.manager.ExecuteRead(ctx, work)
+	if err != nil {
+		return repositories.SearchResults{}, err
+	}
+	return found.(repositories.SearchResults), nil
+}
+
+// mapHit reads the columns of a hit present in record, leaving the others empty.
+func mapHit(record *neo4j.Record) repositories.SearchHit {
+	text := func(key string) string {
+		value, _ := record.Get(key)
+		s, _ := value.(string)
+		return s
+	}
+	score, _ := record.Get("score")
+	hit := repositories.SearchHit{
+		Kind:        repositories.SearchKind(text("kind")),
+		Id:          text("id"),
+		Title:       text("title"),
+		Description: text("description"),
+		ServiceId:   text("serviceId"),
+		ServiceName: text("serviceName"),
+		ServiceType: text("serviceType"),
+	}
+	hit.Score, _ = score.(float64)
+	return hit
+}
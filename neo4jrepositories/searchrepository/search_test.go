@@ -0,0 +1,101 @@
+package searchrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"service-atlas/internal/pagination"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/debtrepository"
+	"service-atlas/neo4jrepositories/releaserepository"
+	"service-atlas/neo4jrepositories/servicerepository"
+	"service-atlas/neo4jrepositories/teamrepository"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jSearchRepository_SearchCatalog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	_, err = nRepo.NewMigrator(driver).Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Arrange: a service, team, debt item and release mentioning kafka
+	svcRepo := servicerepository.New(driver)
+	proxy, err := svcRepo.CreateService(ctx, repositories.Service{Name: "kafka-proxy", ServiceType: "api", Url: "https://kafka-proxy"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cart, err := svcRepo.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "worker", Url: "https://cart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = teamrepository.New(driver).CreateTeam(ctx, repositories.Team{Name: "kafka guild"}); err != nil {
+		t.Fatal(err)
+	}
+	err = debtrepository.New(driver).CreateDebtItem(ctx, repositories.Debt{ServiceId: cart, Type: "code", Title: "upgrade", Description: "move to the new kafka client", Status: "pending"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = releaserepository.New(driver).CreateRelease(ctx, repositories.Release{ServiceId: proxy, Version: "kafka-4", ReleaseDate: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := New(driver)
+
+	// Act
+	results, err := repo.SearchCatalog(ctx, repositories.SearchQuery{Text: "kafka"}, pagination.Page{Limit: 10, WithTotal: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if len(results.Items) != 4 || *results.TotalCount != 4 {
+		t.Fatalf("expected a hit of every kind, got %+v", results.Items)
+	}
+	for _, kind := range repositories.SearchKinds {
+		if results.Facets.Kinds[kind] != 1 {
+			t.Errorf("expected one %s hit, got facets %+v", kind, results.Facets)
+		}
+	}
+	if results.Facets.ServiceTypes["api"] != 2 || results.Facets.ServiceTypes["worker"] != 1 {
+		t.Errorf("unexpected service type facets %+v", results.Facets.ServiceTypes)
+	}
+
+	debt, err := repo.SearchCatalog(ctx, repositories.SearchQuery{Text: "kafka", Kinds: []repositories.SearchKind{repositories.KindDebt}}, pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(debt.Items) != 1 || debt.Items[0].ServiceName != "cart" || debt.Items[0].Score <= 0 {
+		t.Errorf("expected the debt item of cart, got %+v", debt.Items)
+	}
+
+	typed, err := repo.SearchCatalog(ctx, repositories.SearchQuery{Text: "kafka", ServiceType: "API"}, pagination.First(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(typed.Items) != 2 || typed.Facets.Kinds[repositories.KindTeam] != 1 {
+		t.Errorf("expected the service and its release, with facets over every hit, got %+v", typed)
+	}
+}
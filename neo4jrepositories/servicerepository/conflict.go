@@ -0,0 +1,45 @@
+package servicerepository
+
+import (
+	"context"
+	"service-atlas/internal/customerrors"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// conflictMessage is returned when a uniqueness constraint catches a duplicate written concurrently.
+const conflictMessage = "A service with this name or url already exists"
+
+// findConflict returns a 409 holding the other service with the name or repository url of service, if any.
+func findConflict(ctx context.Context, tx neo4j.ManagedTransaction, service repositories.Service) error {
+	result, err := tx.Run(ctx, `
+		MATCH (s:Service)
+		WHERE s.id <> $id AND (s.name = $name OR toLower(s.url) CONTAINS $needle)
+		RETURN s
+		ORDER BY s.created ASC
+	`, map[string]any{
+		"id":     service.Id,
+		"name":   service.Name,
+		"needle": repositoryPath(repositories.NormalizeRepositoryUrl(service.Url)),
+	})
+	if err != nil {
+		return err
+	}
+	for result.Next(ctx) {
+		node, ok := result.Record().Get("s")
+		if !ok {
+			continue
+		}
+		n, ok := node.(neo4j.Node)
+		if !ok {
+			continue
+		}
+		existing := nRepo.MapNodeToService(n)
+		if msg := service.Conflict(existing); msg != "" {
+			return customerrors.NewConflictError(msg, existing)
+		}
+	}
+	return result.Err()
+}
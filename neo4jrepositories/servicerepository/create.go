@@ -2,21 +2,31 @@ package servicerepository
 
 import (
 	"context"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"service-atlas/internal/events"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 func (d *Neo4jServiceRepository) CreateService(ctx context.Context, service repositories.Service) (id string, err error) {
 	createServiceTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := findConflict(ctx, tx, service); err != nil {
+			return "", err
+		}
 		result, err := tx.Run(
 			ctx, `
-        CREATE (n: Service {id: randomuuid(), created: datetime(), name: $name, type: $type, description: $description, url: $url})
+        CREATE (n: Service {id: randomuuid(), created: datetime(), name: $name, type: $type, description: $description, url: $url,
+            lifecycle: $lifecycle, tags: $tags})
         RETURN n.id AS id
         `, map[string]any{
 				"name":        service.Name,
 				"type":        service.ServiceType,
 				"description": service.Description,
 				"url":         service.Url,
+				"lifecycle":   service.Lifecycle,
+				"tags":        service.Tags,
 			})
 		if err != nil {
 			return "", err
@@ -28,7 +38,11 @@ func (d *Neo4jServiceRepository) CreateService(ctx context.Context, service repo
 		svcMap := svc.AsMap()
 		if svcId, ok := svcMap["id"]; ok {
 			if idStr, ok := svcId.(string); ok {
-				return idStr, err
+				service.Id = idStr
+				if err = outbox.Record(ctx, tx, events.ServiceCreated, events.AggregateService, idStr, service); err != nil {
+					return "", err
+				}
+				return idStr, nil
 			}
 		}
 		return "", err
@@ -36,7 +50,7 @@ func (d *Neo4jServiceRepository) CreateService(ctx context.Context, service repo
 	}
 	newId, insertErr := d.manager.ExecuteWrite(ctx, createServiceTransaction)
 	if insertErr != nil {
-		return "", insertErr
+		return "", nRepo.ConflictOnConstraint(insertErr, conflictMessage)
 	}
 	return newId.(string), nil
 }
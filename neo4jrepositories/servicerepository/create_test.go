@@ -2,9 +2,11 @@ package servicerepository
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"service-atlas/internal/customerrors"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
@@ -79,3 +81,45 @@ func TestNeo4jServiceRepository_CreateService_Success(t *testing.T) {
 		t.Fatalf("expected non-nil created, got %#v", created)
 	}
 }
+
+func TestNeo4jServiceRepository_CreateService_Conflict(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+	if _, err = nRepo.NewMigrator(driver).Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := New(driver)
+	id, err := repo.CreateService(ctx, repositories.Service{Name: "cart", ServiceType: "api", Url: "https://github.com/org/cart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, duplicate := range []repositories.Service{
+		{Name: "cart", ServiceType: "api", Url: "https://github.com/org/basket"},
+		{Name: "basket", ServiceType: "api", Url: "git@github.com:org/cart.git"},
+	} {
+		_, err = repo.CreateService(ctx, duplicate)
+		var conflict *customerrors.ConflictError
+		if !errors.As(err, &conflict) {
+			t.Fatalf("expected a conflict creating %+v, got %v", duplicate, err)
+		}
+		if existing, ok := conflict.Existing.(repositories.Service); !ok || existing.Id != id {
+			t.Errorf("expected the conflict to hold service %s, got %+v", id, conflict.Existing)
+		}
+	}
+}
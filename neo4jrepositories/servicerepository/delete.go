@@ -2,8 +2,11 @@ package servicerepository
 
 import (
 	"context"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 func (d *Neo4jServiceRepository) DeleteService(ctx context.Context, id string) (err error) {
@@ -27,8 +30,14 @@ func (d *Neo4jServiceRepository) DeleteService(ctx context.Context, id string) (
 				}
 			}
 		}
+		// debt and releases belong to the service, so they go with it rather than being left orphaned
 		result, err = tx.Run(ctx, `
-		MATCH(s:Service { id: $id})
+		MATCH (s:Service { id: $id })
+		OPTIONAL MATCH (s)-[:OWNS]->(d:Debt)
+		WITH s, collect(d) AS debt
+		OPTIONAL MATCH (s)-[:RELEASED]->(r:Release)
+		WITH s, debt + collect(r) AS owned
+		FOREACH (n IN owned | DETACH DELETE n)
 		DETACH DELETE s;`, map[string]interface{}{"id": id})
 		if err != nil {
 			return nil, err
@@ -42,7 +51,7 @@ func (d *Neo4jServiceRepository) DeleteService(ctx context.Context, id string) (
 		if summary.Counters().NodesDeleted() == 0 {
 			return nil, &customerrors.HTTPError{Status: 500, Msg: "Error deleting service: " + id}
 		}
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.ServiceDeleted, events.AggregateService, id, map[string]string{"id": id})
 	}
 
 	_, err = d.manager.ExecuteWrite(ctx, deleteServiceTransaction)
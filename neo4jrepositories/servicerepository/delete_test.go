@@ -44,6 +44,14 @@ func TestNeo4jServiceRepository_DeleteService_Success(t *testing.T) {
 	if err != nil {
 		t.Fatalf("CreateService error: %v", err)
 	}
+	write := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer func() { _ = write.Close(ctx) }()
+	if _, err = write.Run(ctx, `
+		MATCH (s:Service {id: $id})
+		CREATE (s)-[:OWNS]->(:Debt {id: randomuuid()}), (s)-[:RELEASED]->(:Release {version: '1.0'})
+	`, map[string]any{"id": createdID}); err != nil {
+		t.Fatalf("failed to create debt and release: %v", err)
+	}
 
 	// Act
 	if err := repo.DeleteService(ctx, createdID); err != nil {
@@ -65,6 +73,16 @@ func TestNeo4jServiceRepository_DeleteService_Success(t *testing.T) {
 	if cnt.(int64) != 0 {
 		t.Fatalf("expected node deleted, found count=%d", cnt.(int64))
 	}
+	res, err = read.Run(ctx, "MATCH (n) WHERE n:Debt OR n:Release RETURN count(n) AS cnt", nil)
+	if err != nil {
+		t.Fatalf("failed to verify delete: %v", err)
+	}
+	if rec, err = res.Single(ctx); err != nil {
+		t.Fatalf("expected single record: %v", err)
+	}
+	if cnt, _ = rec.Get("cnt"); cnt.(int64) != 0 {
+		t.Fatalf("expected the debt and release deleted with the service, found count=%d", cnt.(int64))
+	}
 }
 
 func TestNeo4jServiceRepository_DeleteService_NotFound(t *testing.T) {
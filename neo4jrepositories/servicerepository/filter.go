@@ -0,0 +1,85 @@
+package servicerepository
+
+import (
+	"strings"
+	"time"
+
+	"service-atlas/repositories"
+)
+
+// openDebtStatuses are the statuses of debt that still needs work, as counted in the reports.
+var openDebtStatuses = []string{"pending", "in_progress"}
+
+// serviceOrders holds the expression of s each sort orders services by. Queries only ever take an expression from
+// here, never from the filter itself, so they stay safe to build as text.
+var serviceOrders = map[repositories.ServiceSort]string{
+	repositories.SortByName:       "s.name",
+	repositories.SortByCreated:    "s.created",
+	repositories.SortByUpdated:    "coalesce(s.updated, s.created)",
+	repositories.SortByDependents: "COUNT { MATCH (dependent:Service)-[:DEPENDS_ON]->(s) RETURN DISTINCT dependent }",
+	repositories.SortByDebt:       "COUNT { MATCH (s)-[:OWNS]->(d:Debt) WHERE d.status IN $openDebtStatuses RETURN d }",
+}
+
+// filterWhere returns the WHERE clause keeping the services s that match filter, or an empty string when every
+// service does, with the parameters it refers to. Values are only ever passed as parameters.
+func filterWhere(filter repositories.ServiceFilter) (string, map[string]any) {
+	var conditions []string
+	params := map[string]any{"openDebtStatuses": openDebtStatuses}
+	if filter.Type != "" {
+		conditions = append(conditions, "toLower(s.type) = toLower($type)")
+		params["type"] = filter.Type
+	}
+	if filter.Team != "" {
+		conditions = append(conditions, "EXISTS { MATCH (t:Team)-[:OWNS]->(s) WHERE t.id = $team OR t.name = $team }")
+		params["team"] = filter.Team
+	}
+	if filter.Lifecycle != "" {
+		conditions = append(conditions, "s.lifecycle = toLower($lifecycle)")
+		params["lifecycle"] = filter.Lifecycle
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, "all(tag IN $tags WHERE tag IN coalesce(s.tags, []))")
+		params["tags"] = filter.Tags
+	}
+	if filter.HasOpenDebt != nil {
+		openDebt := "EXISTS { MATCH (s)-[:OWNS]->(d:Debt) WHERE d.status IN $openDebtStatuses }"
+		if !*filter.HasOpenDebt {
+			openDebt = "NOT " + openDebt
+		}
+		conditions = append(conditions, openDebt)
+	}
+	bounds := []struct {
+		name, expression, operator string
+		value                      time.Time
+	}{
+		{"createdAfter", "s.created", ">", filter.CreatedAfter},
+		{"createdBefore", "s.created", "<", filter.CreatedBefore},
+		{"updatedAfter", "coalesce(s.updated, s.created)", ">", filter.UpdatedAfter},
+		{"updatedBefore", "coalesce(s.updated, s.created)", "<", filter.UpdatedBefore},
+	}
+	for _, bound := range bounds {
+		if bound.value.IsZero() {
+			continue
+		}
+		conditions = append(conditions, bound.expression+" "+bound.operator+" $"+bound.name)
+		params[bound.name] = bound.value
+	}
+	if len(conditions) == 0 {
+		return "", params
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), params
+}
+
+// filterOrder returns the ORDER BY clause listing services s in the order of filter, ending with their id so pages
+// never overlap.
+func filterOrder(filter repositories.ServiceFilter) string {
+	sort, descending := filter.Order()
+	expression, ok := serviceOrders[sort]
+	if !ok {
+		expression = serviceOrders[repositories.SortByCreated]
+	}
+	if descending {
+		expression += " DESC"
+	}
+	return "ORDER BY " + expression + ", s.id"
+}
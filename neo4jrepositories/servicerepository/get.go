@@ -4,32 +4,31 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-func (d *Neo4jServiceRepository) GetAllServices(ctx context.Context, page int, pageSize int) (services []repositories.Service, err error) {
-	services = []repositories.Service{}
+func (d *Neo4jServiceRepository) GetAllServices(ctx context.Context, filter repositories.ServiceFilter, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	where, params := filterWhere(filter)
 	getPagedData := func(tx neo4j.ManagedTransaction) (any, error) {
-		skip := (page - 1) * pageSize
-
 		result, err := tx.Run(ctx, `
 		    MATCH (s:Service)
-			RETURN s
-			ORDER BY s.createdDate DESC
+			`+where+`
+			WITH s
+			`+filterOrder(filter)+`
 			SKIP $skip
 			LIMIT $limit
-		`, map[string]any{
-			"skip":  skip,
-			"limit": pageSize,
-		})
+			RETURN s
+		`, nRepo.PageParams(page, params))
 
 		if err != nil {
 			return nil, err
 		}
 
+		services := []repositories.Service{}
 		for result.Next(ctx) {
 			record := result.Record()
 			node, ok := record.Get("s")
@@ -45,13 +44,18 @@ func (d *Neo4jServiceRepository) GetAllServices(ctx context.Context, page int, p
 			svc := nRepo.MapNodeToService(n)
 			services = append(services, svc)
 		}
-		return services, nil
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		paged := pagination.NewResult(services, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `MATCH (s:Service) `+where+` RETURN count(s) AS total`, params)
+		return paged, err
 	}
-	_, readErr := d.manager.ExecuteRead(ctx, getPagedData)
+	paged, readErr := d.manager.ExecuteRead(ctx, getPagedData)
 	if readErr != nil {
-		return nil, readErr
+		return pagination.Result[repositories.Service]{}, readErr
 	}
-	return services, nil
+	return paged.(pagination.Result[repositories.Service]), nil
 }
 
 func (d *Neo4jServiceRepository) GetServiceById(ctx context.Context, id string) (svc repositories.Service, err error) {
@@ -98,28 +102,32 @@ func (d *Neo4jServiceRepository) GetServiceById(ctx context.Context, id string)
 	return service.(repositories.Service), nil
 }
 
-func (d *Neo4jServiceRepository) GetTeamsByServiceId(ctx context.Context, serviceId string) ([]repositories.Team, error) {
+func (d *Neo4jServiceRepository) GetTeamsByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[repositories.Team], error) {
 	//validate service exists
 	svc, err := d.GetServiceById(ctx, serviceId)
 	if err != nil {
-		return nil, err
+		return pagination.Result[repositories.Team]{}, err
 	}
 	if svc.Id == "" {
-		return nil, customerrors.HTTPError{
+		return pagination.Result[repositories.Team]{}, customerrors.HTTPError{
 			Status: 404,
 			Msg:    "Service not found",
 		}
 	}
-	teams := make([]repositories.Team, 0)
+	var teams pagination.Result[repositories.Team]
+	params := map[string]any{
+		"serviceId": serviceId,
+	}
 	work := func(tx neo4j.ManagedTransaction) (any, error) {
 		localTeams := make([]repositories.Team, 0)
 		result, err := tx.Run(ctx, `
 			MATCH (t:Team)-[r:OWNS]->(s:Service)
 			WHERE s.id = $serviceId
 			RETURN t
-		`, map[string]any{
-			"serviceId": serviceId,
-		})
+			ORDER BY t.name, t.id
+			SKIP $skip
+			LIMIT $limit
+		`, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -148,8 +156,14 @@ func (d *Neo4jServiceRepository) GetTeamsByServiceId(ctx context.Context, servic
 			}
 			localTeams = append(localTeams, t)
 		}
-		teams = localTeams
-		return nil, nil
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		teams = pagination.NewResult(localTeams, page)
+		return nil, nRepo.CountTotal(ctx, tx, page, &teams, `
+			MATCH (:Team)-[r:OWNS]->(:Service {id: $serviceId})
+			RETURN count(r) AS total
+		`, params)
 	}
 	_, err = d.manager.ExecuteRead(ctx, work)
 	return teams, err
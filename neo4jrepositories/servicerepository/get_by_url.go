@@ -0,0 +1,68 @@
+package servicerepository
+
+import (
+	"context"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+	"strings"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// GetServicesByUrl finds services whose url points at the same repository as repoUrl.
+// Candidates are narrowed in Cypher by the repository path and then compared exactly after normalization,
+// so https, ssh and clone urls of the same repository all match.
+func (d *Neo4jServiceRepository) GetServicesByUrl(ctx context.Context, repoUrl string) ([]repositories.Service, error) {
+	services := make([]repositories.Service, 0)
+	normalized := repositories.NormalizeRepositoryUrl(repoUrl)
+	if normalized == "" {
+		return services, nil
+	}
+	needle := repositoryPath(normalized)
+	work := func(tx neo4j.ManagedTransaction) (any, error) {
+		localServices := make([]repositories.Service, 0)
+		result, err := tx.Run(ctx, `
+			MATCH (s:Service)
+			WHERE toLower(s.url) CONTAINS $needle
+			RETURN s
+			ORDER BY s.created ASC
+		`, map[string]any{
+			"needle": needle,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for result.Next(ctx) {
+			node, ok := result.Record().Get("s")
+			if !ok {
+				continue
+			}
+			n, ok := node.(neo4j.Node)
+			if !ok {
+				continue
+			}
+			svc := nRepo.MapNodeToService(n)
+			if repositories.NormalizeRepositoryUrl(svc.Url) == normalized {
+				localServices = append(localServices, svc)
+			}
+		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		services = localServices
+		return nil, nil
+	}
+	if _, err := d.manager.ExecuteRead(ctx, work); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// repositoryPath is the path of a normalized repository url, which narrows down the services that may match it as
+// the host may be separated by ':' in ssh urls.
+func repositoryPath(normalized string) string {
+	if idx := strings.Index(normalized, "/"); idx >= 0 {
+		return normalized[idx+1:]
+	}
+	return normalized
+}
@@ -0,0 +1,68 @@
+package servicerepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestNeo4jServiceRepository_GetServicesByUrl(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	repo := New(driver)
+	id, err := repo.CreateService(ctx, repositories.Service{
+		Name:        "cart",
+		ServiceType: "api",
+		Url:         "https://github.com/shop/cart",
+	})
+	if err != nil {
+		t.Fatalf("CreateService error: %v", err)
+	}
+	// same path on another host must not match
+	if _, err = repo.CreateService(ctx, repositories.Service{
+		Name:        "cart mirror",
+		ServiceType: "api",
+		Url:         "https://gitlab.com/shop/cart",
+	}); err != nil {
+		t.Fatalf("CreateService error: %v", err)
+	}
+
+	for _, url := range []string{"https://github.com/shop/cart.git", "git@github.com:shop/cart.git", "HTTPS://GITHUB.COM/shop/cart/"} {
+		services, err := repo.GetServicesByUrl(ctx, url)
+		if err != nil {
+			t.Fatalf("GetServicesByUrl(%q) error: %v", url, err)
+		}
+		if len(services) != 1 || services[0].Id != id {
+			t.Errorf("GetServicesByUrl(%q) = %+v, want service %s", url, services, id)
+		}
+	}
+
+	services, err := repo.GetServicesByUrl(ctx, "https://github.com/shop/orders")
+	if err != nil {
+		t.Fatalf("GetServicesByUrl error: %v", err)
+	}
+	if len(services) != 0 {
+		t.Errorf("expected no services, got %+v", services)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"service-atlas/internal/pagination"
 	nRepo "service-atlas/neo4jrepositories"
 	teamrepo "service-atlas/neo4jrepositories/teamrepository"
 	"service-atlas/repositories"
@@ -57,10 +58,11 @@ func TestNeo4jServiceRepository_GetTeamsByServiceId_Success(t *testing.T) {
 	}
 
 	// Act
-	teams, err := svcRepo.GetTeamsByServiceId(ctx, serviceID)
+	result, err := svcRepo.GetTeamsByServiceId(ctx, serviceID, pagination.First(10))
 	if err != nil {
 		t.Fatalf("GetTeamsByServiceId error: %v", err)
 	}
+	teams := result.Items
 
 	// Assert
 	if len(teams) != 1 {
@@ -93,11 +95,11 @@ func TestNeo4jServiceRepository_GetTeamsByServiceId_NotFound(t *testing.T) {
 	svcRepo := New(driver)
 
 	// Act: use a random/non-existent id
-	teams, err := svcRepo.GetTeamsByServiceId(ctx, "00000000-0000-0000-0000-000000000000")
+	result, err := svcRepo.GetTeamsByServiceId(ctx, "00000000-0000-0000-0000-000000000000", pagination.First(10))
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if len(teams) != 0 {
+	if teams := result.Items; len(teams) != 0 {
 		t.Fatalf("expected 0 teams, got %d", len(teams))
 	}
 }
@@ -2,10 +2,15 @@ package servicerepository
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
+	"service-atlas/internal/pagination"
 	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/debtrepository"
+	"service-atlas/neo4jrepositories/dependencyrepository"
+	teamrepo "service-atlas/neo4jrepositories/teamrepository"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -88,3 +93,98 @@ func TestNeo4jServiceRepository_GetServiceById_NotFound(t *testing.T) {
 		t.Fatalf("expected zero-value service when not found, got: %+v", svc)
 	}
 }
+
+func TestNeo4jServiceRepository_GetAllServices_Filtered(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	tc, err := nRepo.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tc.Container.Terminate(ctx) })
+
+	driver, err := neo4j.NewDriverWithContext(tc.Endpoint, neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = driver.Close(ctx) }()
+
+	repo := New(driver)
+	tRepo := teamrepo.New(driver)
+	depRepo := dependencyrepository.New(driver)
+	debtRepo := debtrepository.New(driver)
+
+	// Arrange: three services, two owned by a team, cart tagged and most depended on, orders with the most open debt
+	ids := make(map[string]string)
+	for _, svc := range []repositories.Service{
+		{Name: "cart", ServiceType: "api", Url: "https://cart", Lifecycle: "active", Tags: []string{"kafka", "pci"}},
+		{Name: "orders", ServiceType: "api", Url: "https://orders", Lifecycle: "active"},
+		{Name: "users", ServiceType: "worker", Url: "https://users", Lifecycle: "retired"},
+	} {
+		id, err := repo.CreateService(ctx, svc)
+		if err != nil {
+			t.Fatalf("CreateService error: %v", err)
+		}
+		ids[svc.Name] = id
+	}
+	teamID, err := tRepo.CreateTeam(ctx, repositories.Team{Name: "shop"})
+	if err != nil {
+		t.Fatalf("CreateTeam error: %v", err)
+	}
+	for _, name := range []string{"cart", "orders"} {
+		if err := tRepo.CreateTeamAssociation(ctx, teamID, ids[name]); err != nil {
+			t.Fatalf("CreateTeamAssociation error: %v", err)
+		}
+	}
+	for _, d := range [][2]string{{"orders", "cart"}, {"users", "cart"}, {"users", "orders"}} {
+		if err := depRepo.AddDependency(ctx, ids[d[0]], repositories.Dependency{Id: ids[d[1]]}); err != nil {
+			t.Fatalf("AddDependency error: %v", err)
+		}
+	}
+	for _, name := range []string{"orders", "orders", "cart"} {
+		if err := debtRepo.CreateDebtItem(ctx, repositories.Debt{ServiceId: ids[name], Type: "code", Title: "tests", Status: "pending"}); err != nil {
+			t.Fatalf("CreateDebtItem error: %v", err)
+		}
+	}
+
+	yes, no := true, false
+	tests := []struct {
+		name   string
+		filter repositories.ServiceFilter
+		want   []string
+	}{
+		{"NewestFirst", repositories.ServiceFilter{}, []string{"users", "orders", "cart"}},
+		{"TeamByName", repositories.ServiceFilter{Team: "shop"}, []string{"orders", "cart"}},
+		{"TeamById", repositories.ServiceFilter{Team: teamID}, []string{"orders", "cart"}},
+		{"Lifecycle", repositories.ServiceFilter{Lifecycle: "retired"}, []string{"users"}},
+		{"Tags", repositories.ServiceFilter{Tags: []string{"pci", "kafka"}}, []string{"cart"}},
+		{"Type", repositories.ServiceFilter{Type: "API", Sort: repositories.SortByName}, []string{"cart", "orders"}},
+		{"OpenDebt", repositories.ServiceFilter{HasOpenDebt: &yes, Sort: repositories.SortByName}, []string{"cart", "orders"}},
+		{"NoOpenDebt", repositories.ServiceFilter{HasOpenDebt: &no}, []string{"users"}},
+		{"CreatedBefore", repositories.ServiceFilter{CreatedBefore: time.Now().Add(-time.Hour)}, []string{}},
+		{"ByDependents", repositories.ServiceFilter{Sort: repositories.SortByDependents, Descending: true}, []string{"cart", "orders", "users"}},
+		{"ByDebt", repositories.ServiceFilter{Sort: repositories.SortByDebt, Descending: true}, []string{"orders", "cart", "users"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			result, err := repo.GetAllServices(ctx, tt.filter, pagination.Page{Limit: 10, WithTotal: true})
+			if err != nil {
+				t.Fatalf("GetAllServices error: %v", err)
+			}
+
+			// Assert
+			got := make([]string, 0)
+			for _, svc := range result.Items {
+				got = append(got, svc.Name)
+			}
+			if !slices.Equal(got, tt.want) || *result.TotalCount != int64(len(tt.want)) {
+				t.Fatalf("got %v of %d, want %v", got, *result.TotalCount, tt.want)
+			}
+		})
+	}
+}
@@ -2,34 +2,71 @@ package servicerepository
 
 import (
 	"context"
+	"fmt"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
+	"strings"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
-// Search performs a fuzzy search against the Service full-text index
-// and returns matching services ordered by relevance.
-func (d *Neo4jServiceRepository) Search(ctx context.Context, query string) ([]repositories.Service, error) {
-	services := make([]repositories.Service, 0)
-	if query == "" {
-		return services, nil
+// queryConditions holds the condition on s each field of a query filters by, with $value standing for the value.
+var queryConditions = map[searchquery.Field]string{
+	searchquery.FieldType:      "toLower(s.type) = toLower($value)",
+	searchquery.FieldTeam:      "EXISTS { MATCH (t:Team)-[:OWNS]->(s) WHERE t.id = $value OR toLower(t.name) = toLower($value) }",
+	searchquery.FieldName:      "toLower(s.name) CONTAINS toLower($value)",
+	searchquery.FieldLifecycle: "s.lifecycle = toLower($value)",
+	searchquery.FieldTag:       "toLower($value) IN coalesce(s.tags, [])",
+}
+
+// queryWhere returns the WHERE clause keeping the services s that match the filters of query, or an empty string when
+// it has none, with the parameters it refers to.
+func queryWhere(query searchquery.Query) (string, map[string]any) {
+	conditions := make([]string, 0, len(query.Filters))
+	params := make(map[string]any, len(query.Filters))
+	for i, filter := range query.Filters {
+		condition := "NOT EXISTS { MATCH (:Team)-[:OWNS]->(s) }"
+		if !filter.Unowned() {
+			name := fmt.Sprintf("f%d", i)
+			condition = strings.ReplaceAll(queryConditions[filter.Field], "$value", "$"+name)
+			params[name] = filter.Value
+		}
+		if filter.Negated {
+			condition = "NOT (" + condition + ")"
+		}
+		conditions = append(conditions, condition)
+	}
+	if len(conditions) == 0 {
+		return "", params
 	}
-	if string(query[len(query)-1]) != "~" {
-		query += "~"
+	return "WHERE " + strings.Join(conditions, " AND "), params
+}
+
+// Search returns a page of the services matching query. Its terms are matched against the Service full-text index,
+// ordering services by relevance, and its filters against their properties. A query of filters alone lists the
+// services by name.
+func (d *Neo4jServiceRepository) Search(ctx context.Context, query searchquery.Query, page pagination.Page) (pagination.Result[repositories.Service], error) {
+	if query.IsEmpty() {
+		return pagination.Slice([]repositories.Service{}, page), nil
+	}
+	where, params := queryWhere(query)
+	match, order := "MATCH (s:Service)", "ORDER BY s.name, s.id"
+	if text := query.Lucene(); text != "" {
+		match, order = "CALL db.index.fulltext.queryNodes($indexName, $q) YIELD node AS s, score", "ORDER BY score DESC, s.id"
+		params["indexName"] = nRepo.ServiceFulltextIndexName
+		params["q"] = text
 	}
 	work := func(tx neo4j.ManagedTransaction) (any, error) {
 		localServices := make([]repositories.Service, 0)
-		result, err := tx.Run(ctx, `
-            CALL db.index.fulltext.queryNodes($indexName, $q)
-            YIELD node, score
-            RETURN node AS s, score
-            ORDER BY score DESC
-            LIMIT 50
-        `, map[string]any{
-			"indexName": nRepo.ServiceFulltextIndexName,
-			"q":         query,
-		})
+		result, err := tx.Run(ctx, match+`
+            `+where+`
+            RETURN s
+            `+order+`
+            SKIP $skip
+            LIMIT $limit
+        `, nRepo.PageParams(page, params))
 		if err != nil {
 			return nil, err
 		}
@@ -46,12 +83,17 @@ func (d *Neo4jServiceRepository) Search(ctx context.Context, query string) ([]re
 			}
 			localServices = append(localServices, nRepo.MapNodeToService(n))
 		}
-		services = localServices
-		return nil, nil
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
+		paged := pagination.NewResult(localServices, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, match+` `+where+` RETURN count(s) AS total`, params)
+		return paged, err
 	}
 
-	if _, err := d.manager.ExecuteRead(ctx, work); err != nil {
-		return nil, err
+	paged, err := d.manager.ExecuteRead(ctx, work)
+	if err != nil {
+		return pagination.Result[repositories.Service]{}, err
 	}
-	return services, nil
+	return paged.(pagination.Result[repositories.Service]), nil
 }
@@ -2,8 +2,11 @@ package servicerepository
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
+	"slices"
 	"testing"
 	"time"
 
@@ -30,7 +33,7 @@ func TestNeo4jServiceRepository_Search(t *testing.T) {
 	}
 	defer func() { _ = driver.Close(ctx) }()
 
-	err = nRepo.Startup(ctx, driver)
+	_, err = nRepo.NewMigrator(driver).Up(ctx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -45,14 +48,52 @@ func TestNeo4jServiceRepository_Search(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	services, err := repo.Search(ctx, "find")
+	servicesResult, err := repo.Search(ctx, searchquery.Text("find"), pagination.First(10))
 	if err != nil {
 		t.Fatal(err)
 	}
+	services := servicesResult.Items
 	if len(services) != 1 {
 		t.Fatal("expected 1 service, got", len(services))
 	}
 	if services[0].Id != id {
 		t.Error("expected service with id", id, "got", services[0].Id)
 	}
+
+	if _, err = repo.CreateService(ctx, repositories.Service{
+		Name:        "find that (retired) test",
+		ServiceType: "grpc",
+		Url:         "https://svc-2",
+		Lifecycle:   "retired",
+		Tags:        []string{"pci"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"find -lifecycle:retired", []string{"find this test"}},
+		{"owner:none type:GRPC", []string{"find that (retired) test"}},
+		{`name:"(retired)" tag:PCI`, []string{"find that (retired) test"}},
+		{`"find that (retired"`, []string{"find that (retired) test"}},
+		{"-owner:none", nil},
+	}
+	for _, tc := range tests {
+		query, err := searchquery.Parse(tc.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := repo.Search(ctx, query, pagination.First(10))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.query, err)
+		}
+		var names []string
+		for _, service := range result.Items {
+			names = append(names, service.Name)
+		}
+		if !slices.Equal(names, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.query, names, tc.want)
+		}
+	}
 }
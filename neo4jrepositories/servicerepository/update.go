@@ -3,9 +3,13 @@ package servicerepository
 import (
 	"context"
 	"errors"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 func (d *Neo4jServiceRepository) UpdateService(ctx context.Context, service repositories.Service) (err error) {
@@ -30,6 +34,9 @@ func (d *Neo4jServiceRepository) UpdateService(ctx context.Context, service repo
 				Msg:    "Service not found",
 			}
 		}
+		if err = findConflict(ctx, tx, service); err != nil {
+			return nil, err
+		}
 		// Service exists, update it
 		updateResult, updateErr := tx.Run(ctx, `
 			MATCH (s:Service)
@@ -38,6 +45,8 @@ func (d *Neo4jServiceRepository) UpdateService(ctx context.Context, service repo
 				s.type = $type, 
 				s.description = $description,
 				s.url = $url,
+				s.lifecycle = $lifecycle,
+				s.tags = $tags,
 				s.updated = datetime()
 			RETURN s
 		`, map[string]any{
@@ -46,23 +55,25 @@ func (d *Neo4jServiceRepository) UpdateService(ctx context.Context, service repo
 			"type":        service.ServiceType,
 			"description": service.Description,
 			"url":         service.Url,
+			"lifecycle":   service.Lifecycle,
+			"tags":        service.Tags,
 		})
 
 		if updateErr != nil {
-			err = updateErr
+			return nil, updateErr
 		}
 
 		// Confirm update was successful
 		if !updateResult.Next(ctx) {
-			err = errors.New("update Service failed")
+			return nil, errors.New("update Service failed")
 		}
 
-		return nil, err
+		return nil, outbox.Record(ctx, tx, events.ServiceUpdated, events.AggregateService, service.Id, service)
 	}
 
 	_, execErr := d.manager.ExecuteWrite(ctx, updateServiceTransaction)
 	if execErr != nil {
-		return execErr
+		return nRepo.ConflictOnConstraint(execErr, conflictMessage)
 	}
 
 	return nil
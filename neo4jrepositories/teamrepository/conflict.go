@@ -0,0 +1,36 @@
+package teamrepository
+
+import (
+	"context"
+	"service-atlas/internal/customerrors"
+	nRepo "service-atlas/neo4jrepositories"
+	"service-atlas/repositories"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// findConflict returns a 409 holding the other team named like team, if any.
+func findConflict(ctx context.Context, tx neo4j.ManagedTransaction, team repositories.Team) error {
+	result, err := tx.Run(ctx, `
+		MATCH (t:Team {name: $name})
+		WHERE t.id <> $id
+		RETURN t
+		LIMIT 1
+	`, map[string]any{"id": team.Id, "name": team.Name})
+	if err != nil {
+		return err
+	}
+	if result.Next(ctx) {
+		node, _ := result.Record().Get("t")
+		if n, ok := node.(neo4j.Node); ok {
+			existing, _ := nRepo.MapNodeToTeam(n)
+			return customerrors.NewConflictError("A team named "+team.Name+" already exists", existing)
+		}
+	}
+	return result.Err()
+}
+
+// conflictOnConstraint is a 409 for a team named like another written concurrently.
+func conflictOnConstraint(err error, team repositories.Team) error {
+	return nRepo.ConflictOnConstraint(err, "A team named "+team.Name+" already exists")
+}
@@ -2,8 +2,11 @@ package teamrepository
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 	"service-atlas/repositories"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
@@ -11,6 +14,9 @@ import (
 
 func (r Neo4jTeamRepository) CreateTeam(ctx context.Context, team repositories.Team) (string, error) {
 	createTeamTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := findConflict(ctx, tx, team); err != nil {
+			return nil, err
+		}
 		result, err := tx.Run(
 			ctx, `
         CREATE (n: Team {id: randomuuid(), created: datetime(), updated: datetime(), name: $name})
@@ -30,8 +36,15 @@ func (r Neo4jTeamRepository) CreateTeam(ctx context.Context, team repositories.T
 					Msg:    "Id not returned when creating team",
 				}
 			}
+			team.Id, _ = id.(string)
+			if err = outbox.Record(ctx, tx, events.TeamCreated, events.AggregateTeam, team.Id, team); err != nil {
+				return nil, err
+			}
 			return id, nil
 		}
+		if err = result.Err(); err != nil {
+			return nil, err
+		}
 		return nil, &customerrors.HTTPError{
 			Status: http.StatusInternalServerError,
 			Msg:    "No id returned from creating team",
@@ -39,6 +52,10 @@ func (r Neo4jTeamRepository) CreateTeam(ctx context.Context, team repositories.T
 
 	}
 	result, err := r.manager.ExecuteWrite(ctx, createTeamTransaction)
+	var httpErr *customerrors.HTTPError
+	if err = conflictOnConstraint(err, team); errors.As(err, &httpErr) {
+		return "", err
+	}
 	if err != nil {
 		return "", &customerrors.HTTPError{
 			Status: http.StatusInternalServerError,
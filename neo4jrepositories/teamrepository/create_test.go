@@ -2,6 +2,8 @@ package teamrepository
 
 import (
 	"context"
+	"errors"
+	"service-atlas/internal/customerrors"
 	"service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 	"testing"
@@ -109,3 +111,40 @@ func TestNeo4jTeamRepository_CreateTeam(t *testing.T) {
 		t.Errorf("expected 'created' between %s and %s, got %s", now, now.Add(10*time.Second), createdTime)
 	}
 }
+
+func TestNeo4jTeamRepository_CreateTeam_Conflict(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode.")
+	}
+	ctx := context.Background()
+	tc, err := neo4jrepositories.NewTestContainerHelper(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = tc.Container.Terminate(ctx)
+	})
+
+	driver, err := neo4j.NewDriverWithContext(
+		tc.Endpoint,
+		neo4j.BasicAuth("neo4j", "letmein!", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = driver.Close(ctx)
+	}()
+	repo := New(driver)
+	id, err := repo.CreateTeam(ctx, repositories.Team{Name: "payments"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.CreateTeam(ctx, repositories.Team{Name: "payments"})
+	var conflict *customerrors.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	if existing, ok := conflict.Existing.(repositories.Team); !ok || existing.Id != id {
+		t.Errorf("expected the conflict to hold team %s, got %+v", id, conflict.Existing)
+	}
+}
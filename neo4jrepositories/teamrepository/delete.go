@@ -3,6 +3,8 @@ package teamrepository
 import (
 	"context"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
@@ -41,7 +43,7 @@ func (r Neo4jTeamRepository) DeleteTeam(ctx context.Context, id string) error {
 		if deletedCount == 0 {
 			return nil, &customerrors.HTTPError{Status: 404, Msg: "Team not found"}
 		}
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.TeamDeleted, events.AggregateTeam, id, map[string]string{"id": id})
 	}
 	_, err := r.manager.ExecuteWrite(ctx, deleteTeamTransaction)
 	return err
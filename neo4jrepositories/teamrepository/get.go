@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/pagination"
 	nRepo "service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 
@@ -80,20 +81,15 @@ func (r Neo4jTeamRepository) GetTeam(ctx context.Context, teamId string) (*repos
 
 }
 
-func (r Neo4jTeamRepository) GetTeams(ctx context.Context, page, pageSize int) ([]repositories.Team, error) {
+func (r Neo4jTeamRepository) GetTeams(ctx context.Context, page pagination.Page) (pagination.Result[repositories.Team], error) {
 	getPageTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
-		skip := (page - 1) * pageSize
-
 		result, err := tx.Run(ctx, `
 		    MATCH (s:Team)
 			RETURN s
-			ORDER BY s.created DESC
+			ORDER BY s.created DESC, s.id
 			SKIP $skip
 			LIMIT $limit
-		`, map[string]any{
-			"skip":  skip,
-			"limit": pageSize,
-		})
+		`, nRepo.PageParams(page, nil))
 		if err != nil {
 			return nil, customerrors.HTTPError{
 				Status: http.StatusInternalServerError,
@@ -123,18 +119,20 @@ func (r Neo4jTeamRepository) GetTeams(ctx context.Context, page, pageSize int) (
 			}
 			teams = append(teams, team)
 		}
-		return teams, nil
+		paged := pagination.NewResult(teams, page)
+		err = nRepo.CountTotal(ctx, tx, page, &paged, `MATCH (s:Team) RETURN count(s) AS total`, nil)
+		return paged, err
 	}
 	pagedResult, err := r.manager.ExecuteRead(ctx, getPageTransaction)
 	if err != nil {
-		return nil, customerrors.HTTPError{
+		return pagination.Result[repositories.Team]{}, customerrors.HTTPError{
 			Status: http.StatusInternalServerError,
 			Msg:    err.Error(),
 		}
 	}
-	teams, ok := pagedResult.([]repositories.Team)
+	teams, ok := pagedResult.(pagination.Result[repositories.Team])
 	if !ok {
-		return nil, customerrors.HTTPError{
+		return pagination.Result[repositories.Team]{}, customerrors.HTTPError{
 			Status: http.StatusInternalServerError,
 			Msg:    "unexpected return type from transaction",
 		}
@@ -2,6 +2,7 @@ package teamrepository
 
 import (
 	"context"
+	"service-atlas/internal/pagination"
 	"service-atlas/neo4jrepositories"
 	"service-atlas/repositories"
 	"testing"
@@ -130,10 +131,17 @@ func TestNeo4jTeamRepository_GetTeams(t *testing.T) {
 	}
 
 	// Page 1, size 2 -> expect last two created: 3, 2
-	page1, err := repo.GetTeams(ctx, 1, 2)
+	page1Result, err := repo.GetTeams(ctx, pagination.Page{Limit: 2, WithTotal: true})
 	if err != nil {
 		t.Fatalf("GetTeams page1 returned error: %v", err)
 	}
+	page1 := page1Result.Items
+	if page1Result.NextCursor != pagination.EncodeCursor(2) {
+		t.Errorf("expected a cursor to the second page, got %q", page1Result.NextCursor)
+	}
+	if page1Result.TotalCount == nil || *page1Result.TotalCount != 3 {
+		t.Errorf("expected a total of 3, got %v", page1Result.TotalCount)
+	}
 	if len(page1) != 2 {
 		t.Fatalf("expected 2 teams on page1, got %d", len(page1))
 	}
@@ -148,10 +156,14 @@ func TestNeo4jTeamRepository_GetTeams(t *testing.T) {
 	}
 
 	// Page 2, size 2 -> expect the remaining oldest: 1
-	page2, err := repo.GetTeams(ctx, 2, 2)
+	page2Result, err := repo.GetTeams(ctx, pagination.FromPage(2, 2))
 	if err != nil {
 		t.Fatalf("GetTeams page2 returned error: %v", err)
 	}
+	page2 := page2Result.Items
+	if page2Result.NextCursor != "" || page2Result.TotalCount != nil {
+		t.Errorf("expected the last page without a cursor or total, got %+v", page2Result)
+	}
 	if len(page2) != 1 {
 		t.Fatalf("expected 1 team on page2, got %d", len(page2))
 	}
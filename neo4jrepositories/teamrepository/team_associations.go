@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
@@ -37,7 +39,10 @@ func (r Neo4jTeamRepository) CreateTeamAssociation(ctx context.Context, teamId,
 			}
 		}
 
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.TeamAssociationCreated, events.AggregateTeam, teamId, map[string]string{
+			"teamId":    teamId,
+			"serviceId": serviceId,
+		})
 	}
 	_, err := r.manager.ExecuteWrite(ctx, createTeamAssociationTransaction)
 	if err != nil {
@@ -75,7 +80,14 @@ func (r Neo4jTeamRepository) DeleteTeamAssociation(ctx context.Context, teamId,
 				Msg:    "Failed to delete team association",
 			}
 		}
-		return nil, nil
+		// count(r) always yields a row, so only record an event when a relationship was removed
+		if deleted, _ := result.Record().Get("deleted"); deleted == int64(0) {
+			return nil, nil
+		}
+		return nil, outbox.Record(ctx, tx, events.TeamAssociationDeleted, events.AggregateTeam, teamId, map[string]string{
+			"teamId":    teamId,
+			"serviceId": serviceId,
+		})
 	}
 	_, err := r.manager.ExecuteWrite(ctx, deleteTeamAssociationTransaction)
 	if err != nil {
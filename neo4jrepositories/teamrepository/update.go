@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"service-atlas/internal/customerrors"
+	"service-atlas/internal/events"
+	"service-atlas/neo4jrepositories/outbox"
 
 	"service-atlas/repositories"
 
@@ -18,6 +20,9 @@ func (r Neo4jTeamRepository) UpdateTeam(ctx context.Context, team repositories.T
 	}
 
 	updateTeamTransaction := func(tx neo4j.ManagedTransaction) (any, error) {
+		if err := findConflict(ctx, tx, team); err != nil {
+			return nil, err
+		}
 		updateResult, updateErr := tx.Run(ctx, `
 			MATCH (s:Team)
 			WHERE s.id = $id
@@ -30,31 +35,25 @@ func (r Neo4jTeamRepository) UpdateTeam(ctx context.Context, team repositories.T
 		})
 
 		if updateErr != nil {
-			return nil, customerrors.HTTPError{
-				Status: http.StatusInternalServerError,
-				Msg:    updateErr.Error(),
-			}
+			return nil, updateErr
 		}
 
 		// Confirm update was successful
 		if !updateResult.Next(ctx) {
 			if resultErr := updateResult.Err(); resultErr != nil {
-				return nil, customerrors.HTTPError{
-					Status: http.StatusInternalServerError,
-					Msg:    resultErr.Error(),
-				}
+				return nil, resultErr
 			}
 			return nil, customerrors.HTTPError{
 				Status: http.StatusInternalServerError,
 				Msg:    "Failed to confirm update",
 			}
 		}
-		return nil, nil
+		return nil, outbox.Record(ctx, tx, events.TeamUpdated, events.AggregateTeam, team.Id, team)
 	}
 
 	_, err = r.manager.ExecuteWrite(ctx, updateTeamTransaction)
 	if err != nil {
-		return err
+		return conflictOnConstraint(err, team)
 	}
 	return nil
 }
@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// ApiKey is a credential for the API. Hash is the SHA-256 of the key, which is only shown once, when it is created.
+type ApiKey struct {
+	Id      string    `json:"id"`
+	Name    string    `json:"name"`
+	Scopes  []string  `json:"scopes"`
+	Created time.Time `json:"created"`
+	Hash    string    `json:"-"`
+}
+
+// ValidScopes are the scopes an API key can be granted.
+var ValidScopes = []string{"read", "write:services", "write:debt", "admin"}
+
+func (k *ApiKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("api key name is required")
+	}
+	if len(k.Scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+	for _, scope := range k.Scopes {
+		if !slices.Contains(ValidScopes, scope) {
+			return errors.New("invalid scope: " + scope)
+		}
+	}
+	return nil
+}
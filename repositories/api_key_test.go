@@ -0,0 +1,31 @@
+package repositories
+
+import "testing"
+
+func TestApiKey_ValidateSuccess(t *testing.T) {
+	key := ApiKey{Name: "ci", Scopes: []string{"read", "write:debt"}}
+	if err := key.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApiKey_ValidateFailNoName(t *testing.T) {
+	key := ApiKey{Scopes: []string{"read"}}
+	if err := key.Validate(); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestApiKey_ValidateFailNoScopes(t *testing.T) {
+	key := ApiKey{Name: "ci"}
+	if err := key.Validate(); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestApiKey_ValidateFailUnknownScope(t *testing.T) {
+	key := ApiKey{Name: "ci", Scopes: []string{"read", "write:everything"}}
+	if err := key.Validate(); err == nil {
+		t.Error("Expected error")
+	}
+}
@@ -3,6 +3,9 @@ package repositories
 import (
 	"context"
 	"time"
+
+	"service-atlas/internal/pagination"
+	"service-atlas/internal/searchquery"
 )
 
 // DebtRepository defines the methods for interacting with debt items.
@@ -11,14 +14,16 @@ type DebtRepository interface {
 	CreateDebtItem(ctx context.Context, debt Debt) error
 	// UpdateStatus updates the status of an existing debt item.
 	UpdateStatus(ctx context.Context, id, status string) error
-	// GetDebtByServiceId retrieves debt items for a given service.
-	GetDebtByServiceId(ctx context.Context, id string, page, pageSize int, onlyResolved bool) ([]Debt, error)
+	// GetDebtById retrieves a debt item, with the id of the service it belongs to.
+	GetDebtById(ctx context.Context, id string) (Debt, error)
+	// GetDebtByServiceId retrieves a page of the debt items of a service.
+	GetDebtByServiceId(ctx context.Context, id string, page pagination.Page, onlyResolved bool) (pagination.Result[Debt], error)
 }
 
 // ServiceRepository defines the methods for interacting with services.
 type ServiceRepository interface {
-	// GetAllServices retrieves all services.
-	GetAllServices(ctx context.Context, page int, pageSize int) ([]Service, error)
+	// GetAllServices retrieves a page of the services matching filter, in its order.
+	GetAllServices(ctx context.Context, filter ServiceFilter, page pagination.Page) (pagination.Result[Service], error)
 	// CreateService creates a new service.
 	CreateService(ctx context.Context, service Service) (string, error)
 	// UpdateService updates an existing service.
@@ -27,20 +32,23 @@ type ServiceRepository interface {
 	DeleteService(ctx context.Context, id string) error
 	// GetServiceById retrieves a service by its ID.
 	GetServiceById(ctx context.Context, id string) (Service, error)
-	// Search performs a fuzzy search against the Service full-text index and returns matching services ordered by relevance.
-	Search(ctx context.Context, query string) ([]Service, error)
-	// GetTeamsByServiceId retrieves all teams associated with a service.
-	GetTeamsByServiceId(ctx context.Context, serviceId string) ([]Team, error)
+	// Search returns a page of the services matching the terms and filters of query, ordered by relevance.
+	Search(ctx context.Context, query searchquery.Query, page pagination.Page) (pagination.Result[Service], error)
+	// GetTeamsByServiceId retrieves a page of the teams owning a service, by name.
+	GetTeamsByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[Team], error)
+	// GetServicesByUrl retrieves all services whose url matches the repository url once both are normalized.
+	GetServicesByUrl(ctx context.Context, repoUrl string) ([]Service, error)
 }
 
 // DependencyRepository defines the methods for interacting with dependencies.
 type DependencyRepository interface {
 	// AddDependency adds a dependency to a resource.
 	AddDependency(ctx context.Context, id string, dependency Dependency) error
-	// GetDependencies retrieves all dependencies of a resource.
-	GetDependencies(ctx context.Context, id string) ([]*Dependency, error)
-	// GetDependents retrieves all resources that depend on a given resource.
-	GetDependents(ctx context.Context, id string) ([]*Dependency, error)
+	// GetDependencies retrieves a page of the dependencies of a resource, by name.
+	GetDependencies(ctx context.Context, id string, page pagination.Page) (pagination.Result[*Dependency], error)
+	// GetDependents retrieves a page of the resources that depend on a given resource, by name, only those depending
+	// on version unless it is empty.
+	GetDependents(ctx context.Context, id string, version string, page pagination.Page) (pagination.Result[*Dependency], error)
 	// DeleteDependency deletes a dependency between two resources.
 	DeleteDependency(ctx context.Context, id string, dependsOnID string) error
 }
@@ -49,20 +57,24 @@ type DependencyRepository interface {
 type ReleaseRepository interface {
 	// CreateRelease creates a new release.
 	CreateRelease(ctx context.Context, release Release) error
-	// GetReleasesByServiceId retrieves all releases associated with a service.
-	GetReleasesByServiceId(ctx context.Context, serviceId string, page, pageSize int) ([]*Release, error)
-	// GetReleasesInDateRange retrieves all releases within a specified date range.
-	GetReleasesInDateRange(ctx context.Context, startDate, endDate time.Time, page, pageSize int) ([]*ServiceReleaseInfo, error)
+	// GetReleasesByServiceId retrieves a page of the releases of a service.
+	GetReleasesByServiceId(ctx context.Context, serviceId string, page pagination.Page) (pagination.Result[*Release], error)
+	// GetReleasesInDateRange retrieves a page of the releases within a specified date range.
+	GetReleasesInDateRange(ctx context.Context, startDate, endDate time.Time, page pagination.Page) (pagination.Result[*ServiceReleaseInfo], error)
 }
 
 // ReportRepository defines the methods for gathering reports.
 type ReportRepository interface {
 	// GetServiceRiskReport retrieves the risk report for a service.
 	GetServiceRiskReport(ctx context.Context, serviceId string) (*ServiceRiskReport, error)
-	// GetServicesByTeam retrieves all services associated with a team.
-	GetServicesByTeam(ctx context.Context, teamId string) ([]Service, error)
-	// GetDebtCountByService retrieves the number of debt items for each service.
-	GetDebtCountByService(ctx context.Context) ([]ServiceDebtReport, error)
+	// GetServicesByTeam retrieves a page of the services associated with a team.
+	GetServicesByTeam(ctx context.Context, teamId string, page pagination.Page) (pagination.Result[Service], error)
+	// GetDebtCountByService retrieves the number of debt items for a page of services.
+	GetDebtCountByService(ctx context.Context, page pagination.Page) (pagination.Result[ServiceDebtReport], error)
+	// GetCatalogCounts retrieves the number of services, teams and open debt items.
+	GetCatalogCounts(ctx context.Context) (*CatalogCounts, error)
+	// GetIntegrityReport retrieves duplicated names and urls, and debt and releases without a service.
+	GetIntegrityReport(ctx context.Context) (*IntegrityReport, error)
 }
 
 // TeamRepository defines the methods for interacting with teams.
@@ -71,8 +83,8 @@ type TeamRepository interface {
 	CreateTeam(ctx context.Context, team Team) (string, error)
 	// GetTeam retrieves a team by its ID.
 	GetTeam(ctx context.Context, teamId string) (*Team, error)
-	// GetTeams retrieves all teams.
-	GetTeams(ctx context.Context, page, pageSize int) ([]Team, error)
+	// GetTeams retrieves a page of teams.
+	GetTeams(ctx context.Context, page pagination.Page) (pagination.Result[Team], error)
 	// UpdateTeam updates an existing team.
 	UpdateTeam(ctx context.Context, team Team) error
 	// DeleteTeam deletes a team.
@@ -82,3 +94,43 @@ type TeamRepository interface {
 	// DeleteTeamAssociation deletes a team association with a service.
 	DeleteTeamAssociation(ctx context.Context, teamId, serviceId string) error
 }
+
+// GraphRepository defines batched lookups keyed by parent id. They let nested graph queries resolve
+// a field for many parents in a single query instead of one query per parent.
+type GraphRepository interface {
+	// GetServicesByIds retrieves the services with the given ids, keyed by id.
+	GetServicesByIds(ctx context.Context, ids []string) (map[string]Service, error)
+	// GetDependenciesByServiceIds retrieves the dependencies of each service, keyed by service id.
+	GetDependenciesByServiceIds(ctx context.Context, ids []string) (map[string][]*Dependency, error)
+	// GetDependentsByServiceIds retrieves the services depending on each service, keyed by service id.
+	GetDependentsByServiceIds(ctx context.Context, ids []string) (map[string][]*Dependency, error)
+	// GetTeamsByServiceIds retrieves the teams owning each service, keyed by service id.
+	GetTeamsByServiceIds(ctx context.Context, ids []string) (map[string][]Team, error)
+	// GetReleasesByServiceIds retrieves up to limit of the most recent releases of each service, keyed by service id.
+	GetReleasesByServiceIds(ctx context.Context, ids []string, limit int) (map[string][]*Release, error)
+	// GetDebtByServiceIds retrieves the debt items of each service, newest first, keyed by service id.
+	GetDebtByServiceIds(ctx context.Context, ids []string) (map[string][]Debt, error)
+	// GetServicesByTeamIds retrieves the services owned by each team, keyed by team id.
+	GetServicesByTeamIds(ctx context.Context, ids []string) (map[string][]Service, error)
+}
+
+// SearchRepository defines searching across every kind of entity in the catalog.
+type SearchRepository interface {
+	// SearchCatalog retrieves a page of the entities matching query, most relevant first, with its facets.
+	SearchCatalog(ctx context.Context, query SearchQuery, page pagination.Page) (SearchResults, error)
+	// Autocomplete retrieves up to query.Limit services and teams whose name completes query.Prefix, in the order of
+	// CompareSuggestions.
+	Autocomplete(ctx context.Context, query AutocompleteQuery) ([]Suggestion, error)
+}
+
+// ApiKeyRepository defines the methods for managing API keys. Keys are stored as hashes; the plaintext is never persisted.
+type ApiKeyRepository interface {
+	// CreateApiKey stores a new key and returns its id.
+	CreateApiKey(ctx context.Context, key ApiKey) (string, error)
+	// GetApiKeys retrieves a page of keys, newest first.
+	GetApiKeys(ctx context.Context, page pagination.Page) (pagination.Result[ApiKey], error)
+	// GetApiKeyByHash retrieves the key with the given hash.
+	GetApiKeyByHash(ctx context.Context, hash string) (*ApiKey, error)
+	// DeleteApiKey revokes a key.
+	DeleteApiKey(ctx context.Context, id string) error
+}
@@ -1,5 +1,10 @@
 package repositories
 
+import (
+	"slices"
+	"strings"
+)
+
 type ServiceRiskReport struct {
 	DebtCount      map[string]int64 `json:"debtCount"`
 	DependentCount int64            `json:"dependentCount"`
@@ -10,3 +15,47 @@ type ServiceDebtReport struct {
 	Id    string `json:"id"`
 	Count int64  `json:"count"`
 }
+
+// CatalogCounts are the sizes of the catalog, as reported in the metrics.
+type CatalogCounts struct {
+	Services int64
+	Teams    int64
+	// OpenDebt counts debt items that are pending or in progress.
+	OpenDebt int64
+}
+
+// IntegrityReport lists data breaking the rules of the catalog, such as duplicates written before names and urls
+// had to be unique.
+type IntegrityReport struct {
+	DuplicateServiceNames []Duplicate `json:"duplicateServiceNames"`
+	// DuplicateServiceUrls groups services by their normalized repository url.
+	DuplicateServiceUrls []Duplicate `json:"duplicateServiceUrls"`
+	DuplicateTeamNames   []Duplicate `json:"duplicateTeamNames"`
+	// OrphanedDebt and OrphanedReleases are owned by no service.
+	OrphanedDebt     []Debt    `json:"orphanedDebt"`
+	OrphanedReleases []Release `json:"orphanedReleases"`
+}
+
+// Duplicate is a value shared by several entities.
+type Duplicate struct {
+	Value string   `json:"value"`
+	Ids   []string `json:"ids"`
+}
+
+// FindDuplicates groups the ids by their value, given in the same order, returning the values shared by several
+// ids sorted by value.
+func FindDuplicates(ids, values []string) []Duplicate {
+	byValue := make(map[string][]string)
+	for i, value := range values {
+		byValue[value] = append(byValue[value], ids[i])
+	}
+	duplicates := make([]Duplicate, 0)
+	for value, ids := range byValue {
+		if len(ids) > 1 {
+			slices.Sort(ids)
+			duplicates = append(duplicates, Duplicate{Value: value, Ids: ids})
+		}
+	}
+	slices.SortFunc(duplicates, func(a, b Duplicate) int { return strings.Compare(a.Value, b.Value) })
+	return duplicates
+}
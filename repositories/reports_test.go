@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	got := FindDuplicates([]string{"3", "1", "2", "4"}, []string{"cart", "cart", "orders", "cart"})
+	want := []Duplicate{{Value: "cart", Ids: []string{"1", "3", "4"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindDuplicates() = %+v, want %+v", got, want)
+	}
+}
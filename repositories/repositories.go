@@ -0,0 +1,14 @@
+package repositories
+
+// Repositories are the repositories the catalog is kept in, shared by the REST, GraphQL and gRPC APIs.
+type Repositories struct {
+	Services     ServiceRepository
+	Dependencies DependencyRepository
+	Teams        TeamRepository
+	Debt         DebtRepository
+	Releases     ReleaseRepository
+	Reports      ReportRepository
+	Graph        GraphRepository
+	ApiKeys      ApiKeyRepository
+	Search       SearchRepository
+}
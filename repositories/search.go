@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"service-atlas/internal/pagination"
+)
+
+// SearchKind is the kind of entity a search hit is.
+type SearchKind string
+
+const (
+	KindService SearchKind = "service"
+	KindTeam    SearchKind = "team"
+	KindDebt    SearchKind = "debt"
+	KindRelease SearchKind = "release"
+)
+
+// SearchKinds lists every kind of entity searched.
+var SearchKinds = []SearchKind{KindService, KindTeam, KindDebt, KindRelease}
+
+// SearchQuery is a search across the catalog.
+type SearchQuery struct {
+	// Text is matched against service names, descriptions, types and urls, team names, debt titles and
+	// descriptions, and release versions.
+	Text string
+	// Kinds keeps only hits of these kinds, or every kind when empty.
+	Kinds []SearchKind
+	// ServiceType keeps only hits that are or belong to a service of this type, ignoring case.
+	ServiceType string
+}
+
+// SearchHit is an entity matching a search.
+type SearchHit struct {
+	Kind SearchKind `json:"kind"`
+	// Id is the id of the service, team or debt item, and empty for releases, which have none.
+	Id string `json:"id,omitempty"`
+	// Title is the name of a service or team, the title of a debt item or the version of a release.
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// ServiceId, ServiceName and ServiceType describe the service the hit is or belongs to, and are empty for teams.
+	ServiceId   string `json:"serviceId,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+	ServiceType string `json:"serviceType,omitempty"`
+	// Score is the relevance of the hit, higher being better.
+	Score float64 `json:"score"`
+}
+
+// SearchFacets count every hit for the text of a search, before its kinds and service type narrow it, so a client
+// can offer those filters with the number of hits each leaves.
+type SearchFacets struct {
+	Kinds        map[SearchKind]int64 `json:"kinds"`
+	ServiceTypes map[string]int64     `json:"serviceTypes"`
+}
+
+// NewSearchFacets returns facets with nothing counted.
+func NewSearchFacets() SearchFacets {
+	return SearchFacets{Kinds: make(map[SearchKind]int64), ServiceTypes: make(map[string]int64)}
+}
+
+// Count adds a hit to the facets.
+func (f SearchFacets) Count(hit SearchHit, n int64) {
+	f.Kinds[hit.Kind] += n
+	if hit.ServiceType != "" {
+		f.ServiceTypes[hit.ServiceType] += n
+	}
+}
+
+// SearchResults are a page of search hits, most relevant first, with the facets of the search.
+type SearchResults struct {
+	pagination.Result[SearchHit]
+	Facets SearchFacets `json:"facets"`
+}
+
+// AutocompleteKinds lists the kinds of entity autocomplete suggests.
+var AutocompleteKinds = []SearchKind{KindService, KindTeam}
+
+// AutocompleteQuery asks for the services and teams whose name or id completes what has been typed.
+type AutocompleteQuery struct {
+	// Prefix is matched against the start of the words of names, each of its words completing a different one, and
+	// against the start of ids.
+	Prefix string
+	// Kinds keeps only suggestions of these kinds, or every kind autocomplete suggests when empty.
+	Kinds []SearchKind
+	// Limit is the most suggestions to return.
+	Limit int
+}
+
+// Suggestion is a service or team whose name or id completes the prefix of an AutocompleteQuery.
+type Suggestion struct {
+	Kind SearchKind `json:"kind"`
+	Id   string     `json:"id"`
+	Name string     `json:"name"`
+	// Type is the type of a service, and empty for teams.
+	Type string `json:"type,omitempty"`
+}
+
+// NameWords splits text into the lower case words autocomplete matches, breaking at anything but letters and digits
+// as the full-text indexes do.
+func NameWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// CompletesName reports whether every word of prefix starts a word of name.
+func CompletesName(prefix []string, name string) bool {
+	words := NameWords(name)
+	for _, word := range prefix {
+		if !slices.ContainsFunc(words, func(w string) bool { return strings.HasPrefix(w, word) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompletesId reports whether prefix starts id, ignoring case.
+func CompletesId(prefix string, id string) bool {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	return prefix != "" && strings.HasPrefix(strings.ToLower(id), prefix)
+}
+
+// CompareSuggestions orders suggestions for prefix, those whose whole name starts with it first, then shorter names
+// before longer ones, then by name.
+func CompareSuggestions(prefix string) func(a, b Suggestion) int {
+	prefix = strings.ToLower(prefix)
+	return func(a, b Suggestion) int {
+		startsA := strings.HasPrefix(strings.ToLower(a.Name), prefix)
+		startsB := strings.HasPrefix(strings.ToLower(b.Name), prefix)
+		if startsA != startsB {
+			if startsA {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(utf8.RuneCountInString(a.Name), utf8.RuneCountInString(b.Name)), cmp.Compare(a.Name, b.Name), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Id, b.Id))
+	}
+}
@@ -3,6 +3,9 @@ package repositories
 import (
 	"errors"
 	"net/url"
+	"service-atlas/internal"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -14,6 +17,10 @@ type Service struct {
 	Created     time.Time `json:"created"`
 	Updated     time.Time `json:"updated,omitempty"`
 	Url         string    `json:"url,omitempty"`
+	// Lifecycle is one of internal.ServiceLifecycles, or empty when it is not known.
+	Lifecycle string `json:"lifecycle,omitempty"`
+	// Tags label the service, lower case and without duplicates.
+	Tags []string `json:"tags,omitempty"`
 }
 
 func (service *Service) Validate() error {
@@ -24,7 +31,15 @@ func (service *Service) Validate() error {
 		return errors.New("service url is required")
 	case service.ServiceType == "":
 		return errors.New("service type is required")
+	case service.Lifecycle != "" && !internal.ServiceLifecycles.IsMember(service.Lifecycle):
+		return errors.New("service lifecycle must be one of " + strings.Join(internal.ServiceLifecycles.Members(), ", "))
 	}
+	service.Lifecycle = strings.ToLower(service.Lifecycle)
+	tags, err := NormalizeTags(service.Tags)
+	if err != nil {
+		return err
+	}
+	service.Tags = tags
 
 	// Validate URL format
 	parsedURL, err := url.Parse(service.Url)
@@ -39,3 +54,55 @@ func (service *Service) Validate() error {
 
 	return nil
 }
+
+// Conflict describes how service clashes with other, as names and repository urls must be unique, or is empty
+// when they do not.
+func (service *Service) Conflict(other Service) string {
+	switch {
+	case other.Id == service.Id:
+		return ""
+	case other.Name == service.Name:
+		return "A service named " + other.Name + " already exists"
+	case NormalizeRepositoryUrl(other.Url) == NormalizeRepositoryUrl(service.Url):
+		return "A service with url " + other.Url + " already exists"
+	}
+	return ""
+}
+
+// NormalizeRepositoryUrl reduces a repository URL to a comparable form so that the https, ssh and
+// clone URLs of the same repository match, e.g. "git@github.com:org/repo.git" and
+// "https://github.com/org/repo/" both become "github.com/org/repo".
+func NormalizeRepositoryUrl(repoUrl string) string {
+	normalized := strings.ToLower(strings.TrimSpace(repoUrl))
+	if idx := strings.Index(normalized, "://"); idx >= 0 {
+		normalized = normalized[idx+3:]
+	} else if strings.HasPrefix(normalized, "git@") {
+		// scp-like ssh syntax separates the host from the path with a colon
+		normalized = strings.Replace(strings.TrimPrefix(normalized, "git@"), ":", "/", 1)
+	}
+	// drop any credentials in the authority
+	if at := strings.Index(normalized, "@"); at >= 0 && at < strings.Index(normalized+"/", "/") {
+		normalized = normalized[at+1:]
+	}
+	normalized = strings.TrimSuffix(normalized, "/")
+	normalized = strings.TrimSuffix(normalized, ".git")
+	return normalized
+}
+
+// NormalizeTags trims and lower-cases tags, dropping duplicates, so that tags differing only in case match.
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, errors.New("service tags must not be empty")
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized, nil
+}
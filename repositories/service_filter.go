@@ -0,0 +1,51 @@
+package repositories
+
+import "time"
+
+// ServiceSort is a field services can be listed in order of.
+type ServiceSort string
+
+const (
+	SortByName    ServiceSort = "name"
+	SortByCreated ServiceSort = "created"
+	// SortByUpdated orders services by when they were last updated, or created when they never were.
+	SortByUpdated ServiceSort = "updated"
+	// SortByDependents orders services by the number of services depending on them.
+	SortByDependents ServiceSort = "dependents"
+	// SortByDebt orders services by the number of their debt items that are not remediated.
+	SortByDebt ServiceSort = "debt"
+)
+
+// ServiceSorts lists the valid sorts.
+var ServiceSorts = []ServiceSort{SortByName, SortByCreated, SortByUpdated, SortByDependents, SortByDebt}
+
+// ServiceFilter narrows and orders a list of services. Every field is optional, and the zero value lists every
+// service, newest first.
+type ServiceFilter struct {
+	// Type matches the service type, ignoring case.
+	Type string
+	// Team is the id or name of a team owning the service.
+	Team      string
+	Lifecycle string
+	// Tags must all be on the service.
+	Tags []string
+	// HasOpenDebt keeps only services with debt that is not remediated when true, and only those without when false.
+	HasOpenDebt *bool
+	// CreatedAfter and CreatedBefore bound when the service was created, exclusively.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// UpdatedAfter and UpdatedBefore bound when the service was last updated, or created when it never was.
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// Sort is the order of the list, ascending unless Descending is set. It is empty for newest first.
+	Sort       ServiceSort
+	Descending bool
+}
+
+// Order returns the sort of the list and whether it is descending, filling in the default.
+func (f ServiceFilter) Order() (ServiceSort, bool) {
+	if f.Sort == "" {
+		return SortByCreated, true
+	}
+	return f.Sort, f.Descending
+}